@@ -11,6 +11,7 @@ require (
 	github.com/Azure/go-autorest/tracing v0.6.0
 	github.com/btubbs/datetime v0.1.1
 	github.com/davecgh/go-spew v1.1.1
+	github.com/denisenkom/go-mssqldb v0.12.0
 	github.com/gofrs/uuid v4.1.0+incompatible
 	github.com/google/go-cmp v0.5.6
 	github.com/google/uuid v1.3.0
@@ -46,6 +47,8 @@ require (
 	github.com/dimchansky/utfbom v1.1.1 // indirect
 	github.com/fatih/color v1.7.0 // indirect
 	github.com/golang-jwt/jwt/v4 v4.1.0 // indirect
+	github.com/golang-sql/civil v0.0.0-20190719163853-cb61b32ac6fe // indirect
+	github.com/golang-sql/sqlexp v0.0.0-20170517235910-f1bb20e5a188 // indirect
 	github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/googleapis/gax-go/v2 v2.0.5 // indirect
@@ -147,7 +147,6 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		resource.DeprecationMessage = message
 	}
-	// TODO: State Migrations
 
 	return &resource, nil
 }
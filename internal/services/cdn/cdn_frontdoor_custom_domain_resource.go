@@ -0,0 +1,268 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	dnsValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/dns/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorCustomDomainCreate,
+		Read:   resourceCdnFrontDoorCustomDomainRead,
+		Update: resourceCdnFrontDoorCustomDomainUpdate,
+		Delete: resourceCdnFrontDoorCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(12 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: dnsValidate.DnsZoneID,
+			},
+
+			"tls": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// only Front Door managed certificates are supported at this time
+						"certificate_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdCertificateTypeManagedCertificate),
+							}, false),
+						},
+
+						"minimum_tls_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdMinimumTLSVersionTLS10),
+								string(cdn.AfdMinimumTLSVersionTLS12),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"validation_token": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_custom_domain", id.ID())
+	}
+
+	props := cdn.AFDDomainProperties{
+		HostName:    utils.String(d.Get("host_name").(string)),
+		TLSSettings: expandFrontDoorCustomDomainTLS(d.Get("tls").([]interface{})),
+	}
+	if v := d.Get("dns_zone_id").(string); v != "" {
+		props.AzureDNSZone = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, cdn.AFDDomain{AFDDomainProperties: &props})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.AFDDomainProperties; props != nil {
+		d.Set("dns_zone_id", flattenFrontDoorResourceReference(props.AzureDNSZone))
+		d.Set("host_name", utils.NormalizeNilableString(props.HostName))
+
+		if err := d.Set("tls", flattenFrontDoorCustomDomainTLS(props.TLSSettings)); err != nil {
+			return fmt.Errorf("setting `tls`: %+v", err)
+		}
+
+		validationToken := ""
+		expirationDate := ""
+		if validation := props.ValidationProperties; validation != nil {
+			validationToken = utils.NormalizeNilableString(validation.ValidationToken)
+			expirationDate = utils.NormalizeNilableString(validation.ExpirationDate)
+		}
+		d.Set("validation_token", validationToken)
+		d.Set("expiration_date", expirationDate)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDDomainUpdatePropertiesParameters{
+		TLSSettings: expandFrontDoorCustomDomainTLS(d.Get("tls").([]interface{})),
+	}
+	if v := d.Get("dns_zone_id").(string); v != "" {
+		props.AzureDNSZone = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, cdn.AFDDomainUpdateParameters{AFDDomainUpdatePropertiesParameters: &props})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorCustomDomainTLS(input []interface{}) *cdn.AFDDomainHTTPSParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &cdn.AFDDomainHTTPSParameters{
+		CertificateType:   cdn.AfdCertificateType(v["certificate_type"].(string)),
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(v["minimum_tls_version"].(string)),
+	}
+}
+
+func flattenFrontDoorCustomDomainTLS(input *cdn.AFDDomainHTTPSParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_type":    string(input.CertificateType),
+			"minimum_tls_version": string(input.MinimumTLSVersion),
+		},
+	}
+}
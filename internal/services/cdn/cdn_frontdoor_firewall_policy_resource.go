@@ -0,0 +1,994 @@
+package cdn
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/sdk/2020-11-01/webapplicationfirewallpolicies"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	frontDoorValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/frontdoor/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorFirewallPolicyCreateUpdate,
+		Read:   resourceCdnFrontDoorFirewallPolicyRead,
+		Update: resourceCdnFrontDoorFirewallPolicyCreateUpdate,
+		Delete: resourceCdnFrontDoorFirewallPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorFirewallPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorFirewallPolicyName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(webapplicationfirewallpolicies.SkuNameStandardAzureFrontDoor),
+					string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor),
+				}, false),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(webapplicationfirewallpolicies.PolicyModeDetection),
+					string(webapplicationfirewallpolicies.PolicyModePrevention),
+				}, false),
+				Default: string(webapplicationfirewallpolicies.PolicyModePrevention),
+			},
+
+			"request_body_check_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"redirect_url": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+
+			"custom_block_response_status_code": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					200,
+					403,
+					405,
+					406,
+					429,
+				}),
+			},
+
+			"custom_block_response_body": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: frontDoorValidate.CustomBlockResponseBody,
+			},
+
+			"custom_rule": {
+				Type:     pluginsdk.TypeList,
+				MaxItems: 100,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(webapplicationfirewallpolicies.RuleTypeMatchRule),
+								string(webapplicationfirewallpolicies.RuleTypeRateLimitRule),
+							}, false),
+						},
+
+						"rate_limit_duration_in_minutes": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						"rate_limit_threshold": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+							Default:  10,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(webapplicationfirewallpolicies.ActionTypeAllow),
+								string(webapplicationfirewallpolicies.ActionTypeBlock),
+								string(webapplicationfirewallpolicies.ActionTypeLog),
+								string(webapplicationfirewallpolicies.ActionTypeRedirect),
+							}, false),
+						},
+
+						"match_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+
+									// TODO - rename to "variable" for consistency
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(webapplicationfirewallpolicies.MatchVariableCookies),
+											string(webapplicationfirewallpolicies.MatchVariablePostArgs),
+											string(webapplicationfirewallpolicies.MatchVariableQueryString),
+											string(webapplicationfirewallpolicies.MatchVariableRemoteAddr),
+											string(webapplicationfirewallpolicies.MatchVariableRequestBody),
+											string(webapplicationfirewallpolicies.MatchVariableRequestHeader),
+											string(webapplicationfirewallpolicies.MatchVariableRequestMethod),
+											string(webapplicationfirewallpolicies.MatchVariableRequestUri),
+											string(webapplicationfirewallpolicies.MatchVariableSocketAddr),
+										}, false),
+									},
+
+									// TODO - rename to "value" for consistency
+									"match_values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MaxItems: 600,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(webapplicationfirewallpolicies.OperatorAny),
+											string(webapplicationfirewallpolicies.OperatorBeginsWith),
+											string(webapplicationfirewallpolicies.OperatorContains),
+											string(webapplicationfirewallpolicies.OperatorEndsWith),
+											string(webapplicationfirewallpolicies.OperatorEqual),
+											string(webapplicationfirewallpolicies.OperatorGeoMatch),
+											string(webapplicationfirewallpolicies.OperatorGreaterThan),
+											string(webapplicationfirewallpolicies.OperatorGreaterThanOrEqual),
+											string(webapplicationfirewallpolicies.OperatorIPMatch),
+											string(webapplicationfirewallpolicies.OperatorLessThan),
+											string(webapplicationfirewallpolicies.OperatorLessThanOrEqual),
+											string(webapplicationfirewallpolicies.OperatorRegEx),
+										}, false),
+									},
+
+									"selector": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"negation_condition": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"transforms": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(webapplicationfirewallpolicies.TransformTypeLowercase),
+												string(webapplicationfirewallpolicies.TransformTypeRemoveNulls),
+												string(webapplicationfirewallpolicies.TransformTypeTrim),
+												string(webapplicationfirewallpolicies.TransformTypeUppercase),
+												string(webapplicationfirewallpolicies.TransformTypeUrlDecode),
+												string(webapplicationfirewallpolicies.TransformTypeUrlEncode),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"managed_rule": {
+				Type:     pluginsdk.TypeList,
+				MaxItems: 100,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"exclusion": {
+							Type:     pluginsdk.TypeList,
+							MaxItems: 100,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
+										}, false),
+									},
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
+										}, false),
+									},
+									"selector": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"override": {
+							Type:     pluginsdk.TypeList,
+							MaxItems: 100,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"rule_group_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"exclusion": {
+										Type:     pluginsdk.TypeList,
+										MaxItems: 100,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"match_variable": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
+													}, false),
+												},
+												"operator": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
+													}, false),
+												},
+												"selector": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+										},
+									},
+
+									"rule": {
+										Type:     pluginsdk.TypeList,
+										MaxItems: 1000,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"rule_id": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"enabled": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+
+												"exclusion": {
+													Type:     pluginsdk.TypeList,
+													MaxItems: 100,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"match_variable": {
+																Type:     pluginsdk.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
+																}, false),
+															},
+															"operator": {
+																Type:     pluginsdk.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
+																}, false),
+															},
+															"selector": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+														},
+													},
+												},
+
+												"action": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(webapplicationfirewallpolicies.ActionTypeAllow),
+														string(webapplicationfirewallpolicies.ActionTypeAnomalyScoring),
+														string(webapplicationfirewallpolicies.ActionTypeBlock),
+														string(webapplicationfirewallpolicies.ActionTypeLog),
+														string(webapplicationfirewallpolicies.ActionTypeRedirect),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCdnFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorFirewallPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing args for Front Door (Standard/Premium) Firewall Policy")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	id := webapplicationfirewallpolicies.NewFrontDoorWebApplicationFirewallPoliciesID(subscriptionId, resourceGroup, name)
+
+	if d.IsNewResource() {
+		existing, err := client.PoliciesGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing Front Door (Standard/Premium) Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+		if existing.Model != nil && existing.Model.Id != nil && *existing.Model.Id != "" {
+			return tf.ImportAsExistsError("azurerm_cdn_frontdoor_firewall_policy", id.ID())
+		}
+	}
+
+	location := azure.NormalizeLocation("Global")
+	enabled := webapplicationfirewallpolicies.PolicyEnabledStateDisabled
+	if d.Get("enabled").(bool) {
+		enabled = webapplicationfirewallpolicies.PolicyEnabledStateEnabled
+	}
+	mode := webapplicationfirewallpolicies.PolicyMode(d.Get("mode").(string))
+	redirectUrl := d.Get("redirect_url").(string)
+	customBlockResponseStatusCode := d.Get("custom_block_response_status_code").(int)
+	customBlockResponseBody := d.Get("custom_block_response_body").(string)
+	customRules := d.Get("custom_rule").([]interface{})
+	managedRules := d.Get("managed_rule").([]interface{})
+	skuName := webapplicationfirewallpolicies.SkuName(d.Get("sku_name").(string))
+
+	// Managed Rules are only available for Firewall Policies using the Premium SKU
+	if len(managedRules) > 0 && skuName != webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor {
+		return fmt.Errorf("`managed_rule` can only be specified when `sku_name` is %q", string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor))
+	}
+
+	requestBodyCheck := webapplicationfirewallpolicies.PolicyRequestBodyCheckDisabled
+	if d.Get("request_body_check_enabled").(bool) {
+		requestBodyCheck = webapplicationfirewallpolicies.PolicyRequestBodyCheckEnabled
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+
+	frontdoorWebApplicationFirewallPolicy := webapplicationfirewallpolicies.WebApplicationFirewallPolicy{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Properties: &webapplicationfirewallpolicies.WebApplicationFirewallPolicyProperties{
+			PolicySettings: &webapplicationfirewallpolicies.PolicySettings{
+				EnabledState:     &enabled,
+				Mode:             &mode,
+				RequestBodyCheck: &requestBodyCheck,
+			},
+			CustomRules:  expandCdnFrontDoorFirewallCustomRules(customRules),
+			ManagedRules: expandCdnFrontDoorFirewallManagedRules(managedRules),
+		},
+		Sku: &webapplicationfirewallpolicies.Sku{
+			Name: &skuName,
+		},
+		Tags: expandCdnFrontDoorFirewallPolicyTags(t),
+	}
+
+	if redirectUrl != "" {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.RedirectUrl = utils.String(redirectUrl)
+	}
+	if customBlockResponseBody != "" {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.CustomBlockResponseBody = utils.String(customBlockResponseBody)
+	}
+	if customBlockResponseStatusCode > 0 {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.CustomBlockResponseStatusCode = utils.Int64(int64(customBlockResponseStatusCode))
+	}
+
+	if err := client.PoliciesCreateOrUpdateThenPoll(ctx, id, frontdoorWebApplicationFirewallPolicy); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorFirewallPolicyRead(d, meta)
+}
+
+func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorFirewallPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webapplicationfirewallpolicies.ParseFrontDoorWebApplicationFirewallPoliciesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PoliciesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Front Door (Standard/Premium) Firewall Policy %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FrontDoorWebApplicationFirewallPolicyName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		skuName := ""
+		if sku := model.Sku; sku != nil && sku.Name != nil {
+			skuName = string(*sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		if properties := model.Properties; properties != nil {
+			if policy := properties.PolicySettings; policy != nil {
+				if policy.EnabledState != nil {
+					d.Set("enabled", *policy.EnabledState == webapplicationfirewallpolicies.PolicyEnabledStateEnabled)
+				}
+				if policy.Mode != nil {
+					d.Set("mode", string(*policy.Mode))
+				}
+				if policy.RequestBodyCheck != nil {
+					d.Set("request_body_check_enabled", *policy.RequestBodyCheck == webapplicationfirewallpolicies.PolicyRequestBodyCheckEnabled)
+				}
+				d.Set("redirect_url", policy.RedirectUrl)
+				d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
+				d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+			}
+
+			if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
+				return fmt.Errorf("flattening `custom_rule`: %+v", err)
+			}
+
+			if err := d.Set("managed_rule", flattenCdnFrontDoorFirewallManagedRules(properties.ManagedRules)); err != nil {
+				return fmt.Errorf("flattening `managed_rule`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, flattenCdnFrontDoorFirewallPolicyTags(model.Tags))
+	}
+	return nil
+}
+
+func resourceCdnFrontDoorFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorFirewallPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webapplicationfirewallpolicies.ParseFrontDoorWebApplicationFirewallPoliciesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.PoliciesDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorFirewallCustomRules(input []interface{}) *webapplicationfirewallpolicies.CustomRuleList {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]webapplicationfirewallpolicies.CustomRule, 0)
+
+	for _, cr := range input {
+		custom := cr.(map[string]interface{})
+
+		enabled := webapplicationfirewallpolicies.CustomRuleEnabledStateDisabled
+		if custom["enabled"].(bool) {
+			enabled = webapplicationfirewallpolicies.CustomRuleEnabledStateEnabled
+		}
+
+		name := custom["name"].(string)
+		priority := int64(custom["priority"].(int))
+		ruleType := custom["type"].(string)
+		rateLimitDurationInMinutes := int64(custom["rate_limit_duration_in_minutes"].(int))
+		rateLimitThreshold := int64(custom["rate_limit_threshold"].(int))
+		matchConditions := expandCdnFrontDoorFirewallMatchConditions(custom["match_condition"].([]interface{}))
+		action := custom["action"].(string)
+
+		customRule := webapplicationfirewallpolicies.CustomRule{
+			Name:                       utils.String(name),
+			Priority:                   priority,
+			EnabledState:               &enabled,
+			RuleType:                   webapplicationfirewallpolicies.RuleType(ruleType),
+			RateLimitDurationInMinutes: utils.Int64(rateLimitDurationInMinutes),
+			RateLimitThreshold:         utils.Int64(rateLimitThreshold),
+			MatchConditions:            matchConditions,
+			Action:                     webapplicationfirewallpolicies.ActionType(action),
+		}
+		output = append(output, customRule)
+	}
+
+	return &webapplicationfirewallpolicies.CustomRuleList{
+		Rules: &output,
+	}
+}
+
+func expandCdnFrontDoorFirewallMatchConditions(input []interface{}) []webapplicationfirewallpolicies.MatchCondition {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]webapplicationfirewallpolicies.MatchCondition, 0)
+
+	for _, v := range input {
+		match := v.(map[string]interface{})
+
+		matchVariable := match["match_variable"].(string)
+		selector := match["selector"].(string)
+		operator := match["operator"].(string)
+		negateCondition := match["negation_condition"].(bool)
+		matchValues := match["match_values"].([]interface{})
+		transforms := match["transforms"].([]interface{})
+
+		matchCondition := webapplicationfirewallpolicies.MatchCondition{
+			Operator:        webapplicationfirewallpolicies.Operator(operator),
+			NegateCondition: &negateCondition,
+			MatchValue:      *utils.ExpandStringSlice(matchValues),
+			Transforms:      expandCdnFrontDoorFirewallTransforms(transforms),
+		}
+
+		if matchVariable != "" {
+			matchCondition.MatchVariable = webapplicationfirewallpolicies.MatchVariable(matchVariable)
+		}
+		if selector != "" {
+			matchCondition.Selector = utils.String(selector)
+		}
+
+		result = append(result, matchCondition)
+	}
+
+	return result
+}
+
+func expandCdnFrontDoorFirewallTransforms(input []interface{}) *[]webapplicationfirewallpolicies.TransformType {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]webapplicationfirewallpolicies.TransformType, 0)
+	for _, v := range input {
+		result = append(result, webapplicationfirewallpolicies.TransformType(v.(string)))
+	}
+
+	return &result
+}
+
+func expandCdnFrontDoorFirewallManagedRules(input []interface{}) *webapplicationfirewallpolicies.ManagedRuleSetList {
+	if len(input) == 0 {
+		return nil
+	}
+
+	managedRules := make([]webapplicationfirewallpolicies.ManagedRuleSet, 0)
+
+	for _, mr := range input {
+		managedRule := mr.(map[string]interface{})
+
+		ruleType := managedRule["type"].(string)
+		version := managedRule["version"].(string)
+		overrides := managedRule["override"].([]interface{})
+		exclusions := managedRule["exclusion"].([]interface{})
+
+		managedRuleSet := webapplicationfirewallpolicies.ManagedRuleSet{
+			RuleSetType:    ruleType,
+			RuleSetVersion: version,
+		}
+
+		if exclusions := expandCdnFrontDoorFirewallManagedRuleGroupExclusion(exclusions); exclusions != nil {
+			managedRuleSet.Exclusions = exclusions
+		}
+
+		if ruleGroupOverrides := expandCdnFrontDoorFirewallManagedRuleGroupOverride(overrides); ruleGroupOverrides != nil {
+			managedRuleSet.RuleGroupOverrides = ruleGroupOverrides
+		}
+
+		managedRules = append(managedRules, managedRuleSet)
+	}
+
+	return &webapplicationfirewallpolicies.ManagedRuleSetList{
+		ManagedRuleSets: &managedRules,
+	}
+}
+
+func expandCdnFrontDoorFirewallManagedRuleGroupExclusion(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleExclusion {
+	if len(input) == 0 {
+		return nil
+	}
+
+	managedRuleExclusions := make([]webapplicationfirewallpolicies.ManagedRuleExclusion, 0)
+	for _, v := range input {
+		exclusion := v.(map[string]interface{})
+
+		matchVariable := exclusion["match_variable"].(string)
+		operator := exclusion["operator"].(string)
+		selector := exclusion["selector"].(string)
+
+		managedRuleExclusion := webapplicationfirewallpolicies.ManagedRuleExclusion{
+			MatchVariable:         webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariable(matchVariable),
+			SelectorMatchOperator: webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperator(operator),
+			Selector:              selector,
+		}
+
+		managedRuleExclusions = append(managedRuleExclusions, managedRuleExclusion)
+	}
+
+	return &managedRuleExclusions
+}
+
+func expandCdnFrontDoorFirewallManagedRuleGroupOverride(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride {
+	if len(input) == 0 {
+		return nil
+	}
+
+	managedRuleGroupOverrides := make([]webapplicationfirewallpolicies.ManagedRuleGroupOverride, 0)
+	for _, v := range input {
+		override := v.(map[string]interface{})
+
+		ruleGroupName := override["rule_group_name"].(string)
+		rules := override["rule"].([]interface{})
+		exclusions := override["exclusion"].([]interface{})
+
+		managedRuleGroupOverride := webapplicationfirewallpolicies.ManagedRuleGroupOverride{
+			RuleGroupName: ruleGroupName,
+		}
+
+		if exclusions := expandCdnFrontDoorFirewallManagedRuleGroupExclusion(exclusions); exclusions != nil {
+			managedRuleGroupOverride.Exclusions = exclusions
+		}
+
+		if managedRuleOverride := expandCdnFrontDoorFirewallRuleOverride(rules); managedRuleOverride != nil {
+			managedRuleGroupOverride.Rules = managedRuleOverride
+		}
+
+		managedRuleGroupOverrides = append(managedRuleGroupOverrides, managedRuleGroupOverride)
+	}
+
+	return &managedRuleGroupOverrides
+}
+
+func expandCdnFrontDoorFirewallRuleOverride(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleOverride {
+	if len(input) == 0 {
+		return nil
+	}
+
+	managedRuleOverrides := make([]webapplicationfirewallpolicies.ManagedRuleOverride, 0)
+	for _, v := range input {
+		rule := v.(map[string]interface{})
+
+		enabled := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+		if rule["enabled"].(bool) {
+			enabled = webapplicationfirewallpolicies.ManagedRuleEnabledStateEnabled
+		}
+		ruleId := rule["rule_id"].(string)
+		action := webapplicationfirewallpolicies.ActionType(rule["action"].(string))
+		exclusions := rule["exclusion"].([]interface{})
+
+		managedRuleOverride := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId:       ruleId,
+			EnabledState: &enabled,
+			Action:       &action,
+		}
+
+		if exclusions := expandCdnFrontDoorFirewallManagedRuleGroupExclusion(exclusions); exclusions != nil {
+			managedRuleOverride.Exclusions = exclusions
+		}
+
+		managedRuleOverrides = append(managedRuleOverrides, managedRuleOverride)
+	}
+
+	return &managedRuleOverrides
+}
+
+func flattenCdnFrontDoorFirewallCustomRules(input *webapplicationfirewallpolicies.CustomRuleList) []interface{} {
+	if input == nil || input.Rules == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, r := range *input.Rules {
+		output := make(map[string]interface{})
+
+		output["name"] = r.Name
+		output["type"] = string(r.RuleType)
+		output["action"] = string(r.Action)
+		if r.EnabledState != nil {
+			output["enabled"] = *r.EnabledState == webapplicationfirewallpolicies.CustomRuleEnabledStateEnabled
+		}
+		output["match_condition"] = flattenCdnFrontDoorFirewallMatchConditions(r.MatchConditions)
+		output["priority"] = int(r.Priority)
+
+		if v := r.RateLimitDurationInMinutes; v != nil {
+			output["rate_limit_duration_in_minutes"] = int(*v)
+		}
+
+		if v := r.RateLimitThreshold; v != nil {
+			output["rate_limit_threshold"] = int(*v)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallMatchConditions(condition []webapplicationfirewallpolicies.MatchCondition) []interface{} {
+	if condition == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, c := range condition {
+		output := make(map[string]interface{})
+
+		output["match_variable"] = string(c.MatchVariable)
+		output["operator"] = string(c.Operator)
+		output["match_values"] = c.MatchValue
+		output["transforms"] = flattenCdnFrontDoorFirewallTransforms(c.Transforms)
+
+		if v := c.Selector; v != nil {
+			output["selector"] = *v
+		}
+
+		if v := c.NegateCondition; v != nil {
+			output["negation_condition"] = *v
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallManagedRules(input *webapplicationfirewallpolicies.ManagedRuleSetList) []interface{} {
+	if input == nil || input.ManagedRuleSets == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, r := range *input.ManagedRuleSets {
+		output := make(map[string]interface{})
+
+		output["type"] = r.RuleSetType
+
+		output["version"] = r.RuleSetVersion
+
+		if v := r.RuleGroupOverrides; v != nil {
+			output["override"] = flattenCdnFrontDoorFirewallOverrides(v)
+		}
+
+		if v := r.Exclusions; v != nil {
+			output["exclusion"] = flattenCdnFrontDoorFirewallExclusions(v)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallExclusions(managedRuleExclusion *[]webapplicationfirewallpolicies.ManagedRuleExclusion) []interface{} {
+	if managedRuleExclusion == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, o := range *managedRuleExclusion {
+		output := make(map[string]interface{})
+
+		output["match_variable"] = o.MatchVariable
+		output["operator"] = o.SelectorMatchOperator
+		output["selector"] = o.Selector
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallOverrides(groupOverride *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride) []interface{} {
+	if groupOverride == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, o := range *groupOverride {
+		output := make(map[string]interface{})
+
+		output["rule_group_name"] = o.RuleGroupName
+
+		if v := o.Exclusions; v != nil {
+			output["exclusion"] = flattenCdnFrontDoorFirewallExclusions(v)
+		}
+
+		if rules := o.Rules; rules != nil {
+			output["rule"] = flattenCdnFrontDoorFirewallRules(rules)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallRules(override *[]webapplicationfirewallpolicies.ManagedRuleOverride) []interface{} {
+	if override == nil {
+		return make([]interface{}, 0)
+	}
+
+	results := make([]interface{}, 0)
+	for _, o := range *override {
+		output := make(map[string]interface{})
+
+		if o.EnabledState != nil {
+			output["enabled"] = *o.EnabledState == webapplicationfirewallpolicies.ManagedRuleEnabledStateEnabled
+
+		}
+		if o.Action != nil {
+			output["action"] = string(*o.Action)
+		}
+
+		output["rule_id"] = o.RuleId
+
+		if v := o.Exclusions; v != nil {
+			output["exclusion"] = flattenCdnFrontDoorFirewallExclusions(v)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenCdnFrontDoorFirewallTransforms(input *[]webapplicationfirewallpolicies.TransformType) []interface{} {
+	result := make([]interface{}, 0)
+
+	if input != nil {
+		for _, item := range *input {
+			result = append(result, string(item))
+		}
+	}
+	return result
+}
+
+func expandCdnFrontDoorFirewallPolicyTags(input map[string]interface{}) *map[string]string {
+	output := tags.ToTypedObject(tags.Expand(input))
+	return &output
+}
+
+func flattenCdnFrontDoorFirewallPolicyTags(input *map[string]string) map[string]*string {
+	if input == nil {
+		return map[string]*string{}
+	}
+	return tags.FromTypedObject(*input)
+}
@@ -0,0 +1,57 @@
+package cdn
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func expandFrontDoorEnabledState(input bool) cdn.EnabledState {
+	if input {
+		return cdn.EnabledStateEnabled
+	}
+	return cdn.EnabledStateDisabled
+}
+
+func flattenFrontDoorEnabledState(input cdn.EnabledState) bool {
+	return input == cdn.EnabledStateEnabled
+}
+
+func expandFrontDoorResourceReferences(input []interface{}) *[]cdn.ResourceReference {
+	results := make([]cdn.ResourceReference, 0)
+	for _, item := range input {
+		results = append(results, cdn.ResourceReference{
+			ID: utils.String(item.(string)),
+		})
+	}
+	return &results
+}
+
+func flattenFrontDoorResourceReference(input *cdn.ResourceReference) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+	return *input.ID
+}
+
+func flattenFrontDoorResourceReferences(input *[]cdn.ResourceReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID == nil {
+			continue
+		}
+		results = append(results, *item.ID)
+	}
+
+	return results
+}
+
+func flattenFrontDoorInt32(input *int32) int {
+	if input == nil {
+		return 0
+	}
+	return int(*input)
+}
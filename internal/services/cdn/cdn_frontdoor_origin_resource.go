@@ -0,0 +1,254 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorOrigin() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorOriginCreate,
+		Read:   resourceCdnFrontDoorOriginRead,
+		Update: resourceCdnFrontDoorOriginUpdate,
+		Delete: resourceCdnFrontDoorOriginDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorOriginID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the ID of the Azure Resource (e.g. a Storage Account or App Service) serving as this Origin
+			"azure_origin_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"http_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      80,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"https_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      443,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			// when omitted the API defaults this to the `host_name`
+			"origin_host_header": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+
+			"weight": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorOriginCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	originGroupId, err := parse.FrontDoorOriginGroupID(d.Get("cdn_frontdoor_origin_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorOriginID(originGroupId.SubscriptionId, originGroupId.ResourceGroup, originGroupId.ProfileName, originGroupId.OriginGroupName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_origin", id.ID())
+	}
+
+	props := cdn.AFDOriginProperties{
+		EnabledState: expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+		HostName:     utils.String(d.Get("host_name").(string)),
+		HTTPPort:     utils.Int32(int32(d.Get("http_port").(int))),
+		HTTPSPort:    utils.Int32(int32(d.Get("https_port").(int))),
+		Priority:     utils.Int32(int32(d.Get("priority").(int))),
+		Weight:       utils.Int32(int32(d.Get("weight").(int))),
+	}
+	if v := d.Get("azure_origin_id").(string); v != "" {
+		props.AzureOrigin = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+	if v := d.Get("origin_host_header").(string); v != "" {
+		props.OriginHostHeader = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, cdn.AFDOrigin{AFDOriginProperties: &props})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorOriginRead(d, meta)
+}
+
+func resourceCdnFrontDoorOriginRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.OriginName)
+	d.Set("cdn_frontdoor_origin_group_id", parse.NewFrontDoorOriginGroupID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName).ID())
+
+	if props := resp.AFDOriginProperties; props != nil {
+		d.Set("azure_origin_id", flattenFrontDoorResourceReference(props.AzureOrigin))
+		d.Set("enabled", flattenFrontDoorEnabledState(props.EnabledState))
+		d.Set("host_name", utils.NormalizeNilableString(props.HostName))
+		d.Set("http_port", flattenFrontDoorInt32(props.HTTPPort))
+		d.Set("https_port", flattenFrontDoorInt32(props.HTTPSPort))
+		d.Set("origin_host_header", utils.NormalizeNilableString(props.OriginHostHeader))
+		d.Set("priority", flattenFrontDoorInt32(props.Priority))
+		d.Set("weight", flattenFrontDoorInt32(props.Weight))
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorOriginUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDOriginUpdatePropertiesParameters{
+		EnabledState: expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+		HostName:     utils.String(d.Get("host_name").(string)),
+		HTTPPort:     utils.Int32(int32(d.Get("http_port").(int))),
+		HTTPSPort:    utils.Int32(int32(d.Get("https_port").(int))),
+		Priority:     utils.Int32(int32(d.Get("priority").(int))),
+		Weight:       utils.Int32(int32(d.Get("weight").(int))),
+	}
+	if v := d.Get("azure_origin_id").(string); v != "" {
+		props.AzureOrigin = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+	if v := d.Get("origin_host_header").(string); v != "" {
+		props.OriginHostHeader = utils.String(v)
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, cdn.AFDOriginUpdateParameters{AFDOriginUpdatePropertiesParameters: &props})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorOriginRead(d, meta)
+}
+
+func resourceCdnFrontDoorOriginDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,401 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRouteCreate,
+		Read:   resourceCdnFrontDoorRouteRead,
+		Update: resourceCdnFrontDoorRouteUpdate,
+		Delete: resourceCdnFrontDoorRouteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRouteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			"patterns_to_match": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"supported_protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.AFDEndpointProtocolsHTTP),
+						string(cdn.AFDEndpointProtocolsHTTPS),
+					}, false),
+				},
+			},
+
+			"cache": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query_string_caching_behavior": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+								string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+							}, false),
+						},
+
+						"compression_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"content_types_to_compress": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_custom_domain_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorCustomDomainID,
+				},
+			},
+
+			"cdn_frontdoor_rule_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorRuleSetID,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"forwarding_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.ForwardingProtocolMatchRequest),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.ForwardingProtocolHTTPOnly),
+					string(cdn.ForwardingProtocolHTTPSOnly),
+					string(cdn.ForwardingProtocolMatchRequest),
+				}, false),
+			},
+
+			"https_redirect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"link_to_default_domain": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"origin_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_route", id.ID())
+	}
+
+	// the Create and Update payloads contain the same fields
+	props := expandFrontDoorRouteProperties(d)
+	route := cdn.Route{
+		RouteProperties: &cdn.RouteProperties{
+			CompressionSettings:        props.CompressionSettings,
+			CustomDomains:              props.CustomDomains,
+			EnabledState:               props.EnabledState,
+			ForwardingProtocol:         props.ForwardingProtocol,
+			HTTPSRedirect:              props.HTTPSRedirect,
+			LinkToDefaultDomain:        props.LinkToDefaultDomain,
+			OriginGroup:                props.OriginGroup,
+			OriginPath:                 props.OriginPath,
+			PatternsToMatch:            props.PatternsToMatch,
+			QueryStringCachingBehavior: props.QueryStringCachingBehavior,
+			RuleSets:                   props.RuleSets,
+			SupportedProtocols:         props.SupportedProtocols,
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, route)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteName)
+	d.Set("cdn_frontdoor_endpoint_id", parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID())
+
+	if props := resp.RouteProperties; props != nil {
+		d.Set("cdn_frontdoor_origin_group_id", flattenFrontDoorResourceReference(props.OriginGroup))
+		d.Set("enabled", flattenFrontDoorEnabledState(props.EnabledState))
+		d.Set("forwarding_protocol", string(props.ForwardingProtocol))
+		d.Set("https_redirect_enabled", props.HTTPSRedirect == cdn.HTTPSRedirectEnabled)
+		d.Set("link_to_default_domain", props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled)
+		d.Set("origin_path", utils.NormalizeNilableString(props.OriginPath))
+
+		if err := d.Set("cache", flattenFrontDoorRouteCache(props.QueryStringCachingBehavior, props.CompressionSettings)); err != nil {
+			return fmt.Errorf("setting `cache`: %+v", err)
+		}
+
+		if err := d.Set("cdn_frontdoor_custom_domain_ids", flattenFrontDoorResourceReferences(props.CustomDomains)); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_custom_domain_ids`: %+v", err)
+		}
+
+		if err := d.Set("cdn_frontdoor_rule_set_ids", flattenFrontDoorResourceReferences(props.RuleSets)); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_rule_set_ids`: %+v", err)
+		}
+
+		if err := d.Set("patterns_to_match", utils.FlattenStringSlice(props.PatternsToMatch)); err != nil {
+			return fmt.Errorf("setting `patterns_to_match`: %+v", err)
+		}
+
+		supportedProtocols := make([]interface{}, 0)
+		if props.SupportedProtocols != nil {
+			for _, v := range *props.SupportedProtocols {
+				supportedProtocols = append(supportedProtocols, string(v))
+			}
+		}
+		if err := d.Set("supported_protocols", supportedProtocols); err != nil {
+			return fmt.Errorf("setting `supported_protocols`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRouteUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := cdn.RouteUpdateParameters{
+		RouteUpdatePropertiesParameters: expandFrontDoorRouteProperties(d),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorRouteProperties(d *pluginsdk.ResourceData) *cdn.RouteUpdatePropertiesParameters {
+	props := cdn.RouteUpdatePropertiesParameters{
+		CustomDomains:       expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{})),
+		EnabledState:        expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+		ForwardingProtocol:  cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+		HTTPSRedirect:       cdn.HTTPSRedirectDisabled,
+		LinkToDefaultDomain: cdn.LinkToDefaultDomainDisabled,
+		OriginGroup: &cdn.ResourceReference{
+			ID: utils.String(d.Get("cdn_frontdoor_origin_group_id").(string)),
+		},
+		PatternsToMatch:            utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+		QueryStringCachingBehavior: cdn.AfdQueryStringCachingBehaviorNotSet,
+		RuleSets:                   expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").([]interface{})),
+	}
+
+	if d.Get("https_redirect_enabled").(bool) {
+		props.HTTPSRedirect = cdn.HTTPSRedirectEnabled
+	}
+
+	if d.Get("link_to_default_domain").(bool) {
+		props.LinkToDefaultDomain = cdn.LinkToDefaultDomainEnabled
+	}
+
+	if v := d.Get("origin_path").(string); v != "" {
+		props.OriginPath = utils.String(v)
+	}
+
+	supportedProtocols := make([]cdn.AFDEndpointProtocols, 0)
+	for _, v := range d.Get("supported_protocols").(*pluginsdk.Set).List() {
+		supportedProtocols = append(supportedProtocols, cdn.AFDEndpointProtocols(v.(string)))
+	}
+	props.SupportedProtocols = &supportedProtocols
+
+	if raw := d.Get("cache").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		cache := raw[0].(map[string]interface{})
+		props.QueryStringCachingBehavior = cdn.AfdQueryStringCachingBehavior(cache["query_string_caching_behavior"].(string))
+		props.CompressionSettings = cdn.CompressionSettings{
+			ContentTypesToCompress: utils.ExpandStringSlice(cache["content_types_to_compress"].([]interface{})),
+			IsCompressionEnabled:   utils.Bool(cache["compression_enabled"].(bool)),
+		}
+	}
+
+	return &props
+}
+
+func flattenFrontDoorRouteCache(queryStringCachingBehavior cdn.AfdQueryStringCachingBehavior, compressionSettings interface{}) []interface{} {
+	// caching is disabled for the Route when the Query String Caching Behavior isn't set
+	if queryStringCachingBehavior == "" || queryStringCachingBehavior == cdn.AfdQueryStringCachingBehaviorNotSet {
+		return []interface{}{}
+	}
+
+	compressionEnabled := false
+	contentTypesToCompress := make([]interface{}, 0)
+
+	// the Compression Settings are returned as an untyped object
+	if settings, ok := compressionSettings.(map[string]interface{}); ok {
+		if v, ok := settings["isCompressionEnabled"].(bool); ok {
+			compressionEnabled = v
+		}
+		if v, ok := settings["contentTypesToCompress"].([]interface{}); ok {
+			contentTypesToCompress = v
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"compression_enabled":           compressionEnabled,
+			"content_types_to_compress":     contentTypesToCompress,
+			"query_string_caching_behavior": string(queryStringCachingBehavior),
+		},
+	}
+}
@@ -0,0 +1,455 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/deliveryruleactions"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/deliveryruleconditions"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRuleCreate,
+		Read:   resourceCdnFrontDoorRuleRead,
+		Update: resourceCdnFrontDoorRuleUpdate,
+		Delete: resourceCdnFrontDoorRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorRuleName,
+			},
+
+			"cdn_frontdoor_rule_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorRuleSetID,
+			},
+
+			"order": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"behavior_on_match": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.MatchProcessingBehaviorContinue),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.MatchProcessingBehaviorContinue),
+					string(cdn.MatchProcessingBehaviorStop),
+				}, false),
+			},
+
+			"conditions": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cookies_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.Cookies(),
+						},
+
+						"device_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleconditions.Device(),
+						},
+
+						"http_version_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.HTTPVersion(),
+						},
+
+						"post_arg_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.PostArg(),
+						},
+
+						"query_string_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.QueryString(),
+						},
+
+						"remote_address_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.RemoteAddress(),
+						},
+
+						"request_body_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.RequestBody(),
+						},
+
+						"request_header_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.RequestHeader(),
+						},
+
+						"request_method_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleconditions.RequestMethod(),
+						},
+
+						"request_scheme_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleconditions.RequestScheme(),
+						},
+
+						"request_uri_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.RequestURI(),
+						},
+
+						"url_file_extension_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.URLFileExtension(),
+						},
+
+						"url_file_name_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.URLFileName(),
+						},
+
+						"url_path_condition": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleconditions.URLPath(),
+						},
+					},
+				},
+			},
+
+			"actions": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cache_expiration_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleactions.CacheExpiration(),
+						},
+
+						"cache_key_query_string_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleactions.CacheKeyQueryString(),
+						},
+
+						"modify_request_header_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleactions.ModifyRequestHeader(),
+						},
+
+						"modify_response_header_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem:     deliveryruleactions.ModifyResponseHeader(),
+						},
+
+						"origin_group_override_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleactions.OriginGroupOverride(),
+						},
+
+						"url_redirect_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleactions.URLRedirect(),
+						},
+
+						"url_rewrite_action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     deliveryruleactions.URLRewrite(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ruleSetId, err := parse.FrontDoorRuleSetID(d.Get("cdn_frontdoor_rule_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRuleID(ruleSetId.SubscriptionId, ruleSetId.ResourceGroup, ruleSetId.ProfileName, ruleSetId.RuleSetName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_rule", id.ID())
+	}
+
+	actions, err := expandFrontDoorRuleActions(d.Get("actions").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `actions`: %+v", err)
+	}
+
+	rule := cdn.Rule{
+		RuleProperties: &cdn.RuleProperties{
+			Actions:                 actions,
+			Conditions:              expandFrontDoorRuleConditions(d.Get("conditions").([]interface{})),
+			MatchProcessingBehavior: cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string)),
+			Order:                   utils.Int32(int32(d.Get("order").(int))),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, rule)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("cdn_frontdoor_rule_set_id", parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName).ID())
+
+	if props := resp.RuleProperties; props != nil {
+		d.Set("behavior_on_match", string(props.MatchProcessingBehavior))
+		d.Set("order", flattenFrontDoorInt32(props.Order))
+
+		conditions, err := flattenFrontDoorRuleConditions(props.Conditions)
+		if err != nil {
+			return fmt.Errorf("flattening `conditions`: %+v", err)
+		}
+		if err := d.Set("conditions", conditions); err != nil {
+			return fmt.Errorf("setting `conditions`: %+v", err)
+		}
+
+		actions, err := flattenFrontDoorRuleActions(props.Actions)
+		if err != nil {
+			return fmt.Errorf("flattening `actions`: %+v", err)
+		}
+		if err := d.Set("actions", actions); err != nil {
+			return fmt.Errorf("setting `actions`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	actions, err := expandFrontDoorRuleActions(d.Get("actions").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `actions`: %+v", err)
+	}
+
+	parameters := cdn.RuleUpdateParameters{
+		RuleUpdatePropertiesParameters: &cdn.RuleUpdatePropertiesParameters{
+			Actions:                 actions,
+			Conditions:              expandFrontDoorRuleConditions(d.Get("conditions").([]interface{})),
+			MatchProcessingBehavior: cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string)),
+			Order:                   utils.Int32(int32(d.Get("order").(int))),
+		},
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorRuleConditions(input []interface{}) *[]cdn.BasicDeliveryRuleCondition {
+	if len(input) == 0 || input[0] == nil {
+		conditions := make([]cdn.BasicDeliveryRuleCondition, 0)
+		return &conditions
+	}
+
+	// the Front Door Rules Engine uses the same conditions as the CDN Endpoint Delivery Rules
+	return expandDeliveryRuleConditions(input[0].(map[string]interface{}))
+}
+
+func expandFrontDoorRuleActions(input []interface{}) (*[]cdn.BasicDeliveryRuleAction, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("at least one action must be specified")
+	}
+	raw := input[0].(map[string]interface{})
+
+	actions, err := expandDeliveryRuleActions(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Origin Group Override action is only available within the Front Door Rules Engine
+	originGroupOverride, err := deliveryruleactions.ExpandArmCdnEndpointActionOriginGroupOverride(raw["origin_group_override_action"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	actions = append(actions, *originGroupOverride...)
+
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("at least one action must be specified")
+	}
+
+	return &actions, nil
+}
+
+func flattenFrontDoorRuleConditions(input *[]cdn.BasicDeliveryRuleCondition) ([]interface{}, error) {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}, nil
+	}
+
+	conditions, err := flattenDeliveryRuleConditions(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range *conditions {
+		output[key] = value
+	}
+
+	return []interface{}{output}, nil
+}
+
+func flattenFrontDoorRuleActions(input *[]cdn.BasicDeliveryRuleAction) ([]interface{}, error) {
+	actions, err := flattenDeliveryRuleActions(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range *actions {
+		output[key] = value
+	}
+
+	originGroupOverrides := make([]interface{}, 0)
+	if input != nil {
+		for _, action := range *input {
+			if _, ok := action.AsOriginGroupOverrideAction(); !ok {
+				continue
+			}
+
+			mapped, err := deliveryruleactions.FlattenArmCdnEndpointActionOriginGroupOverride(action)
+			if err != nil {
+				return nil, err
+			}
+			originGroupOverrides = append(originGroupOverrides, *mapped)
+		}
+	}
+	output["origin_group_override_action"] = originGroupOverrides
+
+	return []interface{}{output}, nil
+}
@@ -0,0 +1,263 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecurityPolicyCreate,
+		Read:   resourceCdnFrontDoorSecurityPolicyRead,
+		Update: resourceCdnFrontDoorSecurityPolicyUpdate,
+		Delete: resourceCdnFrontDoorSecurityPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecurityPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"cdn_frontdoor_firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorFirewallPolicyID,
+			},
+
+			"association": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// either a Front Door Endpoint or Custom Domain ID
+						"domain_ids": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.Any(
+									validate.FrontDoorEndpointID,
+									validate.FrontDoorCustomDomainID,
+								),
+							},
+						},
+
+						"patterns_to_match": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecurityPolicyID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_security_policy", id.ID())
+	}
+
+	policy := cdn.SecurityPolicy{
+		SecurityPolicyProperties: &cdn.SecurityPolicyProperties{
+			Parameters: expandFrontDoorSecurityPolicyParameters(d),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, policy)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorSecurityPolicyRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecurityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecurityPolicyName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	firewallPolicyId := ""
+	associations := make([]interface{}, 0)
+	if props := resp.SecurityPolicyProperties; props != nil && props.Parameters != nil {
+		if waf, ok := props.Parameters.AsSecurityPolicyWebApplicationFirewallParameters(); ok && waf != nil {
+			firewallPolicyId = flattenFrontDoorResourceReference(waf.WafPolicy)
+			associations = flattenFrontDoorSecurityPolicyAssociations(waf.Associations)
+		}
+	}
+	d.Set("cdn_frontdoor_firewall_policy_id", firewallPolicyId)
+	if err := d.Set("association", associations); err != nil {
+		return fmt.Errorf("setting `association`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecurityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cdn.SecurityPolicyProperties{
+		Parameters: expandFrontDoorSecurityPolicyParameters(d),
+	}
+
+	future, err := client.Patch(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorSecurityPolicyRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorSecurityPolicyParameters(d *pluginsdk.ResourceData) cdn.SecurityPolicyWebApplicationFirewallParameters {
+	associations := make([]cdn.SecurityPolicyWebApplicationFirewallAssociation, 0)
+	for _, raw := range d.Get("association").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		association := cdn.SecurityPolicyWebApplicationFirewallAssociation{
+			Domains: expandFrontDoorResourceReferences(v["domain_ids"].([]interface{})),
+		}
+
+		// the API defaults to matching every path when no patterns are specified
+		patterns := v["patterns_to_match"].([]interface{})
+		if len(patterns) == 0 {
+			patterns = []interface{}{"/*"}
+		}
+		association.PatternsToMatch = utils.ExpandStringSlice(patterns)
+
+		associations = append(associations, association)
+	}
+
+	return cdn.SecurityPolicyWebApplicationFirewallParameters{
+		WafPolicy: &cdn.ResourceReference{
+			ID: utils.String(d.Get("cdn_frontdoor_firewall_policy_id").(string)),
+		},
+		Associations: &associations,
+		Type:         cdn.TypeWebApplicationFirewall,
+	}
+}
+
+func flattenFrontDoorSecurityPolicyAssociations(input *[]cdn.SecurityPolicyWebApplicationFirewallAssociation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"domain_ids":        flattenFrontDoorResourceReferences(item.Domains),
+			"patterns_to_match": utils.FlattenStringSlice(item.PatternsToMatch),
+		})
+	}
+
+	return results
+}
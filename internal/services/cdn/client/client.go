@@ -3,12 +3,24 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/sdk/2020-11-01/webapplicationfirewallpolicies"
 )
 
 type Client struct {
 	CustomDomainsClient *cdn.CustomDomainsClient
 	EndpointsClient     *cdn.EndpointsClient
 	ProfilesClient      *cdn.ProfilesClient
+
+	FrontDoorCustomDomainsClient    *cdn.AFDCustomDomainsClient
+	FrontDoorEndpointsClient        *cdn.AFDEndpointsClient
+	FrontDoorFirewallPoliciesClient *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
+	FrontDoorOriginGroupsClient     *cdn.AFDOriginGroupsClient
+	FrontDoorOriginsClient          *cdn.AFDOriginsClient
+	FrontDoorProfilesClient         *cdn.ProfilesClient
+	FrontDoorRoutesClient           *cdn.RoutesClient
+	FrontDoorRulesClient            *cdn.RulesClient
+	FrontDoorRuleSetsClient         *cdn.RuleSetsClient
+	FrontDoorSecurityPoliciesClient *cdn.SecurityPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +33,50 @@ func NewClient(o *common.ClientOptions) *Client {
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorCustomDomainsClient := cdn.NewAFDCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorEndpointsClient := cdn.NewAFDEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorFirewallPoliciesClient := webapplicationfirewallpolicies.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&frontDoorFirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginGroupsClient := cdn.NewAFDOriginGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginsClient := cdn.NewAFDOriginsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorProfilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRoutesClient := cdn.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRoutesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRulesClient := cdn.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRuleSetsClient := cdn.NewRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRuleSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecurityPoliciesClient := cdn.NewSecurityPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecurityPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CustomDomainsClient: &customDomainsClient,
 		EndpointsClient:     &endpointsClient,
 		ProfilesClient:      &profilesClient,
+
+		FrontDoorCustomDomainsClient:    &frontDoorCustomDomainsClient,
+		FrontDoorEndpointsClient:        &frontDoorEndpointsClient,
+		FrontDoorFirewallPoliciesClient: &frontDoorFirewallPoliciesClient,
+		FrontDoorOriginGroupsClient:     &frontDoorOriginGroupsClient,
+		FrontDoorOriginsClient:          &frontDoorOriginsClient,
+		FrontDoorProfilesClient:         &frontDoorProfilesClient,
+		FrontDoorRoutesClient:           &frontDoorRoutesClient,
+		FrontDoorRulesClient:            &frontDoorRulesClient,
+		FrontDoorRuleSetsClient:         &frontDoorRuleSetsClient,
+		FrontDoorSecurityPoliciesClient: &frontDoorSecurityPoliciesClient,
 	}
 }
@@ -0,0 +1,60 @@
+package deliveryruleactions
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cdn/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func OriginGroupOverride() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+		},
+	}
+}
+
+func ExpandArmCdnEndpointActionOriginGroupOverride(input []interface{}) (*[]cdn.BasicDeliveryRuleAction, error) {
+	output := make([]cdn.BasicDeliveryRuleAction, 0)
+
+	for _, v := range input {
+		item := v.(map[string]interface{})
+
+		originGroupOverrideAction := cdn.OriginGroupOverrideAction{
+			Name: cdn.NameBasicDeliveryRuleActionNameOriginGroupOverride,
+			Parameters: &cdn.OriginGroupOverrideActionParameters{
+				OdataType: utils.String("Microsoft.Azure.Cdn.Models.DeliveryRuleOriginGroupOverrideActionParameters"),
+				OriginGroup: &cdn.ResourceReference{
+					ID: utils.String(item["cdn_frontdoor_origin_group_id"].(string)),
+				},
+			},
+		}
+
+		output = append(output, originGroupOverrideAction)
+	}
+
+	return &output, nil
+}
+
+func FlattenArmCdnEndpointActionOriginGroupOverride(input cdn.BasicDeliveryRuleAction) (*map[string]interface{}, error) {
+	action, ok := input.AsOriginGroupOverrideAction()
+	if !ok {
+		return nil, fmt.Errorf("expected a delivery rule origin group override action!")
+	}
+
+	originGroupId := ""
+	if params := action.Parameters; params != nil && params.OriginGroup != nil && params.OriginGroup.ID != nil {
+		originGroupId = *params.OriginGroup.ID
+	}
+
+	return &map[string]interface{}{
+		"cdn_frontdoor_origin_group_id": originGroupId,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type FrontDoorOriginGroupId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ProfileName     string
+	OriginGroupName string
+}
+
+func NewFrontDoorOriginGroupID(subscriptionId, resourceGroup, profileName, originGroupName string) FrontDoorOriginGroupId {
+	return FrontDoorOriginGroupId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ProfileName:     profileName,
+		OriginGroupName: originGroupName,
+	}
+}
+
+func (id FrontDoorOriginGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Origin Group Name %q", id.OriginGroupName),
+		fmt.Sprintf("Profile Name %q", id.ProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Front Door Origin Group", segmentsStr)
+}
+
+func (id FrontDoorOriginGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/originGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName)
+}
+
+// FrontDoorOriginGroupID parses a FrontDoorOriginGroup ID into an FrontDoorOriginGroupId struct
+func FrontDoorOriginGroupID(input string) (*FrontDoorOriginGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorOriginGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, err
+	}
+	if resourceId.OriginGroupName, err = id.PopSegment("originGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type FrontDoorRouteId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ProfileName     string
+	AfdEndpointName string
+	RouteName       string
+}
+
+func NewFrontDoorRouteID(subscriptionId, resourceGroup, profileName, afdEndpointName, routeName string) FrontDoorRouteId {
+	return FrontDoorRouteId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ProfileName:     profileName,
+		AfdEndpointName: afdEndpointName,
+		RouteName:       routeName,
+	}
+}
+
+func (id FrontDoorRouteId) String() string {
+	segments := []string{
+		fmt.Sprintf("Route Name %q", id.RouteName),
+		fmt.Sprintf("Afd Endpoint Name %q", id.AfdEndpointName),
+		fmt.Sprintf("Profile Name %q", id.ProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Front Door Route", segmentsStr)
+}
+
+func (id FrontDoorRouteId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/afdEndpoints/%s/routes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+}
+
+// FrontDoorRouteID parses a FrontDoorRoute ID into an FrontDoorRouteId struct
+func FrontDoorRouteID(input string) (*FrontDoorRouteId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorRouteId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, err
+	}
+	if resourceId.AfdEndpointName, err = id.PopSegment("afdEndpoints"); err != nil {
+		return nil, err
+	}
+	if resourceId.RouteName, err = id.PopSegment("routes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
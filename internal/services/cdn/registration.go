@@ -31,5 +31,17 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_endpoint":               resourceCdnEndpoint(),
 		"azurerm_cdn_endpoint_custom_domain": resourceArmCdnEndpointCustomDomain(),
 		"azurerm_cdn_profile":                resourceCdnProfile(),
+
+		// Front Door (Standard/Premium)
+		"azurerm_cdn_frontdoor_custom_domain":   resourceCdnFrontDoorCustomDomain(),
+		"azurerm_cdn_frontdoor_endpoint":        resourceCdnFrontDoorEndpoint(),
+		"azurerm_cdn_frontdoor_firewall_policy": resourceCdnFrontDoorFirewallPolicy(),
+		"azurerm_cdn_frontdoor_origin":          resourceCdnFrontDoorOrigin(),
+		"azurerm_cdn_frontdoor_origin_group":    resourceCdnFrontDoorOriginGroup(),
+		"azurerm_cdn_frontdoor_profile":         resourceCdnFrontDoorProfile(),
+		"azurerm_cdn_frontdoor_route":           resourceCdnFrontDoorRoute(),
+		"azurerm_cdn_frontdoor_rule":            resourceCdnFrontDoorRule(),
+		"azurerm_cdn_frontdoor_rule_set":        resourceCdnFrontDoorRuleSet(),
+		"azurerm_cdn_frontdoor_security_policy": resourceCdnFrontDoorSecurityPolicy(),
 	}
 }
@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type CustomRuleList struct {
+	Rules *[]CustomRule `json:"rules,omitempty"`
+}
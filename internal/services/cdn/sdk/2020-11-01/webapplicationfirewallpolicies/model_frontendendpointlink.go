@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type FrontendEndpointLink struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type ManagedRuleSetList struct {
+	ManagedRuleSets *[]ManagedRuleSet `json:"managedRuleSets,omitempty"`
+}
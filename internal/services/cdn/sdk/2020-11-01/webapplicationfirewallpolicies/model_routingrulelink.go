@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type RoutingRuleLink struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type Sku struct {
+	Name *SkuName `json:"name,omitempty"`
+}
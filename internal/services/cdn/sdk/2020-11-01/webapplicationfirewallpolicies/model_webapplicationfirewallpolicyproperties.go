@@ -0,0 +1,11 @@
+package webapplicationfirewallpolicies
+
+type WebApplicationFirewallPolicyProperties struct {
+	CustomRules           *CustomRuleList         `json:"customRules,omitempty"`
+	FrontendEndpointLinks *[]FrontendEndpointLink `json:"frontendEndpointLinks,omitempty"`
+	ManagedRules          *ManagedRuleSetList     `json:"managedRules,omitempty"`
+	PolicySettings        *PolicySettings         `json:"policySettings,omitempty"`
+	ProvisioningState     *string                 `json:"provisioningState,omitempty"`
+	ResourceState         *PolicyResourceState    `json:"resourceState,omitempty"`
+	RoutingRuleLinks      *[]RoutingRuleLink      `json:"routingRuleLinks,omitempty"`
+}
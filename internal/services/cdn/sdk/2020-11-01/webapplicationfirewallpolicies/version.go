@@ -0,0 +1,9 @@
+package webapplicationfirewallpolicies
+
+import "fmt"
+
+const defaultApiVersion = "2020-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/webapplicationfirewallpolicies/%s", defaultApiVersion)
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// FrontDoorName validates the name of a Front Door (Standard/Premium) Profile, Endpoint, Origin Group, Origin,
+// Route, Custom Domain or Security Policy
+func FrontDoorName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(v) < 1 || len(v) > 260 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 260 characters in length, got %d", k, len(v)))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start and end with a letter or number and may only contain letters, numbers and hyphens, got %q", k, v))
+	}
+
+	return
+}
+
+// FrontDoorEndpointName validates the name of a Front Door (Standard/Premium) Endpoint, which is used as part of the
+// Host Name of the Endpoint and as such is limited to 46 characters
+func FrontDoorEndpointName(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = FrontDoorName(i, k); len(errors) > 0 {
+		return
+	}
+
+	if v := i.(string); len(v) > 46 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 46 characters in length, got %d", k, len(v)))
+	}
+
+	return
+}
+
+// FrontDoorRuleName validates the name of a Front Door (Standard/Premium) Rule Set or Rule
+func FrontDoorRuleName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,259}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, may only contain letters and numbers and must be between 1 and 260 characters in length, got %q", k, v))
+	}
+
+	return
+}
+
+// FrontDoorFirewallPolicyName validates the name of a Front Door (Standard/Premium) Firewall Policy
+func FrontDoorFirewallPolicyName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,127}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, may only contain letters and numbers and must be between 1 and 128 characters in length, got %q", k, v))
+	}
+
+	return
+}
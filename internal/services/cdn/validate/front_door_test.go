@@ -0,0 +1,178 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrontDoorName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "1",
+			Valid: true,
+		},
+		{
+			Input: "my-profile-1",
+			Valid: true,
+		},
+		{
+			Input: "-profile",
+			Valid: false,
+		},
+		{
+			Input: "profile-",
+			Valid: false,
+		},
+		{
+			Input: "my_profile",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 260),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 261),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := FrontDoorName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestFrontDoorEndpointName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "my-endpoint",
+			Valid: true,
+		},
+		{
+			Input: "my_endpoint",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 46),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 47),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := FrontDoorEndpointName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestFrontDoorRuleName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "ruleSet1",
+			Valid: true,
+		},
+		{
+			Input: "1ruleSet",
+			Valid: false,
+		},
+		{
+			Input: "rule-set",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 260),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 261),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := FrontDoorRuleName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestFrontDoorFirewallPolicyName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "examplePolicy1",
+			Valid: true,
+		},
+		{
+			Input: "1examplePolicy",
+			Valid: false,
+		},
+		{
+			Input: "example-policy",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 128),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 129),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := FrontDoorFirewallPolicyName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
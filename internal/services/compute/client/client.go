@@ -4,6 +4,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/sdk/2024-03-01/virtualmachines"
 )
 
 type Client struct {
@@ -23,11 +25,15 @@ type Client struct {
 	UsageClient                     *compute.UsageClient
 	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
 	VMExtensionClient               *compute.VirtualMachineExtensionsClient
+	VMRunCommandsClient             *compute.VirtualMachineRunCommandsClient
 	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
 	VMScaleSetExtensionsClient      *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetPriorityMixClient     *virtualmachinescalesets.VirtualMachineScaleSetsClient
 	VMScaleSetRollingUpgradesClient *compute.VirtualMachineScaleSetRollingUpgradesClient
 	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
+	VMScaleSetVMRunCommandsClient   *compute.VirtualMachineScaleSetVMRunCommandsClient
 	VMClient                        *compute.VirtualMachinesClient
+	VMScaleSetAttachmentClient      *virtualmachines.VirtualMachinesClient
 	VMImageClient                   *compute.VirtualMachineImagesClient
 	SSHPublicKeysClient             *compute.SSHPublicKeysClient
 }
@@ -81,6 +87,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmExtensionClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmExtensionClient.Client, o.ResourceManagerAuthorizer)
 
+	vmRunCommandsClient := compute.NewVirtualMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmImageClient := compute.NewVirtualMachineImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmImageClient.Client, o.ResourceManagerAuthorizer)
 
@@ -90,12 +99,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmScaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetExtensionsClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetAttachmentClient := virtualmachines.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vmScaleSetAttachmentClient.Client, o.ResourceManagerAuthorizer)
+
+	vmScaleSetPriorityMixClient := virtualmachinescalesets.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vmScaleSetPriorityMixClient.Client, o.ResourceManagerAuthorizer)
+
 	vmScaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetRollingUpgradesClient.Client, o.ResourceManagerAuthorizer)
 
 	vmScaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetVMsClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetVMRunCommandsClient := compute.NewVirtualMachineScaleSetVMRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmScaleSetVMRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
@@ -119,11 +137,15 @@ func NewClient(o *common.ClientOptions) *Client {
 		UsageClient:                     &usageClient,
 		VMExtensionImageClient:          &vmExtensionImageClient,
 		VMExtensionClient:               &vmExtensionClient,
+		VMRunCommandsClient:             &vmRunCommandsClient,
 		VMScaleSetClient:                &vmScaleSetClient,
 		VMScaleSetExtensionsClient:      &vmScaleSetExtensionsClient,
+		VMScaleSetPriorityMixClient:     &vmScaleSetPriorityMixClient,
 		VMScaleSetRollingUpgradesClient: &vmScaleSetRollingUpgradesClient,
 		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
+		VMScaleSetVMRunCommandsClient:   &vmScaleSetVMRunCommandsClient,
 		VMClient:                        &vmClient,
+		VMScaleSetAttachmentClient:      &vmScaleSetAttachmentClient,
 		VMImageClient:                   &vmImageClient,
 		SSHPublicKeysClient:             &sshPublicKeysClient,
 	}
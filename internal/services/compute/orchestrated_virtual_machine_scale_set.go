@@ -0,0 +1,426 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/base64"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+// OrchestratedVirtualMachineScaleSetNetworkInterfaceSchema returns the `network_interface` block used by the
+// Uniform Scale Sets - which is Optional here since a Virtual Machine Profile is optional in Flexible Orchestration
+func OrchestratedVirtualMachineScaleSetNetworkInterfaceSchema() *pluginsdk.Schema {
+	s := VirtualMachineScaleSetNetworkInterfaceSchema()
+	s.Required = false
+	s.Optional = true
+	return s
+}
+
+// OrchestratedVirtualMachineScaleSetOSDiskSchema returns the `os_disk` block used by the Uniform Scale Sets,
+// which is Optional here for the same reason
+func OrchestratedVirtualMachineScaleSetOSDiskSchema() *pluginsdk.Schema {
+	s := VirtualMachineScaleSetOSDiskSchema()
+	s.Required = false
+	s.Optional = true
+	return s
+}
+
+func OrchestratedVirtualMachineScaleSetOSProfileSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"custom_data": base64.OptionalSchema(false),
+
+				"linux_configuration": {
+					Type:         pluginsdk.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"admin_username": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"admin_password": {
+								Type:             pluginsdk.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								Sensitive:        true,
+								DiffSuppressFunc: adminPasswordDiffSuppressFunc,
+							},
+
+							"admin_ssh_key": SSHKeysSchema(false),
+
+							"computer_name_prefix": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+
+								// Computed since we reuse the Scale Set name if one's not specified
+								Computed: true,
+								ForceNew: true,
+
+								ValidateFunc: validate.LinuxComputerNamePrefix,
+							},
+
+							"disable_password_authentication": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+
+							"provision_vm_agent": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+								ForceNew: true,
+							},
+
+							"secret": linuxSecretSchema(),
+						},
+					},
+				},
+
+				"windows_configuration": {
+					Type:         pluginsdk.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"admin_username": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"admin_password": {
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								Sensitive:        true,
+								DiffSuppressFunc: adminPasswordDiffSuppressFunc,
+								ValidateFunc:     validation.StringIsNotEmpty,
+							},
+
+							"additional_unattend_content": additionalUnattendContentSchema(),
+
+							"computer_name_prefix": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+
+								// Computed since we reuse the Scale Set name if one's not specified
+								Computed: true,
+								ForceNew: true,
+
+								ValidateFunc: validate.WindowsComputerNamePrefix,
+							},
+
+							"enable_automatic_updates": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+
+							"provision_vm_agent": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+								ForceNew: true,
+							},
+
+							"secret": windowsSecretSchema(),
+
+							"timezone": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.VirtualMachineTimeZone(),
+							},
+
+							"winrm_listener": winRmListenerSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ExpandOrchestratedVirtualMachineScaleSetOSProfile expands the `os_profile` block, using the name of the Scale Set
+// as the Computer Name Prefix when one isn't specified. The Operating System Type is returned so that it can be
+// used to expand the `os_disk` block.
+func OrchestratedVirtualMachineScaleSetPriorityMixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"base_regular_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"regular_percentage_above_base": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func ExpandOrchestratedVirtualMachineScaleSetPriorityMix(input []interface{}) *virtualmachinescalesets.PriorityMixPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &virtualmachinescalesets.PriorityMixPolicy{
+		BaseRegularPriorityCount:           utils.Int64(int64(raw["base_regular_count"].(int))),
+		RegularPriorityPercentageAboveBase: utils.Int64(int64(raw["regular_percentage_above_base"].(int))),
+	}
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetPriorityMix(input *virtualmachinescalesets.PriorityMixPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseRegularCount := 0
+	if input.BaseRegularPriorityCount != nil {
+		baseRegularCount = int(*input.BaseRegularPriorityCount)
+	}
+
+	regularPercentageAboveBase := 0
+	if input.RegularPriorityPercentageAboveBase != nil {
+		regularPercentageAboveBase = int(*input.RegularPriorityPercentageAboveBase)
+	}
+
+	// a policy without any regular instances is the same as not specifying one
+	if baseRegularCount == 0 && regularPercentageAboveBase == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_regular_count":            baseRegularCount,
+			"regular_percentage_above_base": regularPercentageAboveBase,
+		},
+	}
+}
+
+func ExpandOrchestratedVirtualMachineScaleSetOSProfile(input []interface{}, scaleSetName string) (*compute.VirtualMachineScaleSetOSProfile, compute.OperatingSystemTypes, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, "", nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := compute.VirtualMachineScaleSetOSProfile{}
+
+	if v := raw["custom_data"].(string); v != "" {
+		output.CustomData = utils.String(v)
+	}
+
+	if linuxRaw := raw["linux_configuration"].([]interface{}); len(linuxRaw) > 0 && linuxRaw[0] != nil {
+		linux := linuxRaw[0].(map[string]interface{})
+
+		computerNamePrefix := linux["computer_name_prefix"].(string)
+		if computerNamePrefix == "" {
+			if _, errs := validate.LinuxComputerNamePrefix(scaleSetName, "computer_name_prefix"); len(errs) > 0 {
+				return nil, "", fmt.Errorf("unable to assume default computer name prefix %s. Please adjust the %q, or specify an explicit %q", errs[0], "name", "computer_name_prefix")
+			}
+			computerNamePrefix = scaleSetName
+		}
+
+		disablePasswordAuthentication := linux["disable_password_authentication"].(bool)
+		sshKeys := ExpandSSHKeys(linux["admin_ssh_key"].(*pluginsdk.Set).List())
+
+		output.AdminUsername = utils.String(linux["admin_username"].(string))
+		output.ComputerNamePrefix = utils.String(computerNamePrefix)
+		output.LinuxConfiguration = &compute.LinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+			ProvisionVMAgent:              utils.Bool(linux["provision_vm_agent"].(bool)),
+			SSH: &compute.SSHConfiguration{
+				PublicKeys: &sshKeys,
+			},
+		}
+		output.Secrets = expandLinuxSecrets(linux["secret"].([]interface{}))
+
+		if v := linux["admin_password"].(string); v != "" {
+			output.AdminPassword = utils.String(v)
+		}
+
+		// Azure API: "Authentication using either SSH or by user name and password must be enabled in Linux profile."
+		if disablePasswordAuthentication && output.AdminPassword == nil && len(sshKeys) == 0 {
+			return nil, "", fmt.Errorf("At least one SSH key must be specified if `disable_password_authentication` is enabled")
+		}
+
+		return &output, compute.OperatingSystemTypesLinux, nil
+	}
+
+	if windowsRaw := raw["windows_configuration"].([]interface{}); len(windowsRaw) > 0 && windowsRaw[0] != nil {
+		windows := windowsRaw[0].(map[string]interface{})
+
+		computerNamePrefix := windows["computer_name_prefix"].(string)
+		if computerNamePrefix == "" {
+			if _, errs := validate.WindowsComputerNamePrefix(scaleSetName, "computer_name_prefix"); len(errs) > 0 {
+				return nil, "", fmt.Errorf("unable to assume default computer name prefix %s. Please adjust the %q, or specify an explicit %q", errs[0], "name", "computer_name_prefix")
+			}
+			computerNamePrefix = scaleSetName
+		}
+
+		output.AdminUsername = utils.String(windows["admin_username"].(string))
+		output.AdminPassword = utils.String(windows["admin_password"].(string))
+		output.ComputerNamePrefix = utils.String(computerNamePrefix)
+		output.WindowsConfiguration = &compute.WindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(windows["enable_automatic_updates"].(bool)),
+			ProvisionVMAgent:       utils.Bool(windows["provision_vm_agent"].(bool)),
+			WinRM:                  expandWinRMListener(windows["winrm_listener"].(*pluginsdk.Set).List()),
+		}
+		output.Secrets = expandWindowsSecrets(windows["secret"].([]interface{}))
+
+		if v := windows["additional_unattend_content"].([]interface{}); len(v) > 0 {
+			output.WindowsConfiguration.AdditionalUnattendContent = expandAdditionalUnattendContent(v)
+		}
+
+		if v := windows["timezone"].(string); v != "" {
+			output.WindowsConfiguration.TimeZone = utils.String(v)
+		}
+
+		return &output, compute.OperatingSystemTypesWindows, nil
+	}
+
+	return nil, "", fmt.Errorf("either a `linux_configuration` or a `windows_configuration` block must be specified within the `os_profile` block")
+}
+
+// FlattenOrchestratedVirtualMachineScaleSetOSProfile flattens the `os_profile` block - the API doesn't return the
+// `admin_password`, `custom_data` or the `content` of any `additional_unattend_content` so these are pulled from the state
+func FlattenOrchestratedVirtualMachineScaleSetOSProfile(input *compute.VirtualMachineScaleSetOSProfile, d *pluginsdk.ResourceData) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	adminUsername := ""
+	if input.AdminUsername != nil {
+		adminUsername = *input.AdminUsername
+	}
+
+	computerNamePrefix := ""
+	if input.ComputerNamePrefix != nil {
+		computerNamePrefix = *input.ComputerNamePrefix
+	}
+
+	output := map[string]interface{}{
+		"custom_data":           d.Get("os_profile.0.custom_data").(string),
+		"linux_configuration":   []interface{}{},
+		"windows_configuration": []interface{}{},
+	}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		disablePasswordAuthentication := false
+		if linux.DisablePasswordAuthentication != nil {
+			disablePasswordAuthentication = *linux.DisablePasswordAuthentication
+		}
+
+		provisionVMAgent := false
+		if linux.ProvisionVMAgent != nil {
+			provisionVMAgent = *linux.ProvisionVMAgent
+		}
+
+		flattenedSshKeys, err := FlattenSSHKeys(linux.SSH)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `admin_ssh_key`: %+v", err)
+		}
+
+		output["linux_configuration"] = []interface{}{
+			map[string]interface{}{
+				"admin_username":                  adminUsername,
+				"admin_password":                  d.Get("os_profile.0.linux_configuration.0.admin_password").(string),
+				"admin_ssh_key":                   pluginsdk.NewSet(SSHKeySchemaHash, *flattenedSshKeys),
+				"computer_name_prefix":            computerNamePrefix,
+				"disable_password_authentication": disablePasswordAuthentication,
+				"provision_vm_agent":              provisionVMAgent,
+				"secret":                          flattenLinuxSecrets(input.Secrets),
+			},
+		}
+	}
+
+	if windows := input.WindowsConfiguration; windows != nil {
+		enableAutomaticUpdates := false
+		if windows.EnableAutomaticUpdates != nil {
+			enableAutomaticUpdates = *windows.EnableAutomaticUpdates
+		}
+
+		provisionVMAgent := false
+		if windows.ProvisionVMAgent != nil {
+			provisionVMAgent = *windows.ProvisionVMAgent
+		}
+
+		timezone := ""
+		if windows.TimeZone != nil {
+			timezone = *windows.TimeZone
+		}
+
+		output["windows_configuration"] = []interface{}{
+			map[string]interface{}{
+				"admin_username":              adminUsername,
+				"admin_password":              d.Get("os_profile.0.windows_configuration.0.admin_password").(string),
+				"additional_unattend_content": flattenOrchestratedVirtualMachineScaleSetAdditionalUnattendContent(windows.AdditionalUnattendContent, d),
+				"computer_name_prefix":        computerNamePrefix,
+				"enable_automatic_updates":    enableAutomaticUpdates,
+				"provision_vm_agent":          provisionVMAgent,
+				"secret":                      flattenWindowsSecrets(input.Secrets),
+				"timezone":                    timezone,
+				"winrm_listener":              flattenWinRMListener(windows.WinRM),
+			},
+		}
+	}
+
+	return []interface{}{output}, nil
+}
+
+func flattenOrchestratedVirtualMachineScaleSetAdditionalUnattendContent(input *[]compute.AdditionalUnattendContent, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	existing := d.Get("os_profile.0.windows_configuration.0.additional_unattend_content").([]interface{})
+
+	output := make([]interface{}, 0)
+	for i, v := range *input {
+		// content isn't returned by the API since it's sensitive data so we need to look it up later
+		// where we can we'll try and match it to an existing block, but since this can't be guaranteed
+		// fall back to the same position in the list
+		content := ""
+		if len(existing) > i && existing[i] != nil {
+			existingVal := existing[i].(map[string]interface{})
+			if existingSetting, ok := existingVal["setting"]; ok && existingSetting.(string) == string(v.SettingName) {
+				content = existingVal["content"].(string)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"content": content,
+			"setting": string(v.SettingName),
+		})
+	}
+
+	return output
+}
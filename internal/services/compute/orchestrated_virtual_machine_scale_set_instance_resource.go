@@ -0,0 +1,357 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/sdk/2024-03-01/virtualmachines"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+const (
+	orchestratedVirtualMachineScaleSetInstancePowerStateDeallocated = "deallocated"
+	orchestratedVirtualMachineScaleSetInstancePowerStateRunning     = "running"
+	orchestratedVirtualMachineScaleSetInstancePowerStateStopped     = "stopped"
+)
+
+func resourceOrchestratedVirtualMachineScaleSetInstance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceOrchestratedVirtualMachineScaleSetInstanceCreate,
+		Read:   resourceOrchestratedVirtualMachineScaleSetInstanceRead,
+		Update: resourceOrchestratedVirtualMachineScaleSetInstanceUpdate,
+		Delete: resourceOrchestratedVirtualMachineScaleSetInstanceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OrchestratedVirtualMachineScaleSetInstanceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineName,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"power_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  orchestratedVirtualMachineScaleSetInstancePowerStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{
+					orchestratedVirtualMachineScaleSetInstancePowerStateDeallocated,
+					orchestratedVirtualMachineScaleSetInstancePowerStateRunning,
+					orchestratedVirtualMachineScaleSetInstancePowerStateStopped,
+				}, false),
+			},
+
+			"delete_on_destroy": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"virtual_machine_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"computer_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"platform_fault_domain": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrchestratedVirtualMachineScaleSetInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	attachmentClient := meta.(*clients.Client).Compute.VMScaleSetAttachmentClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewOrchestratedVirtualMachineScaleSetInstanceID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroup, scaleSetId.Name, d.Get("name").(string))
+
+	scaleSet, err := client.Get(ctx, scaleSetId.ResourceGroup, scaleSetId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *scaleSetId, err)
+	}
+	if err := assertOrchestratedVirtualMachineScaleSet(scaleSet); err != nil {
+		return fmt.Errorf("managing instances of %s: %+v", *scaleSetId, err)
+	}
+
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	// instances within a Scale Set using Flexible Orchestration are regular Virtual Machines which reference the Scale Set
+	vm, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Virtual Machine for %s: %+v", id, err)
+	}
+
+	if orchestratedVirtualMachineScaleSetContainsInstance(*scaleSetId, vm) {
+		return tf.ImportAsExistsError("azurerm_orchestrated_virtual_machine_scale_set_instance", id.ID())
+	}
+
+	if props := vm.VirtualMachineProperties; props != nil && props.VirtualMachineScaleSet != nil && props.VirtualMachineScaleSet.ID != nil && *props.VirtualMachineScaleSet.ID != "" {
+		return fmt.Errorf("the Virtual Machine %q (Resource Group %q) is already an instance of the Virtual Machine Scale Set %q", id.VirtualMachineName, id.ResourceGroup, *props.VirtualMachineScaleSet.ID)
+	}
+
+	log.Printf("[DEBUG] Attaching the Virtual Machine %q (Resource Group %q) to %s..", id.VirtualMachineName, id.ResourceGroup, *scaleSetId)
+	attachment := virtualmachines.VirtualMachineUpdate{
+		Properties: &virtualmachines.VirtualMachineUpdateProperties{
+			VirtualMachineScaleSet: &virtualmachines.SubResource{
+				Id: utils.String(scaleSetId.ID()),
+			},
+		},
+	}
+	if err := attachmentClient.UpdateThenPoll(ctx, virtualmachines.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName), attachment); err != nil {
+		return fmt.Errorf("attaching the Virtual Machine to %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := updateOrchestratedVirtualMachineScaleSetInstancePowerState(ctx, meta, id, d.Get("power_state").(string)); err != nil {
+		return err
+	}
+
+	return resourceOrchestratedVirtualMachineScaleSetInstanceRead(d, meta)
+}
+
+func resourceOrchestratedVirtualMachineScaleSetInstanceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrchestratedVirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	if d.HasChange("power_state") {
+		if err := updateOrchestratedVirtualMachineScaleSetInstancePowerState(ctx, meta, *id, d.Get("power_state").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceOrchestratedVirtualMachineScaleSetInstanceRead(d, meta)
+}
+
+func resourceOrchestratedVirtualMachineScaleSetInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrchestratedVirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	vm, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, compute.InstanceViewTypesInstanceView)
+	if err != nil {
+		if utils.ResponseWasNotFound(vm.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Virtual Machine for %s: %+v", *id, err)
+	}
+
+	// the Virtual Machine may have been removed from the Scale Set (or re-created outside of it)
+	if !orchestratedVirtualMachineScaleSetContainsInstance(scaleSetId, vm) {
+		log.Printf("[DEBUG] %s is no longer part of %s - removing from state", *id, scaleSetId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.VirtualMachineName)
+	d.Set("virtual_machine_scale_set_id", scaleSetId.ID())
+	d.Set("virtual_machine_id", parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID())
+
+	zone := ""
+	if vm.Zones != nil && len(*vm.Zones) > 0 {
+		zone = (*vm.Zones)[0]
+	}
+	d.Set("zone", zone)
+
+	if props := vm.VirtualMachineProperties; props != nil {
+		platformFaultDomain := 0
+		if props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+
+		computerName := ""
+		if props.OsProfile != nil && props.OsProfile.ComputerName != nil {
+			computerName = *props.OsProfile.ComputerName
+		}
+
+		d.Set("platform_fault_domain", platformFaultDomain)
+		d.Set("computer_name", computerName)
+
+		if props.InstanceView != nil {
+			if powerState := virtualMachinePowerState(*props.InstanceView); powerState != "" {
+				d.Set("power_state", powerState)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceOrchestratedVirtualMachineScaleSetInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	attachmentClient := meta.(*clients.Client).Compute.VMScaleSetAttachmentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrchestratedVirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	if !d.Get("delete_on_destroy").(bool) {
+		log.Printf("[DEBUG] Detaching the Virtual Machine %q (Resource Group %q) from %s..", id.VirtualMachineName, id.ResourceGroup, scaleSetId)
+		detachment := virtualmachines.VirtualMachineUpdate{
+			Properties: &virtualmachines.VirtualMachineUpdateProperties{
+				VirtualMachineScaleSet: nil,
+			},
+		}
+		if err := attachmentClient.UpdateThenPoll(ctx, virtualmachines.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName), detachment); err != nil {
+			return fmt.Errorf("detaching the Virtual Machine from %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+
+	instanceIds := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &[]string{id.VirtualMachineName},
+	}
+
+	future, err := client.DeleteInstances(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceIds, nil)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// updateOrchestratedVirtualMachineScaleSetInstancePowerState transitions the instance into the desired power state
+func updateOrchestratedVirtualMachineScaleSetInstancePowerState(ctx context.Context, meta interface{}, id parse.OrchestratedVirtualMachineScaleSetInstanceId, desiredState string) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+
+	vm, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, compute.InstanceViewTypesInstanceView)
+	if err != nil {
+		return fmt.Errorf("retrieving Virtual Machine for %s: %+v", id, err)
+	}
+
+	currentState := ""
+	if props := vm.VirtualMachineProperties; props != nil && props.InstanceView != nil {
+		currentState = virtualMachinePowerState(*props.InstanceView)
+	}
+
+	if strings.EqualFold(currentState, desiredState) {
+		return nil
+	}
+
+	instanceIds := &compute.VirtualMachineScaleSetVMInstanceIDs{
+		InstanceIds: &[]string{id.VirtualMachineName},
+	}
+
+	log.Printf("[DEBUG] Transitioning %s from %q to %q..", id, currentState, desiredState)
+	switch desiredState {
+	case orchestratedVirtualMachineScaleSetInstancePowerStateRunning:
+		future, err := client.Start(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceIds)
+		if err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to start: %+v", id, err)
+		}
+
+	case orchestratedVirtualMachineScaleSetInstancePowerStateStopped:
+		future, err := client.PowerOff(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceIds, utils.Bool(false))
+		if err != nil {
+			return fmt.Errorf("powering off %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to power off: %+v", id, err)
+		}
+
+	case orchestratedVirtualMachineScaleSetInstancePowerStateDeallocated:
+		future, err := client.Deallocate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceIds)
+		if err != nil {
+			return fmt.Errorf("deallocating %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to deallocate: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// orchestratedVirtualMachineScaleSetContainsInstance returns whether the Virtual Machine is an instance of the specified Scale Set
+func orchestratedVirtualMachineScaleSetContainsInstance(scaleSetId parse.VirtualMachineScaleSetId, vm compute.VirtualMachine) bool {
+	if vm.VirtualMachineProperties == nil || vm.VirtualMachineProperties.VirtualMachineScaleSet == nil || vm.VirtualMachineProperties.VirtualMachineScaleSet.ID == nil {
+		return false
+	}
+
+	// the API returns the Resource Group name in UPPERCASE, github issue: https://github.com/Azure/azure-rest-api-specs/issues/10016
+	return strings.EqualFold(*vm.VirtualMachineProperties.VirtualMachineScaleSet.ID, scaleSetId.ID())
+}
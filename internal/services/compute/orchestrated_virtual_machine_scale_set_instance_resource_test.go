@@ -0,0 +1,218 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type OrchestratedVirtualMachineScaleSetInstanceResource struct{}
+
+func TestAccOrchestratedVirtualMachineScaleSetInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set_instance", "test")
+	r := OrchestratedVirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("running"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOrchestratedVirtualMachineScaleSetInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set_instance", "test")
+	r := OrchestratedVirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccOrchestratedVirtualMachineScaleSetInstance_detach(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set_instance", "test")
+	r := OrchestratedVirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the resource detaches the Virtual Machine from the Scale Set, leaving it running
+			Config: r.template(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_linux_virtual_machine.test").Key("virtual_machine_scale_set_id").IsEmpty(),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOrchestratedVirtualMachineScaleSetInstance_powerState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set_instance", "test")
+	r := OrchestratedVirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerState(data, "deallocated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("deallocated"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerState(data, "running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("running"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r OrchestratedVirtualMachineScaleSetInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.OrchestratedVirtualMachineScaleSetInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, compute.InstanceViewTypes(""))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Virtual Machine for %s: %+v", *id, err)
+	}
+
+	if props := resp.VirtualMachineProperties; props == nil || props.VirtualMachineScaleSet == nil || props.VirtualMachineScaleSet.ID == nil {
+		return utils.Bool(false), nil
+	}
+
+	return utils.Bool(strings.EqualFold(*resp.VirtualMachineScaleSet.ID, parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID())), nil
+}
+
+func (r OrchestratedVirtualMachineScaleSetInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set_instance" "test" {
+  name                         = azurerm_linux_virtual_machine.test.name
+  virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+}
+`, r.template(data))
+}
+
+func (r OrchestratedVirtualMachineScaleSetInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set_instance" "import" {
+  name                         = azurerm_orchestrated_virtual_machine_scale_set_instance.test.name
+  virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set_instance.test.virtual_machine_scale_set_id
+}
+`, r.basic(data))
+}
+
+func (r OrchestratedVirtualMachineScaleSetInstanceResource) powerState(data acceptance.TestData, powerState string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set_instance" "test" {
+  name                         = azurerm_linux_virtual_machine.test.name
+  virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  power_state                  = %q
+}
+`, r.template(data), powerState)
+}
+
+func (r OrchestratedVirtualMachineScaleSetInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestVMO-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  platform_fault_domain_count = 1
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssword1234!"
+  disable_password_authentication = false
+
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  # the Virtual Machine is attached to the Scale Set by the instance resource
+  lifecycle {
+    ignore_changes = [virtual_machine_scale_set_id]
+  }
+}
+`, OrchestratedVirtualMachineScaleSetResource{}.networkTemplate(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
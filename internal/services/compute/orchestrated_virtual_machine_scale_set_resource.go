@@ -8,9 +8,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	azValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/sdk/2022-08-01/virtualmachinescalesets"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
@@ -78,6 +80,117 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 			// the VMO mode can only be deployed into one zone for now, and its zone will also be assigned to all its VM instances
 			"zones": azure.SchemaSingleZone(),
 
+			// the following are only applicable when a Virtual Machine Profile is specified (via the `os_profile` block)
+			"sku_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"os_profile"},
+			},
+
+			"instances": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+				RequiredWith: []string{"sku_name"},
+			},
+
+			"os_profile": OrchestratedVirtualMachineScaleSetOSProfileSchema(),
+
+			"network_interface": OrchestratedVirtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": OrchestratedVirtualMachineScaleSetOSDiskSchema(),
+
+			"data_disk": VirtualMachineScaleSetDataDiskSchema(),
+
+			"additional_capabilities": VirtualMachineScaleSetAdditionalCapabilitiesSchema(),
+
+			"automatic_instance_repair": VirtualMachineScaleSetAutomaticRepairsPolicySchema(),
+
+			"boot_diagnostics": bootDiagnosticsSchema(),
+
+			"encryption_at_host_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"eviction_policy": {
+				// only applicable when `priority` is set to `Spot`
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.VirtualMachineEvictionPolicyTypesDeallocate),
+					string(compute.VirtualMachineEvictionPolicyTypesDelete),
+				}, false),
+			},
+
+			"extension": VirtualMachineScaleSetExtensionsSchema(),
+
+			"extensions_time_budget": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT1H30M",
+				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
+			},
+
+			"identity": VirtualMachineScaleSetIdentitySchema(),
+
+			"license_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"None",
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+				DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+					if old == "None" && new == "" || old == "" && new == "None" {
+						return true
+					}
+
+					return false
+				},
+			},
+
+			"max_bid_price": {
+				Type:         pluginsdk.TypeFloat,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validate.SpotMaxPrice,
+			},
+
+			"plan": planSchema(),
+
+			"priority": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.VirtualMachinePriorityTypesRegular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.VirtualMachinePriorityTypesRegular),
+					string(compute.VirtualMachinePriorityTypesSpot),
+				}, false),
+			},
+
+			"priority_mix": OrchestratedVirtualMachineScaleSetPriorityMixSchema(),
+
+			"source_image_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validate.ImageID,
+					validate.SharedImageID,
+					validate.SharedImageVersionID,
+				),
+				ConflictsWith: []string{"source_image_reference"},
+			},
+
+			"source_image_reference": sourceImageReferenceSchema(false),
+
+			"terminate_notification": VirtualMachineScaleSetTerminateNotificationSchema(),
+
 			"unique_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -109,10 +222,20 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *pluginsdk.Resourc
 		}
 	}
 
+	identity, err := ExpandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
 	props := compute.VirtualMachineScaleSet{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Identity: identity,
+		Plan:     expandPlan(d.Get("plan").([]interface{})),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			AdditionalCapabilities:   ExpandVirtualMachineScaleSetAdditionalCapabilities(d.Get("additional_capabilities").([]interface{})),
+			AutomaticRepairsPolicy:   ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(d.Get("automatic_instance_repair").([]interface{})),
+			OrchestrationMode:        compute.OrchestrationModeFlexible,
 			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
 			SinglePlacementGroup:     utils.Bool(d.Get("single_placement_group").(bool)),
 		},
@@ -125,6 +248,38 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *pluginsdk.Resourc
 		}
 	}
 
+	virtualMachineProfile, err := expandOrchestratedVirtualMachineScaleSetVirtualMachineProfile(d, name)
+	if err != nil {
+		return err
+	}
+	props.VirtualMachineScaleSetProperties.VirtualMachineProfile = virtualMachineProfile
+
+	priorityMix := ExpandOrchestratedVirtualMachineScaleSetPriorityMix(d.Get("priority_mix").([]interface{}))
+	if len(d.Get("priority_mix").([]interface{})) > 0 && d.Get("priority").(string) != string(compute.VirtualMachinePriorityTypesSpot) {
+		return fmt.Errorf("a `priority_mix` block can only be specified when `priority` is set to `Spot`")
+	}
+
+	if v, ok := d.GetOk("sku_name"); ok {
+		props.Sku = &compute.Sku{
+			Name: utils.String(v.(string)),
+
+			// doesn't appear this can be set to anything else, even Promo machines are Standard
+			Tier: utils.String("Standard"),
+		}
+
+		if instances, ok := d.GetOk("instances"); ok {
+			props.Sku.Capacity = utils.Int64(int64(instances.(int)))
+		}
+	}
+
+	// the priority mix policy isn't available in the API version used for the Scale Set - so when one's specified the
+	// Scale Set is created without any instances, which are then added alongside the policy so that the mix is honoured
+	instances := int64(0)
+	if d.IsNewResource() && priorityMix != nil && props.Sku != nil && props.Sku.Capacity != nil {
+		instances = *props.Sku.Capacity
+		props.Sku.Capacity = utils.Int64(0)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, props)
 	if err != nil {
 		return fmt.Errorf("creating Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -134,6 +289,36 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *pluginsdk.Resourc
 		return fmt.Errorf("waiting for creation of Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if (d.IsNewResource() && priorityMix != nil) || (!d.IsNewResource() && d.HasChange("priority_mix")) {
+		priorityMixClient := meta.(*clients.Client).Compute.VMScaleSetPriorityMixClient
+		scaleSetId := virtualmachinescalesets.NewVirtualMachineScaleSetID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, name)
+
+		// removing the policy reverts the Scale Set to only using Spot instances
+		if priorityMix == nil {
+			priorityMix = &virtualmachinescalesets.PriorityMixPolicy{
+				BaseRegularPriorityCount:           utils.Int64(0),
+				RegularPriorityPercentageAboveBase: utils.Int64(0),
+			}
+		}
+
+		update := virtualmachinescalesets.VirtualMachineScaleSetUpdate{
+			Properties: &virtualmachinescalesets.VirtualMachineScaleSetUpdateProperties{
+				PriorityMixPolicy: priorityMix,
+			},
+		}
+		if d.IsNewResource() && props.Sku != nil {
+			update.Sku = &virtualmachinescalesets.Sku{
+				Capacity: utils.Int64(instances),
+				Name:     props.Sku.Name,
+				Tier:     props.Sku.Tier,
+			}
+		}
+
+		if err := priorityMixClient.UpdateThenPoll(ctx, scaleSetId, update); err != nil {
+			return fmt.Errorf("updating the priority mix policy of Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -172,6 +357,29 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
+	var skuName *string
+	var instances int
+	if resp.Sku != nil {
+		skuName = resp.Sku.Name
+		if resp.Sku.Capacity != nil {
+			instances = int(*resp.Sku.Capacity)
+		}
+	}
+	d.Set("sku_name", skuName)
+	d.Set("instances", instances)
+
+	identity, err := FlattenVirtualMachineScaleSetIdentity(resp.Identity)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenPlan(resp.Plan)); err != nil {
+		return fmt.Errorf("setting `plan`: %+v", err)
+	}
+
 	if props := resp.VirtualMachineScaleSetProperties; props != nil {
 		d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
 		d.Set("single_placement_group", props.SinglePlacementGroup)
@@ -181,6 +389,31 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 		}
 		d.Set("proximity_placement_group_id", proximityPlacementGroupID)
 		d.Set("unique_id", props.UniqueID)
+
+		if err := d.Set("additional_capabilities", FlattenVirtualMachineScaleSetAdditionalCapabilities(props.AdditionalCapabilities)); err != nil {
+			return fmt.Errorf("setting `additional_capabilities`: %+v", err)
+		}
+
+		if err := d.Set("automatic_instance_repair", FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(props.AutomaticRepairsPolicy)); err != nil {
+			return fmt.Errorf("setting `automatic_instance_repair`: %+v", err)
+		}
+
+		if err := flattenOrchestratedVirtualMachineScaleSetVirtualMachineProfile(d, props.VirtualMachineProfile); err != nil {
+			return err
+		}
+	}
+
+	priorityMixClient := meta.(*clients.Client).Compute.VMScaleSetPriorityMixClient
+	priorityMixResp, err := priorityMixClient.Get(ctx, virtualmachinescalesets.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving the priority mix policy of Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	var priorityMix *virtualmachinescalesets.PriorityMixPolicy
+	if model := priorityMixResp.Model; model != nil && model.Properties != nil {
+		priorityMix = model.Properties.PriorityMixPolicy
+	}
+	if err := d.Set("priority_mix", FlattenOrchestratedVirtualMachineScaleSetPriorityMix(priorityMix)); err != nil {
+		return fmt.Errorf("setting `priority_mix`: %+v", err)
 	}
 
 	if err := d.Set("zones", resp.Zones); err != nil {
@@ -215,3 +448,214 @@ func resourceOrchestratedVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData,
 
 	return nil
 }
+
+func expandOrchestratedVirtualMachineScaleSetVirtualMachineProfile(d *pluginsdk.ResourceData, name string) (*compute.VirtualMachineScaleSetVMProfile, error) {
+	osProfileRaw := d.Get("os_profile").([]interface{})
+	if len(osProfileRaw) == 0 {
+		// the remaining blocks are part of the Virtual Machine Profile, which can only be specified alongside an `os_profile`
+		for _, field := range []string{"network_interface", "os_disk", "data_disk", "extension", "source_image_id", "source_image_reference", "terminate_notification", "boot_diagnostics"} {
+			if v, ok := d.GetOk(field); ok && v != nil {
+				return nil, fmt.Errorf("an `os_profile` block must be specified when `%s` is set", field)
+			}
+		}
+
+		return nil, nil
+	}
+
+	osProfile, osType, err := ExpandOrchestratedVirtualMachineScaleSetOSProfile(osProfileRaw, name)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `os_profile`: %+v", err)
+	}
+
+	if _, ok := d.GetOk("sku_name"); !ok {
+		return nil, fmt.Errorf("`sku_name` must be specified when an `os_profile` block is specified")
+	}
+
+	osDiskRaw := d.Get("os_disk").([]interface{})
+	if len(osDiskRaw) == 0 {
+		return nil, fmt.Errorf("an `os_disk` block must be specified when an `os_profile` block is specified")
+	}
+
+	networkInterfaces, err := ExpandVirtualMachineScaleSetNetworkInterface(d.Get("network_interface").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `network_interface`: %+v", err)
+	}
+
+	ultraSSDEnabled := d.Get("additional_capabilities.0.ultra_ssd_enabled").(bool)
+	dataDisks, err := ExpandVirtualMachineScaleSetDataDisk(d.Get("data_disk").([]interface{}), ultraSSDEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `data_disk`: %+v", err)
+	}
+
+	sourceImageReference, err := expandSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	profile := compute.VirtualMachineScaleSetVMProfile{
+		Priority:           priority,
+		OsProfile:          osProfile,
+		DiagnosticsProfile: expandBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		NetworkProfile: &compute.VirtualMachineScaleSetNetworkProfile{
+			NetworkInterfaceConfigurations: networkInterfaces,
+		},
+		StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+			ImageReference: sourceImageReference,
+			OsDisk:         ExpandVirtualMachineScaleSetOSDisk(osDiskRaw, osType),
+			DataDisks:      dataDisks,
+		},
+	}
+
+	if v, ok := d.GetOk("extension"); ok {
+		profile.ExtensionProfile, _, err = expandVirtualMachineScaleSetExtensions(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := d.GetOk("extensions_time_budget"); ok {
+		if profile.ExtensionProfile == nil {
+			profile.ExtensionProfile = &compute.VirtualMachineScaleSetExtensionProfile{}
+		}
+		profile.ExtensionProfile.ExtensionsTimeBudget = utils.String(v.(string))
+	}
+
+	if v, ok := d.Get("max_bid_price").(float64); ok && v > 0 {
+		if priority != compute.VirtualMachinePriorityTypesSpot {
+			return nil, fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Spot`")
+		}
+
+		profile.BillingProfile = &compute.BillingProfile{
+			MaxPrice: utils.Float(v),
+		}
+	}
+
+	if evictionPolicyRaw, ok := d.GetOk("eviction_policy"); ok {
+		if priority != compute.VirtualMachinePriorityTypesSpot {
+			return nil, fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to `Spot`")
+		}
+		profile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(evictionPolicyRaw.(string))
+	} else if priority == compute.VirtualMachinePriorityTypesSpot {
+		return nil, fmt.Errorf("An `eviction_policy` must be specified when `priority` is set to `Spot`")
+	}
+
+	if encryptionAtHostEnabled, ok := d.GetOk("encryption_at_host_enabled"); ok {
+		profile.SecurityProfile = &compute.SecurityProfile{
+			EncryptionAtHost: utils.Bool(encryptionAtHostEnabled.(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		if osType != compute.OperatingSystemTypesWindows {
+			return nil, fmt.Errorf("`license_type` can only be specified when a `windows_configuration` block is specified")
+		}
+		profile.LicenseType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("terminate_notification"); ok {
+		profile.ScheduledEventsProfile = ExpandVirtualMachineScaleSetScheduledEventsProfile(v.([]interface{}))
+	}
+
+	return &profile, nil
+}
+
+func flattenOrchestratedVirtualMachineScaleSetVirtualMachineProfile(d *pluginsdk.ResourceData, profile *compute.VirtualMachineScaleSetVMProfile) error {
+	// defaulted since these aren't returned when there's no Virtual Machine Profile
+	maxBidPrice := float64(-1.0)
+	priority := compute.VirtualMachinePriorityTypesRegular
+	extensionsTimeBudget := "PT1H30M"
+	encryptionAtHostEnabled := false
+	evictionPolicy := ""
+	licenseType := ""
+
+	if profile == nil {
+		d.Set("max_bid_price", maxBidPrice)
+		d.Set("priority", string(priority))
+		d.Set("extensions_time_budget", extensionsTimeBudget)
+		d.Set("encryption_at_host_enabled", encryptionAtHostEnabled)
+		d.Set("eviction_policy", evictionPolicy)
+		d.Set("license_type", licenseType)
+		return nil
+	}
+
+	if err := d.Set("boot_diagnostics", flattenBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
+		return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
+	}
+
+	if profile.BillingProfile != nil && profile.BillingProfile.MaxPrice != nil {
+		maxBidPrice = *profile.BillingProfile.MaxPrice
+	}
+	d.Set("max_bid_price", maxBidPrice)
+
+	// the service just return empty when this is not assigned when provisioned
+	// See discussion on https://github.com/Azure/azure-rest-api-specs/issues/10971
+	if profile.Priority != "" {
+		priority = profile.Priority
+	}
+	d.Set("priority", string(priority))
+	d.Set("eviction_policy", string(profile.EvictionPolicy))
+
+	if profile.LicenseType != nil {
+		licenseType = *profile.LicenseType
+	}
+	d.Set("license_type", licenseType)
+
+	osProfile, err := FlattenOrchestratedVirtualMachineScaleSetOSProfile(profile.OsProfile, d)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("os_profile", osProfile); err != nil {
+		return fmt.Errorf("setting `os_profile`: %+v", err)
+	}
+
+	if storageProfile := profile.StorageProfile; storageProfile != nil {
+		if err := d.Set("os_disk", FlattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+			return fmt.Errorf("setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", FlattenVirtualMachineScaleSetDataDisk(storageProfile.DataDisks)); err != nil {
+			return fmt.Errorf("setting `data_disk`: %+v", err)
+		}
+
+		if err := d.Set("source_image_reference", flattenSourceImageReference(storageProfile.ImageReference)); err != nil {
+			return fmt.Errorf("setting `source_image_reference`: %+v", err)
+		}
+
+		var storageImageId string
+		if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+			storageImageId = *storageProfile.ImageReference.ID
+		}
+		d.Set("source_image_id", storageImageId)
+	}
+
+	if nwProfile := profile.NetworkProfile; nwProfile != nil {
+		if err := d.Set("network_interface", FlattenVirtualMachineScaleSetNetworkInterface(nwProfile.NetworkInterfaceConfigurations)); err != nil {
+			return fmt.Errorf("setting `network_interface`: %+v", err)
+		}
+	}
+
+	if scheduleProfile := profile.ScheduledEventsProfile; scheduleProfile != nil {
+		if err := d.Set("terminate_notification", FlattenVirtualMachineScaleSetScheduledEventsProfile(scheduleProfile)); err != nil {
+			return fmt.Errorf("setting `terminate_notification`: %+v", err)
+		}
+	}
+
+	extensionProfile, err := flattenVirtualMachineScaleSetExtensions(profile.ExtensionProfile, d)
+	if err != nil {
+		return fmt.Errorf("failed flattening `extension`: %+v", err)
+	}
+	d.Set("extension", extensionProfile)
+
+	if profile.ExtensionProfile != nil && profile.ExtensionProfile.ExtensionsTimeBudget != nil {
+		extensionsTimeBudget = *profile.ExtensionProfile.ExtensionsTimeBudget
+	}
+	d.Set("extensions_time_budget", extensionsTimeBudget)
+
+	if profile.SecurityProfile != nil && profile.SecurityProfile.EncryptionAtHost != nil {
+		encryptionAtHostEnabled = *profile.SecurityProfile.EncryptionAtHost
+	}
+	d.Set("encryption_at_host_enabled", encryptionAtHostEnabled)
+
+	return nil
+}
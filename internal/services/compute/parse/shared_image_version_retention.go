@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type SharedImageVersionRetentionId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	GalleryName         string
+	ImageName           string
+	RetentionPolicyName string
+}
+
+func NewSharedImageVersionRetentionID(subscriptionId, resourceGroup, galleryName, imageName, retentionPolicyName string) SharedImageVersionRetentionId {
+	return SharedImageVersionRetentionId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		GalleryName:         galleryName,
+		ImageName:           imageName,
+		RetentionPolicyName: retentionPolicyName,
+	}
+}
+
+func (id SharedImageVersionRetentionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Retention Policy Name %q", id.RetentionPolicyName),
+		fmt.Sprintf("Image Name %q", id.ImageName),
+		fmt.Sprintf("Gallery Name %q", id.GalleryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Shared Image Version Retention", segmentsStr)
+}
+
+func (id SharedImageVersionRetentionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/retentionPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.RetentionPolicyName)
+}
+
+// SharedImageVersionRetentionID parses a SharedImageVersionRetention ID into an SharedImageVersionRetentionId struct
+func SharedImageVersionRetentionID(input string) (*SharedImageVersionRetentionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SharedImageVersionRetentionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+	if resourceId.RetentionPolicyName, err = id.PopSegment("retentionPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type VirtualMachineRunCommandId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	RunCommandName     string
+}
+
+func NewVirtualMachineRunCommandID(subscriptionId, resourceGroup, virtualMachineName, runCommandName string) VirtualMachineRunCommandId {
+	return VirtualMachineRunCommandId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		RunCommandName:     runCommandName,
+	}
+}
+
+func (id VirtualMachineRunCommandId) String() string {
+	segments := []string{
+		fmt.Sprintf("Run Command Name %q", id.RunCommandName),
+		fmt.Sprintf("Virtual Machine Name %q", id.VirtualMachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Run Command", segmentsStr)
+}
+
+func (id VirtualMachineRunCommandId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/runCommands/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+}
+
+// VirtualMachineRunCommandID parses a VirtualMachineRunCommand ID into an VirtualMachineRunCommandId struct
+func VirtualMachineRunCommandID(input string) (*VirtualMachineRunCommandId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineRunCommandId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+	if resourceId.RunCommandName, err = id.PopSegment("runCommands"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
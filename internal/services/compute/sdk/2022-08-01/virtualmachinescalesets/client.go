@@ -0,0 +1,15 @@
+package virtualmachinescalesets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachineScaleSetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineScaleSetsClientWithBaseURI(endpoint string) VirtualMachineScaleSetsClient {
+	return VirtualMachineScaleSetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
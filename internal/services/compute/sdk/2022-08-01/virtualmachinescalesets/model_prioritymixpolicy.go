@@ -0,0 +1,6 @@
+package virtualmachinescalesets
+
+type PriorityMixPolicy struct {
+	BaseRegularPriorityCount           *int64 `json:"baseRegularPriorityCount,omitempty"`
+	RegularPriorityPercentageAboveBase *int64 `json:"regularPriorityPercentageAboveBase,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSetProperties struct {
+	PriorityMixPolicy *PriorityMixPolicy `json:"priorityMixPolicy,omitempty"`
+}
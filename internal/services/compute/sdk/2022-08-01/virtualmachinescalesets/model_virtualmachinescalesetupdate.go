@@ -0,0 +1,7 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSetUpdate struct {
+	Properties *VirtualMachineScaleSetUpdateProperties `json:"properties,omitempty"`
+	Sku        *Sku                                    `json:"sku,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
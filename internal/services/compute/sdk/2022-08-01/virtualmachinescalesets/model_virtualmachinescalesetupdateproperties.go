@@ -0,0 +1,5 @@
+package virtualmachinescalesets
+
+type VirtualMachineScaleSetUpdateProperties struct {
+	PriorityMixPolicy *PriorityMixPolicy `json:"priorityMixPolicy,omitempty"`
+}
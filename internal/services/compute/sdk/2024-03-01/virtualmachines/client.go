@@ -0,0 +1,15 @@
+package virtualmachines
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachinesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachinesClientWithBaseURI(endpoint string) VirtualMachinesClient {
+	return VirtualMachinesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
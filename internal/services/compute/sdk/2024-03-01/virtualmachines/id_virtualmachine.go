@@ -0,0 +1,108 @@
+package virtualmachines
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VirtualMachineId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+}
+
+func NewVirtualMachineID(subscriptionId, resourceGroup, virtualMachineName string) VirtualMachineId {
+	return VirtualMachineId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+	}
+}
+
+func (id VirtualMachineId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Machine Name %q", id.VirtualMachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine", segmentsStr)
+}
+
+func (id VirtualMachineId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+}
+
+// ParseVirtualMachineID parses a VirtualMachine ID into an VirtualMachineId struct
+func ParseVirtualMachineID(input string) (*VirtualMachineId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseVirtualMachineIDInsensitively parses an VirtualMachine ID into an VirtualMachineId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseVirtualMachineID method should be used instead for validation etc.
+func ParseVirtualMachineIDInsensitively(input string) (*VirtualMachineId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'virtualMachines' segment
+	virtualMachinesKey := "virtualMachines"
+	for key := range id.Path {
+		if strings.EqualFold(key, virtualMachinesKey) {
+			virtualMachinesKey = key
+			break
+		}
+	}
+	if resourceId.VirtualMachineName, err = id.PopSegment(virtualMachinesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
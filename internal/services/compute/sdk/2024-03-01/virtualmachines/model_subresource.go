@@ -0,0 +1,5 @@
+package virtualmachines
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachines
+
+type VirtualMachineUpdate struct {
+	Properties *VirtualMachineUpdateProperties `json:"properties,omitempty"`
+}
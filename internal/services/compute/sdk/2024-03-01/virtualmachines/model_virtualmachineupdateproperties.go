@@ -0,0 +1,6 @@
+package virtualmachines
+
+type VirtualMachineUpdateProperties struct {
+	// a nil value is sent as `null`, which detaches the Virtual Machine from the Scale Set
+	VirtualMachineScaleSet *SubResource `json:"virtualMachineScaleSet"`
+}
@@ -0,0 +1,209 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type sharedImageVersionRetentionPolicy struct {
+	KeepNewestCount       int
+	ExcludeFromLatest     bool
+	EndOfLifeInDays       int
+	RegionalReplicaCount  int
+	DeleteExpiredVersions bool
+}
+
+// sharedImageVersionRetentionAction describes the change required to bring a retired Shared Image Version
+// in line with the Retention Policy - either it's deleted, or the fields which are non-nil are updated
+type sharedImageVersionRetentionAction struct {
+	Name                 string
+	Delete               bool
+	ExcludeFromLatest    *bool
+	EndOfLifeDate        *time.Time
+	RegionalReplicaCount *int
+}
+
+type sharedImageVersionRetentionPlan struct {
+	RetainedVersions []string
+	RetiredVersions  []string
+	Actions          []sharedImageVersionRetentionAction
+}
+
+func (p sharedImageVersionRetentionPlan) PendingVersions() []string {
+	output := make([]string, 0)
+	for _, action := range p.Actions {
+		output = append(output, action.Name)
+	}
+	return output
+}
+
+// listSharedImageVersions returns all of the Versions within the specified Shared Image
+func listSharedImageVersions(ctx context.Context, client *compute.GalleryImageVersionsClient, resourceGroup, galleryName, imageName string) ([]compute.GalleryImageVersion, error) {
+	resp, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response().Response) {
+			return nil, fmt.Errorf("No Versions were found for Shared Image %q / Gallery %q / Resource Group %q", imageName, galleryName, resourceGroup)
+		}
+		return nil, fmt.Errorf("retrieving Shared Image Versions (Image %q / Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+	}
+
+	images := make([]compute.GalleryImageVersion, 0)
+	for resp.NotDone() {
+		images = append(images, resp.Value())
+		if err := resp.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing next page of images for Shared Image %q / Gallery %q / Resource Group %q: %+v", imageName, galleryName, resourceGroup, err)
+		}
+	}
+
+	return images, nil
+}
+
+// planSharedImageVersionRetention determines which Shared Image Versions should be retained, and the changes
+// required to the remaining (retired) versions to conform to the specified Retention Policy.
+// Versions are ordered by their version number (e.g. `1.10.0` is newer than `1.9.0`) so that this is deterministic.
+func planSharedImageVersionRetention(versions []compute.GalleryImageVersion, policy sharedImageVersionRetentionPolicy, now time.Time) sharedImageVersionRetentionPlan {
+	sorted := make([]compute.GalleryImageVersion, 0)
+	for _, v := range versions {
+		if v.Name != nil {
+			sorted = append(sorted, v)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareSharedImageVersionNames(*sorted[i].Name, *sorted[j].Name) > 0
+	})
+
+	plan := sharedImageVersionRetentionPlan{
+		RetainedVersions: make([]string, 0),
+		RetiredVersions:  make([]string, 0),
+		Actions:          make([]sharedImageVersionRetentionAction, 0),
+	}
+
+	for i, version := range sorted {
+		name := *version.Name
+		if i < policy.KeepNewestCount {
+			plan.RetainedVersions = append(plan.RetainedVersions, name)
+			continue
+		}
+
+		plan.RetiredVersions = append(plan.RetiredVersions, name)
+
+		var profile compute.GalleryImageVersionPublishingProfile
+		if props := version.GalleryImageVersionProperties; props != nil && props.PublishingProfile != nil {
+			profile = *props.PublishingProfile
+		}
+
+		if profile.EndOfLifeDate != nil && policy.DeleteExpiredVersions && !profile.EndOfLifeDate.After(now) {
+			plan.Actions = append(plan.Actions, sharedImageVersionRetentionAction{
+				Name:   name,
+				Delete: true,
+			})
+			continue
+		}
+
+		action := sharedImageVersionRetentionAction{
+			Name: name,
+		}
+		requiresUpdate := false
+
+		if policy.ExcludeFromLatest && (profile.ExcludeFromLatest == nil || !*profile.ExcludeFromLatest) {
+			action.ExcludeFromLatest = utils.Bool(true)
+			requiresUpdate = true
+		}
+
+		if policy.EndOfLifeInDays > 0 && profile.EndOfLifeDate == nil {
+			// truncated to the day so that the same date is calculated throughout a single day
+			endOfLife := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, policy.EndOfLifeInDays)
+			action.EndOfLifeDate = &endOfLife
+			requiresUpdate = true
+		}
+
+		if policy.RegionalReplicaCount > 0 && profile.TargetRegions != nil {
+			for _, region := range *profile.TargetRegions {
+				// replica counts are only ever reduced, so that a version isn't replicated further when it's retired
+				if region.RegionalReplicaCount != nil && int(*region.RegionalReplicaCount) > policy.RegionalReplicaCount {
+					action.RegionalReplicaCount = utils.Int(policy.RegionalReplicaCount)
+					requiresUpdate = true
+					break
+				}
+			}
+		}
+
+		if requiresUpdate {
+			plan.Actions = append(plan.Actions, action)
+		}
+	}
+
+	return plan
+}
+
+// expandSharedImageVersionRetentionUpdate applies the specified action to the existing Shared Image Version
+func expandSharedImageVersionRetentionUpdate(existing compute.GalleryImageVersion, action sharedImageVersionRetentionAction) compute.GalleryImageVersionUpdate {
+	props := compute.GalleryImageVersionProperties{}
+	if existing.GalleryImageVersionProperties != nil {
+		props.StorageProfile = existing.GalleryImageVersionProperties.StorageProfile
+		if existingProfile := existing.GalleryImageVersionProperties.PublishingProfile; existingProfile != nil {
+			profile := *existingProfile
+			props.PublishingProfile = &profile
+		}
+	}
+	if props.PublishingProfile == nil {
+		props.PublishingProfile = &compute.GalleryImageVersionPublishingProfile{}
+	}
+
+	if action.ExcludeFromLatest != nil {
+		props.PublishingProfile.ExcludeFromLatest = action.ExcludeFromLatest
+	}
+
+	if action.EndOfLifeDate != nil {
+		props.PublishingProfile.EndOfLifeDate = &date.Time{Time: *action.EndOfLifeDate}
+	}
+
+	if action.RegionalReplicaCount != nil && props.PublishingProfile.TargetRegions != nil {
+		regions := make([]compute.TargetRegion, 0)
+		for _, region := range *props.PublishingProfile.TargetRegions {
+			if region.RegionalReplicaCount != nil && int(*region.RegionalReplicaCount) > *action.RegionalReplicaCount {
+				region.RegionalReplicaCount = utils.Int32(int32(*action.RegionalReplicaCount))
+			}
+			regions = append(regions, region)
+		}
+		props.PublishingProfile.TargetRegions = &regions
+	}
+
+	return compute.GalleryImageVersionUpdate{
+		GalleryImageVersionProperties: &props,
+		Tags:                          existing.Tags,
+	}
+}
+
+// compareSharedImageVersionNames compares two Shared Image Version names (in the format `Major.Minor.Patch`)
+// numerically, returning a positive number when `a` is newer than `b`, a negative number when `a` is older
+// than `b` and zero when they're equal
+func compareSharedImageVersionNames(a, b string) int {
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		aVal, aErr := strconv.Atoi(aSegments[i])
+		bVal, bErr := strconv.Atoi(bSegments[i])
+		if aErr != nil || bErr != nil {
+			if c := strings.Compare(aSegments[i], bSegments[i]); c != 0 {
+				return c
+			}
+			continue
+		}
+
+		if aVal != bVal {
+			return aVal - bVal
+		}
+	}
+
+	return len(aSegments) - len(bSegments)
+}
@@ -0,0 +1,248 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceSharedImageVersionRetention() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSharedImageVersionRetentionCreateUpdate,
+		Read:   resourceSharedImageVersionRetentionRead,
+		Update: resourceSharedImageVersionRetentionCreateUpdate,
+		Delete: resourceSharedImageVersionRetentionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SharedImageVersionRetentionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"shared_image_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedImageID,
+			},
+
+			"keep_newest_count": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"exclude_from_latest": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"end_of_life_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"regional_replica_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+
+			"delete_expired_versions": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"retained_versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"retired_versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"pending_versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		// new versions are published (and existing versions expire) outside of Terraform, as such when
+		// the last refresh found versions which don't conform to the policy we need to trigger an update
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if diff.Id() == "" {
+				return nil
+			}
+
+			if pending := diff.Get("pending_versions").([]interface{}); len(pending) > 0 {
+				return diff.SetNewComputed("pending_versions")
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceSharedImageVersionRetentionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	imagesClient := meta.(*clients.Client).Compute.GalleryImagesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	sharedImageId, err := parse.SharedImageID(d.Get("shared_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewSharedImageVersionRetentionID(sharedImageId.SubscriptionId, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName, "default")
+
+	if _, err := imagesClient.Get(ctx, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *sharedImageId, err)
+	}
+
+	locks.ByID(sharedImageId.ID())
+	defer locks.UnlockByID(sharedImageId.ID())
+
+	versions, err := listSharedImageVersions(ctx, client, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName)
+	if err != nil {
+		return err
+	}
+
+	policy := expandSharedImageVersionRetentionPolicy(d)
+	plan := planSharedImageVersionRetention(versions, policy, time.Now())
+
+	existing := make(map[string]compute.GalleryImageVersion)
+	for _, version := range versions {
+		if version.Name != nil {
+			existing[*version.Name] = version
+		}
+	}
+
+	for _, action := range plan.Actions {
+		versionId := parse.NewSharedImageVersionID(sharedImageId.SubscriptionId, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName, action.Name)
+
+		if action.Delete {
+			log.Printf("[DEBUG] Deleting expired %s..", versionId)
+			future, err := client.Delete(ctx, versionId.ResourceGroup, versionId.GalleryName, versionId.ImageName, versionId.VersionName)
+			if err != nil {
+				return fmt.Errorf("deleting expired %s: %+v", versionId, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of expired %s: %+v", versionId, err)
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Retiring %s..", versionId)
+		update := expandSharedImageVersionRetentionUpdate(existing[action.Name], action)
+		future, err := client.Update(ctx, versionId.ResourceGroup, versionId.GalleryName, versionId.ImageName, versionId.VersionName, update)
+		if err != nil {
+			return fmt.Errorf("retiring %s: %+v", versionId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be retired: %+v", versionId, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSharedImageVersionRetentionRead(d, meta)
+}
+
+func resourceSharedImageVersionRetentionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	imagesClient := meta.(*clients.Client).Compute.GalleryImagesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SharedImageVersionRetentionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sharedImageId := parse.NewSharedImageID(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName)
+
+	image, err := imagesClient.Get(ctx, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName)
+	if err != nil {
+		if utils.ResponseWasNotFound(image.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", sharedImageId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", sharedImageId, err)
+	}
+
+	versions, err := listSharedImageVersions(ctx, client, sharedImageId.ResourceGroup, sharedImageId.GalleryName, sharedImageId.ImageName)
+	if err != nil {
+		return err
+	}
+
+	d.Set("shared_image_id", sharedImageId.ID())
+
+	plan := planSharedImageVersionRetention(versions, expandSharedImageVersionRetentionPolicy(d), time.Now())
+
+	if err := d.Set("retained_versions", plan.RetainedVersions); err != nil {
+		return fmt.Errorf("setting `retained_versions`: %+v", err)
+	}
+
+	if err := d.Set("retired_versions", plan.RetiredVersions); err != nil {
+		return fmt.Errorf("setting `retired_versions`: %+v", err)
+	}
+
+	if err := d.Set("pending_versions", plan.PendingVersions()); err != nil {
+		return fmt.Errorf("setting `pending_versions`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceSharedImageVersionRetentionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	if _, err := parse.SharedImageVersionRetentionID(d.Id()); err != nil {
+		return err
+	}
+
+	// the Retention Policy only exists within Terraform, so removing it leaves the Shared Image Versions as-is
+	return nil
+}
+
+func expandSharedImageVersionRetentionPolicy(d *pluginsdk.ResourceData) sharedImageVersionRetentionPolicy {
+	return sharedImageVersionRetentionPolicy{
+		KeepNewestCount:       d.Get("keep_newest_count").(int),
+		ExcludeFromLatest:     d.Get("exclude_from_latest").(bool),
+		EndOfLifeInDays:       d.Get("end_of_life_in_days").(int),
+		RegionalReplicaCount:  d.Get("regional_replica_count").(int),
+		DeleteExpiredVersions: d.Get("delete_expired_versions").(bool),
+	}
+}
@@ -0,0 +1,140 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/compute/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type SharedImageVersionRetentionResource struct{}
+
+func TestAccSharedImageVersionRetention_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version_retention", "test")
+	r := SharedImageVersionRetentionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: SharedImageVersionResource{}.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("retained_versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("retained_versions.0").HasValue("0.0.2"),
+				check.That(data.ResourceName).Key("retired_versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("retired_versions.0").HasValue("0.0.1"),
+				check.That(data.ResourceName).Key("pending_versions.#").HasValue("0"),
+			),
+		},
+		{
+			Config: r.retainAll(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("retained_versions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("retired_versions.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (r SharedImageVersionRetentionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SharedImageVersionRetentionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.GalleryImagesClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Shared Image for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SharedImageVersionRetentionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version_retention" "test" {
+  shared_image_id        = azurerm_shared_image.test.id
+  keep_newest_count      = 1
+  exclude_from_latest    = true
+  end_of_life_in_days    = 30
+  regional_replica_count = 1
+
+  depends_on = [
+    azurerm_shared_image_version.first,
+    azurerm_shared_image_version.second,
+  ]
+}
+`, r.template(data))
+}
+
+func (r SharedImageVersionRetentionResource) retainAll(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version_retention" "test" {
+  shared_image_id   = azurerm_shared_image.test.id
+  keep_newest_count = 5
+
+  depends_on = [
+    azurerm_shared_image_version.first,
+    azurerm_shared_image_version.second,
+  ]
+}
+`, r.template(data))
+}
+
+func (SharedImageVersionRetentionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "first" {
+  name                = "0.0.1"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+
+  lifecycle {
+    ignore_changes = [exclude_from_latest]
+  }
+}
+
+resource "azurerm_shared_image_version" "second" {
+  name                = "0.0.2"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+
+  depends_on = [azurerm_shared_image_version.first]
+}
+`, SharedImageVersionResource{}.provision(data))
+}
@@ -0,0 +1,259 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func TestCompareSharedImageVersionNames(t *testing.T) {
+	testData := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{
+			A:        "1.0.0",
+			B:        "1.0.0",
+			Expected: 0,
+		},
+		{
+			A:        "1.10.0",
+			B:        "1.9.0",
+			Expected: 1,
+		},
+		{
+			A:        "1.9.0",
+			B:        "1.10.0",
+			Expected: -1,
+		},
+		{
+			A:        "2.0.0",
+			B:        "1.99.99",
+			Expected: 1,
+		},
+		{
+			A:        "1.0.1",
+			B:        "1.0.0",
+			Expected: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q vs %q", v.A, v.B)
+
+		actual := compareSharedImageVersionNames(v.A, v.B)
+		if (actual > 0 && v.Expected <= 0) || (actual < 0 && v.Expected >= 0) || (actual == 0 && v.Expected != 0) {
+			t.Fatalf("Expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
+
+func TestPlanSharedImageVersionRetention(t *testing.T) {
+	now := time.Date(2021, 11, 15, 13, 30, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+
+	buildVersion := func(name string, excludeFromLatest bool, endOfLife *time.Time, replicaCount int32) compute.GalleryImageVersion {
+		profile := compute.GalleryImageVersionPublishingProfile{
+			ExcludeFromLatest: utils.Bool(excludeFromLatest),
+			TargetRegions: &[]compute.TargetRegion{
+				{
+					Name:                 utils.String("westeurope"),
+					RegionalReplicaCount: utils.Int32(replicaCount),
+				},
+			},
+		}
+		if endOfLife != nil {
+			profile.EndOfLifeDate = &date.Time{Time: *endOfLife}
+		}
+
+		return compute.GalleryImageVersion{
+			Name: utils.String(name),
+			GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+				PublishingProfile: &profile,
+			},
+		}
+	}
+
+	expectedEndOfLife := time.Date(2021, 11, 22, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name     string
+		Versions []compute.GalleryImageVersion
+		Policy   sharedImageVersionRetentionPolicy
+		Expected sharedImageVersionRetentionPlan
+	}{
+		{
+			Name:     "No Versions",
+			Versions: []compute.GalleryImageVersion{},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:   2,
+				ExcludeFromLatest: true,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{},
+				RetiredVersions:  []string{},
+				Actions:          []sharedImageVersionRetentionAction{},
+			},
+		},
+		{
+			Name: "Fewer Versions than Retained",
+			Versions: []compute.GalleryImageVersion{
+				buildVersion("1.0.0", false, nil, 1),
+			},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:   2,
+				ExcludeFromLatest: true,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{"1.0.0"},
+				RetiredVersions:  []string{},
+				Actions:          []sharedImageVersionRetentionAction{},
+			},
+		},
+		{
+			Name: "Excluded from Latest, ordered numerically",
+			Versions: []compute.GalleryImageVersion{
+				buildVersion("1.9.0", false, nil, 1),
+				buildVersion("1.10.0", false, nil, 1),
+				buildVersion("1.2.0", true, nil, 1),
+			},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:   1,
+				ExcludeFromLatest: true,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{"1.10.0"},
+				RetiredVersions:  []string{"1.9.0", "1.2.0"},
+				Actions: []sharedImageVersionRetentionAction{
+					{
+						Name:              "1.9.0",
+						ExcludeFromLatest: utils.Bool(true),
+					},
+				},
+			},
+		},
+		{
+			Name: "End of Life and Replica Count",
+			Versions: []compute.GalleryImageVersion{
+				buildVersion("2.0.0", false, nil, 3),
+				buildVersion("1.0.0", true, nil, 3),
+			},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:      1,
+				ExcludeFromLatest:    true,
+				EndOfLifeInDays:      7,
+				RegionalReplicaCount: 1,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{"2.0.0"},
+				RetiredVersions:  []string{"1.0.0"},
+				Actions: []sharedImageVersionRetentionAction{
+					{
+						Name:                 "1.0.0",
+						EndOfLifeDate:        &expectedEndOfLife,
+						RegionalReplicaCount: utils.Int(1),
+					},
+				},
+			},
+		},
+		{
+			Name: "Expired Versions are Deleted",
+			Versions: []compute.GalleryImageVersion{
+				buildVersion("3.0.0", false, &yesterday, 1),
+				buildVersion("2.0.0", true, &tomorrow, 1),
+				buildVersion("1.0.0", true, &yesterday, 1),
+			},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:       1,
+				ExcludeFromLatest:     true,
+				DeleteExpiredVersions: true,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{"3.0.0"},
+				RetiredVersions:  []string{"2.0.0", "1.0.0"},
+				Actions: []sharedImageVersionRetentionAction{
+					{
+						Name:   "1.0.0",
+						Delete: true,
+					},
+				},
+			},
+		},
+		{
+			Name: "Expired Versions are Retained when Deletion is Disabled",
+			Versions: []compute.GalleryImageVersion{
+				buildVersion("2.0.0", false, nil, 1),
+				buildVersion("1.0.0", true, &yesterday, 1),
+			},
+			Policy: sharedImageVersionRetentionPolicy{
+				KeepNewestCount:   1,
+				ExcludeFromLatest: true,
+			},
+			Expected: sharedImageVersionRetentionPlan{
+				RetainedVersions: []string{"2.0.0"},
+				RetiredVersions:  []string{"1.0.0"},
+				Actions:          []sharedImageVersionRetentionAction{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := planSharedImageVersionRetention(v.Versions, v.Policy, now)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestExpandSharedImageVersionRetentionUpdate(t *testing.T) {
+	existing := compute.GalleryImageVersion{
+		Name: utils.String("1.0.0"),
+		GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+			PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+				ExcludeFromLatest: utils.Bool(false),
+				TargetRegions: &[]compute.TargetRegion{
+					{
+						Name:                 utils.String("westeurope"),
+						RegionalReplicaCount: utils.Int32(3),
+					},
+					{
+						Name:                 utils.String("northeurope"),
+						RegionalReplicaCount: utils.Int32(1),
+					},
+				},
+			},
+		},
+	}
+
+	actual := expandSharedImageVersionRetentionUpdate(existing, sharedImageVersionRetentionAction{
+		Name:                 "1.0.0",
+		ExcludeFromLatest:    utils.Bool(true),
+		RegionalReplicaCount: utils.Int(2),
+	})
+
+	profile := actual.GalleryImageVersionProperties.PublishingProfile
+	if !*profile.ExcludeFromLatest {
+		t.Fatalf("Expected `ExcludeFromLatest` to be true")
+	}
+
+	regions := *profile.TargetRegions
+	if *regions[0].RegionalReplicaCount != 2 {
+		t.Fatalf("Expected the replica count for %q to be reduced to 2 but got %d", *regions[0].Name, *regions[0].RegionalReplicaCount)
+	}
+	if *regions[1].RegionalReplicaCount != 1 {
+		t.Fatalf("Expected the replica count for %q to remain 1 but got %d", *regions[1].Name, *regions[1].RegionalReplicaCount)
+	}
+
+	// the existing version shouldn't be modified
+	if *existing.GalleryImageVersionProperties.PublishingProfile.ExcludeFromLatest {
+		t.Fatalf("Expected the existing version to be unmodified")
+	}
+}
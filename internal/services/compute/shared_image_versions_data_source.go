@@ -11,7 +11,6 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
-	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
 func dataSourceSharedImageVersions() *pluginsdk.Resource {
@@ -102,20 +101,9 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	resourceGroup := d.Get("resource_group_name").(string)
 	filterTags := tags.Expand(d.Get("tags_filter").(map[string]interface{}))
 
-	resp, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
+	images, err := listSharedImageVersions(ctx, client, resourceGroup, galleryName, imageName)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response().Response) {
-			return fmt.Errorf("No Versions were found for Shared Image %q / Gallery %q / Resource Group %q", imageName, galleryName, resourceGroup)
-		}
-		return fmt.Errorf("retrieving Shared Image Versions (Image %q / Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
-	}
-
-	images := make([]compute.GalleryImageVersion, 0)
-	for resp.NotDone() {
-		images = append(images, resp.Value())
-		if err := resp.NextWithContext(ctx); err != nil {
-			return fmt.Errorf("listing next page of images for Shared Image %q / Gallery %q / Resource Group %q: %+v", imageName, galleryName, resourceGroup, err)
-		}
+		return err
 	}
 
 	flattenedImages := flattenSharedImageVersions(images, filterTags)
@@ -32,3 +32,22 @@ func virtualMachineShouldBeStarted(instanceView compute.VirtualMachineInstanceVi
 
 	return false
 }
+
+// virtualMachinePowerState returns the Power State of the Virtual Machine (e.g. `running` or `deallocated`)
+// from the Instance View, or an empty string when this isn't available (e.g. during provisioning)
+func virtualMachinePowerState(instanceView compute.VirtualMachineInstanceView) string {
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			state := strings.ToLower(*status.Code)
+			if strings.HasPrefix(state, "powerstate/") {
+				return strings.TrimPrefix(state, "powerstate/")
+			}
+		}
+	}
+
+	return ""
+}
@@ -7,19 +7,19 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
-func TestVirtualMachineShouldBeStarted(t *testing.T) {
-	buildInstanceViewStatus := func(statuses ...string) *[]compute.InstanceViewStatus {
-		results := make([]compute.InstanceViewStatus, 0)
-
-		for _, v := range statuses {
-			results = append(results, compute.InstanceViewStatus{
-				Code: utils.String(v),
-			})
-		}
+func buildInstanceViewStatus(statuses ...string) *[]compute.InstanceViewStatus {
+	results := make([]compute.InstanceViewStatus, 0)
 
-		return &results
+	for _, v := range statuses {
+		results = append(results, compute.InstanceViewStatus{
+			Code: utils.String(v),
+		})
 	}
 
+	return &results
+}
+
+func TestVirtualMachineShouldBeStarted(t *testing.T) {
 	testCases := []struct {
 		Name     string
 		Input    *[]compute.InstanceViewStatus
@@ -79,3 +79,49 @@ func TestVirtualMachineShouldBeStarted(t *testing.T) {
 		}
 	}
 }
+
+func TestVirtualMachinePowerState(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "No Power State",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/Creating"),
+			Expected: "",
+		},
+		{
+			Name:     "Running",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/succeeded", "PowerState/running"),
+			Expected: "running",
+		},
+		{
+			Name:     "Deallocated",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/succeeded", "PowerState/Deallocated"),
+			Expected: "deallocated",
+		},
+		{
+			Name:     "Stopped",
+			Input:    buildInstanceViewStatus("PowerState/stopped", "ProvisioningStatus/updating"),
+			Expected: "stopped",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		instanceView := compute.VirtualMachineInstanceView{
+			Statuses: testCase.Input,
+		}
+		result := virtualMachinePowerState(instanceView)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, result)
+		}
+	}
+}
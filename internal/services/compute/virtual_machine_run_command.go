@@ -0,0 +1,444 @@
+package compute
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+// virtualMachineRunCommandSchema returns the arguments shared between the Virtual Machine
+// and Virtual Machine Scale Set Instance Run Command resources
+func virtualMachineRunCommandSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.script_file_path", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.script_file_path", "source.0.command_id"},
+					},
+
+					// the contents of this file are read locally and sent as the `script`
+					"script_file_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.script_file_path", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.script_file_path", "source.0.command_id"},
+					},
+				},
+			},
+		},
+
+		// the hash of the contents of `script_file_path`, so that changes to the file trigger the Run Command
+		"script_file_sha256": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"parameter": virtualMachineRunCommandParameterSchema(false),
+
+		// due to the sensitive nature, these are not returned by the API
+		"protected_parameter": virtualMachineRunCommandParameterSchema(true),
+
+		"async_execution_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// due to the sensitive nature, this is not returned by the API
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"run_as_user"},
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 5400),
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+// virtualMachineRunCommandCustomizeDiff detects changes to the contents of `script_file_path`, which otherwise
+// wouldn't be surfaced since only the path is stored in the state
+func virtualMachineRunCommandCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source.0.script_file_path") {
+		return d.SetNewComputed("script_file_sha256")
+	}
+
+	hash := ""
+	if v := d.Get("source.0.script_file_path").(string); v != "" {
+		contents, err := ioutil.ReadFile(v)
+		if err != nil {
+			return fmt.Errorf("reading `script_file_path` %q: %+v", v, err)
+		}
+		hash = virtualMachineRunCommandScriptHash(string(contents))
+	}
+
+	if d.Get("script_file_sha256").(string) != hash {
+		return d.SetNew("script_file_sha256", hash)
+	}
+
+	return nil
+}
+
+func virtualMachineRunCommandScriptHash(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(hash[:])
+}
+
+func virtualMachineRunCommandParameterSchema(sensitive bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Optional:  true,
+		Sensitive: sensitive,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:      pluginsdk.TypeString,
+					Required:  true,
+					Sensitive: sensitive,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandProperties(d *pluginsdk.ResourceData) (*compute.VirtualMachineRunCommandProperties, error) {
+	source, err := expandVirtualMachineRunCommandScriptSource(d.Get("source").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	props := compute.VirtualMachineRunCommandProperties{
+		Source:              source,
+		Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+		AsyncExecution:      utils.Bool(d.Get("async_execution_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("run_as_user"); ok {
+		props.RunAsUser = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("run_as_password"); ok {
+		props.RunAsPassword = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timeout_in_seconds"); ok {
+		props.TimeoutInSeconds = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("output_blob_uri"); ok {
+		props.OutputBlobURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("error_blob_uri"); ok {
+		props.ErrorBlobURI = utils.String(v.(string))
+	}
+
+	return &props, nil
+}
+
+func expandVirtualMachineRunCommandScriptSource(input []interface{}) (*compute.VirtualMachineRunCommandScriptSource, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	source := compute.VirtualMachineRunCommandScriptSource{}
+
+	if v := raw["script"].(string); v != "" {
+		source.Script = utils.String(v)
+	}
+
+	if v := raw["script_uri"].(string); v != "" {
+		source.ScriptURI = utils.String(v)
+	}
+
+	if v := raw["script_file_path"].(string); v != "" {
+		contents, err := ioutil.ReadFile(v)
+		if err != nil {
+			return nil, fmt.Errorf("reading `script_file_path` %q: %+v", v, err)
+		}
+		source.Script = utils.String(string(contents))
+	}
+
+	if v := raw["command_id"].(string); v != "" {
+		source.CommandID = utils.String(v)
+	}
+
+	return &source, nil
+}
+
+func expandVirtualMachineRunCommandParameters(input []interface{}) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(raw["name"].(string)),
+			Value: utils.String(raw["value"].(string)),
+		})
+	}
+
+	return &parameters
+}
+
+// setVirtualMachineRunCommandProperties sets the fields shared between the Run Command resources into the state
+func setVirtualMachineRunCommandProperties(d *pluginsdk.ResourceData, props *compute.VirtualMachineRunCommandProperties) error {
+	if props == nil {
+		return nil
+	}
+
+	if err := d.Set("source", flattenVirtualMachineRunCommandScriptSource(d, props.Source)); err != nil {
+		return fmt.Errorf("setting `source`: %+v", err)
+	}
+
+	// the API returns the contents of the file as the `script`, so this reflects what was last run on the machine
+	scriptFileHash := ""
+	if _, ok := d.GetOk("source.0.script_file_path"); ok {
+		scriptFileHash = d.Get("script_file_sha256").(string)
+		if props.Source != nil && props.Source.Script != nil {
+			scriptFileHash = virtualMachineRunCommandScriptHash(*props.Source.Script)
+		}
+	}
+	d.Set("script_file_sha256", scriptFileHash)
+
+	if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+		return fmt.Errorf("setting `parameter`: %+v", err)
+	}
+
+	d.Set("async_execution_enabled", props.AsyncExecution)
+	d.Set("run_as_user", props.RunAsUser)
+	d.Set("output_blob_uri", props.OutputBlobURI)
+	d.Set("error_blob_uri", props.ErrorBlobURI)
+
+	timeoutInSeconds := 0
+	if props.TimeoutInSeconds != nil {
+		timeoutInSeconds = int(*props.TimeoutInSeconds)
+	}
+	d.Set("timeout_in_seconds", timeoutInSeconds)
+
+	if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+		return fmt.Errorf("setting `instance_view`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualMachineRunCommandScriptSource(d *pluginsdk.ResourceData, input *compute.VirtualMachineRunCommandScriptSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// when the script is sourced from a local file the API returns its contents as the `script`,
+	// so we keep the path from the config rather than surfacing a diff on every plan
+	scriptFilePath := ""
+	if v, ok := d.GetOk("source.0.script_file_path"); ok {
+		scriptFilePath = v.(string)
+	}
+
+	script := ""
+	if input.Script != nil && scriptFilePath == "" {
+		script = *input.Script
+	}
+
+	scriptUri := ""
+	if input.ScriptURI != nil {
+		scriptUri = *input.ScriptURI
+	}
+
+	commandId := ""
+	if input.CommandID != nil {
+		commandId = *input.CommandID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"script":           script,
+			"script_uri":       scriptUri,
+			"script_file_path": scriptFilePath,
+			"command_id":       commandId,
+		},
+	}
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		value := ""
+		if item.Value != nil {
+			value = *item.Value
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	executionMessage := ""
+	if input.ExecutionMessage != nil {
+		executionMessage = *input.ExecutionMessage
+	}
+
+	exitCode := 0
+	if input.ExitCode != nil {
+		exitCode = int(*input.ExitCode)
+	}
+
+	output := ""
+	if input.Output != nil {
+		output = *input.Output
+	}
+
+	errorMessage := ""
+	if input.Error != nil {
+		errorMessage = *input.Error
+	}
+
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"execution_state":   string(input.ExecutionState),
+			"execution_message": executionMessage,
+			"exit_code":         exitCode,
+			"output":            output,
+			"error_message":     errorMessage,
+			"start_time":        startTime,
+			"end_time":          endTime,
+		},
+	}
+}
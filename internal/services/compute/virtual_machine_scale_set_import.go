@@ -34,8 +34,9 @@ func assertOrchestratedVirtualMachineScaleSet(resp compute.VirtualMachineScaleSe
 		return fmt.Errorf("`properties` is nil")
 	}
 
-	if resp.VirtualMachineScaleSetProperties.VirtualMachineProfile != nil {
-		return fmt.Errorf("the virtual machine scale set is an orchestration virtual machine scale set")
+	// Scale Sets using Flexible Orchestration can optionally have a Virtual Machine Profile
+	if resp.VirtualMachineScaleSetProperties.VirtualMachineProfile != nil && resp.VirtualMachineScaleSetProperties.OrchestrationMode != compute.OrchestrationModeFlexible {
+		return fmt.Errorf("the virtual machine scale set is not an orchestrated virtual machine scale set")
 	}
 
 	return nil
@@ -1,17 +1,26 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
 	legacy "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-08-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	registryDataPlane "github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/runtime/2019-08-15-preview/containerregistry"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	containerinstanceLatest "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/sdk/2023-05-01/containerinstance"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
+	GroupsLatestClient              *containerinstanceLatest.ContainerInstanceClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
@@ -20,8 +29,11 @@ type Client struct {
 	WebhooksClient                  *containerregistry.WebhooksClient
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
+	TasksClient                     *containerregistry.TasksClient
 
 	Environment azure.Environment
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -40,9 +52,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	scopeMapsClient := containerregistry.NewScopeMapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scopeMapsClient.Client, o.ResourceManagerAuthorizer)
 
+	tasksClient := containerregistry.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&tasksClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
+	groupsLatestClient := containerinstanceLatest.NewContainerInstanceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&groupsLatestClient.Client, o.ResourceManagerAuthorizer)
+
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
@@ -60,6 +78,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AgentPoolsClient:                &agentPoolsClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
 		GroupsClient:                    &groupsClient,
+		GroupsLatestClient:              &groupsLatestClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
 		RegistriesClient:                &registriesClient,
 		WebhooksClient:                  &webhooksClient,
@@ -68,5 +87,75 @@ func NewClient(o *common.ClientOptions) *Client {
 		Environment:                     o.Environment,
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
+		TasksClient:                     &tasksClient,
+		options:                         o,
+	}
+}
+
+// RegistryTagsClient returns a data plane client for the tags within a repository of the Container Registry with the
+// specified login server, authorized for the specified actions (e.g. `pull` or `delete`) on that repository
+func (client Client) RegistryTagsClient(ctx context.Context, loginServer string, repository string, actions ...string) (*registryDataPlane.TagClient, error) {
+	authorizer, err := client.registryDataPlaneAuthorizer(ctx, loginServer, repository, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsClient := registryDataPlane.NewTagClient(fmt.Sprintf("https://%s", loginServer))
+	client.options.ConfigureClient(&tagsClient.Client, authorizer)
+	return &tagsClient, nil
+}
+
+// RegistryRepositoryClient returns a data plane client for a repository of the Container Registry with the specified
+// login server, authorized for the specified actions (e.g. `pull` or `delete`) on that repository
+func (client Client) RegistryRepositoryClient(ctx context.Context, loginServer string, repository string, actions ...string) (*registryDataPlane.RepositoryClient, error) {
+	authorizer, err := client.registryDataPlaneAuthorizer(ctx, loginServer, repository, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryClient := registryDataPlane.NewRepositoryClient(fmt.Sprintf("https://%s", loginServer))
+	client.options.ConfigureClient(&repositoryClient.Client, authorizer)
+	return &repositoryClient, nil
+}
+
+func (client Client) registryDataPlaneAuthorizer(ctx context.Context, loginServer string, repository string, actions []string) (autorest.Authorizer, error) {
+	loginUri := fmt.Sprintf("https://%s", loginServer)
+
+	// the data plane exchanges an Azure Active Directory token for a registry refresh token, which is then used to
+	// obtain an access token scoped to the repository
+	req, err := autorest.Prepare(&http.Request{Header: http.Header{}}, client.options.ResourceManagerAuthorizer.WithAuthorization())
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an Azure Active Directory token: %+v", err)
 	}
+	aadToken := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+
+	refreshTokensClient := registryDataPlane.NewRefreshTokensClient(loginUri)
+	client.options.ConfigureClient(&refreshTokensClient.Client, autorest.NullAuthorizer{})
+	refreshToken, err := refreshTokensClient.GetFromExchange(ctx, "access_token", loginServer, client.options.TenantID, "", aadToken)
+	if err != nil {
+		return nil, fmt.Errorf("exchanging an Azure Active Directory token for a refresh token for %q: %+v", loginServer, err)
+	}
+	if refreshToken.RefreshToken == nil {
+		return nil, fmt.Errorf("exchanging an Azure Active Directory token for a refresh token for %q: `refresh_token` was nil", loginServer)
+	}
+
+	accessTokensClient := registryDataPlane.NewAccessTokensClient(loginUri)
+	client.options.ConfigureClient(&accessTokensClient.Client, autorest.NullAuthorizer{})
+	scope := fmt.Sprintf("repository:%s:%s", repository, strings.Join(actions, ","))
+	accessToken, err := accessTokensClient.Get(ctx, loginServer, scope, *refreshToken.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an access token for %q (scope %q): %+v", loginServer, scope, err)
+	}
+	if accessToken.AccessToken == nil {
+		return nil, fmt.Errorf("obtaining an access token for %q (scope %q): `access_token` was nil", loginServer, scope)
+	}
+
+	return autorest.NewBearerAuthorizer(staticToken(*accessToken.AccessToken)), nil
+}
+
+// staticToken is an OAuth Token Provider for a pre-obtained access token
+type staticToken string
+
+func (t staticToken) OAuthToken() string {
+	return string(t)
 }
@@ -3,6 +3,7 @@ package containers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -10,11 +11,15 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/parse"
+	containerinstanceLatest "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/sdk/2023-05-01/containerinstance"
+	keyVaultParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
 	msiparse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/parse"
 	msivalidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
@@ -64,6 +69,7 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerinstance.Public),
 					string(containerinstance.Private),
+					containerGroupIPAddressTypeNone,
 				}, true),
 			},
 
@@ -330,106 +336,66 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							},
 						},
 
-						"volume": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							ForceNew: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+						"volume": containerVolumeSchema(),
 
-									"mount_path": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"read_only": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										ForceNew: true,
-										Default:  false,
-									},
-
-									"share_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+						"liveness_probe": SchemaContainerGroupProbe(),
 
-									"storage_account_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+						"readiness_probe": SchemaContainerGroupProbe(),
+					},
+				},
+			},
 
-									"storage_account_key": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Sensitive:    true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+			"init_container": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 
-									"empty_dir": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										ForceNew: true,
-										Default:  false,
-									},
+						"image": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 
-									"git_repo": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										ForceNew: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"url": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ForceNew: true,
-												},
-
-												"directory": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													ForceNew: true,
-												},
-
-												"revision": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													ForceNew: true,
-												},
-											},
-										},
-									},
+						"environment_variables": {
+							Type:     pluginsdk.TypeMap,
+							ForceNew: true,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
 
-									"secret": {
-										Type:      pluginsdk.TypeMap,
-										ForceNew:  true,
-										Optional:  true,
-										Sensitive: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
+						"secure_environment_variables": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
 							},
 						},
 
-						"liveness_probe": SchemaContainerGroupProbe(),
+						"commands": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
 
-						"readiness_probe": SchemaContainerGroupProbe(),
+						"volume": containerVolumeSchema(),
 					},
 				},
 			},
@@ -498,6 +464,43 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerinstance.Standard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerinstance.Standard),
+					string(containerinstance.Dedicated),
+					containerGroupSkuConfidential,
+				}, false),
+			},
+
+			"priority": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  containerGroupPriorityRegular,
+				ValidateFunc: validation.StringInSlice([]string{
+					containerGroupPriorityRegular,
+					containerGroupPrioritySpot,
+				}, false),
+			},
+
+			"cce_policy": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"key_vault_key_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemId,
+			},
+
 			"dns_config": {
 				Optional: true,
 				MaxItems: 1,
@@ -538,8 +541,109 @@ func resourceContainerGroup() *pluginsdk.Resource {
 	}
 }
 
+func containerVolumeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"mount_path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"read_only": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  false,
+				},
+
+				"share_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"storage_account_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"storage_account_key": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"empty_dir": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  false,
+				},
+
+				"git_repo": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"url": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+
+							"directory": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+
+							"revision": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+
+				"secret": {
+					Type:      pluginsdk.TypeMap,
+					ForceNew:  true,
+					Optional:  true,
+					Sensitive: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.GroupsClient
+	latestClient := meta.(*clients.Client).Containers.GroupsLatestClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -567,31 +671,57 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	diagnosticsRaw := d.Get("diagnostics").([]interface{})
 	diagnostics := expandContainerGroupDiagnostics(diagnosticsRaw)
 	dnsConfig := d.Get("dns_config").([]interface{})
-	containers, containerGroupPorts, containerGroupVolumes, err := expandContainerGroupContainers(d)
+	addedEmptyDirs := map[string]bool{}
+	containers, containerGroupPorts, containerGroupVolumes, err := expandContainerGroupContainers(d, addedEmptyDirs)
 	if err != nil {
 		return err
 	}
+	initContainers, initContainerVolumes, err := expandContainerGroupInitContainers(d, addedEmptyDirs)
+	if err != nil {
+		return err
+	}
+	*containerGroupVolumes = append(*containerGroupVolumes, initContainerVolumes...)
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     &name,
 		Location: &location,
 		Tags:     tags.Expand(t),
 		Identity: expandContainerGroupIdentity(d),
 		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
-			Containers:    containers,
-			Diagnostics:   diagnostics,
-			RestartPolicy: containerinstance.ContainerGroupRestartPolicy(restartPolicy),
-			IPAddress: &containerinstance.IPAddress{
-				Type:  containerinstance.ContainerGroupIPAddressType(IPAddressType),
-				Ports: containerGroupPorts,
-			},
+			Containers:               containers,
+			Diagnostics:              diagnostics,
+			RestartPolicy:            containerinstance.ContainerGroupRestartPolicy(restartPolicy),
 			OsType:                   containerinstance.OperatingSystemTypes(OSType),
 			Volumes:                  containerGroupVolumes,
 			ImageRegistryCredentials: expandContainerImageRegistryCredentials(d),
 			DNSConfig:                expandContainerGroupDnsConfig(dnsConfig),
+			Sku:                      containerinstance.ContainerGroupSku(d.Get("sku").(string)),
+			InitContainers:           initContainers,
 		},
 	}
 
+	if !strings.EqualFold(IPAddressType, containerGroupIPAddressTypeNone) {
+		containerGroup.ContainerGroupProperties.IPAddress = &containerinstance.IPAddress{
+			Type:  containerinstance.ContainerGroupIPAddressType(IPAddressType),
+			Ports: containerGroupPorts,
+		}
+	}
+
+	if keyVaultKeyId := d.Get("key_vault_key_id").(string); keyVaultKeyId != "" {
+		keyId, err := keyVaultParse.ParseNestedItemID(keyVaultKeyId)
+		if err != nil {
+			return fmt.Errorf("parsing Key Vault Key ID: %+v", err)
+		}
+		containerGroup.ContainerGroupProperties.EncryptionProperties = &containerinstance.EncryptionProperties{
+			VaultBaseURL: utils.String(keyId.KeyVaultBaseUrl),
+			KeyName:      utils.String(keyId.Name),
+			KeyVersion:   utils.String(keyId.Version),
+		}
+	}
+
 	if dnsNameLabel := d.Get("dns_name_label").(string); dnsNameLabel != "" {
+		if containerGroup.ContainerGroupProperties.IPAddress == nil {
+			return fmt.Errorf("`dns_name_label` cannot be set when `ip_address_type` is `None`")
+		}
 		containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel = &dnsNameLabel
 	}
 
@@ -606,25 +736,50 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
-	if err != nil {
-		return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
+	priority := d.Get("priority").(string)
+	ccePolicy := d.Get("cce_policy").(string)
+	if ccePolicy != "" && string(containerGroup.Sku) != containerGroupSkuConfidential {
+		return fmt.Errorf("`cce_policy` can only be specified when `sku` is `Confidential`")
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of container group %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	id := parse.NewContainerGroupID(subscriptionId, resGroup, name)
 
-	read, err := client.Get(ctx, resGroup, name)
-	if err != nil {
-		return err
-	}
+	// network profiles aren't supported by the newer API version, so these Container Groups continue to be created
+	// using the 2019-12-01 API version - which doesn't support the `priority` nor the `Confidential` sku
+	if d.Get("network_profile_id").(string) != "" {
+		if priority != containerGroupPriorityRegular || string(containerGroup.Sku) == containerGroupSkuConfidential {
+			return fmt.Errorf("`network_profile_id` cannot be used with a `priority` of `Spot` or a `sku` of `Confidential`")
+		}
+
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
+		if err != nil {
+			return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read container group %s (resource group %s) ID", name, resGroup)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		payload, err := containerGroupToLatestApiVersion(containerGroup)
+		if err != nil {
+			return fmt.Errorf("building container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		groupPriority := containerinstanceLatest.ContainerGroupPriority(priority)
+		payload.Properties.Priority = &groupPriority
+		if ccePolicy != "" {
+			payload.Properties.ConfidentialComputeProperties = &containerinstanceLatest.ConfidentialComputeProperties{
+				CcePolicy: utils.String(ccePolicy),
+			}
+		}
+
+		latestId := containerinstanceLatest.NewContainerGroupID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := latestClient.ContainerGroupsCreateOrUpdateThenPoll(ctx, latestId, *payload); err != nil {
+			return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceContainerGroupRead(d, meta)
 }
@@ -653,7 +808,7 @@ func resourceContainerGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 }
 
 func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.GroupsClient
+	client := meta.(*clients.Client).Containers.GroupsLatestClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -662,15 +817,23 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	latest, err := client.ContainerGroupsGet(ctx, containerinstanceLatest.NewContainerGroupID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(latest.HttpResponse) {
 			log.Printf("[DEBUG] Container Group %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
 			d.SetId("")
 			return nil
 		}
 		return err
 	}
+	if latest.Model == nil {
+		return fmt.Errorf("retrieving container group %q (Resource Group %q): model was nil", id.Name, id.ResourceGroup)
+	}
+
+	resp, err := containerGroupFromLatestApiVersion(*latest.Model)
+	if err != nil {
+		return fmt.Errorf("parsing container group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
@@ -692,6 +855,11 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `container`: %+v", err)
 		}
 
+		initContainerConfigs := flattenContainerGroupInitContainers(d, props.InitContainers, props.Volumes)
+		if err := d.Set("init_container", initContainerConfigs); err != nil {
+			return fmt.Errorf("setting `init_container`: %+v", err)
+		}
+
 		if err := d.Set("image_registry_credential", flattenContainerImageRegistryCredentials(d, props.ImageRegistryCredentials)); err != nil {
 			return fmt.Errorf("setting `image_registry_credential`: %+v", err)
 		}
@@ -706,12 +874,47 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("exposed_port", flattenPorts(exposedPorts))
 			d.Set("dns_name_label", address.DNSNameLabel)
 			d.Set("fqdn", address.Fqdn)
+		} else {
+			d.Set("ip_address_type", containerGroupIPAddressTypeNone)
+			d.Set("ip_address", "")
+			d.Set("exposed_port", flattenPorts([]interface{}{}))
+			d.Set("dns_name_label", "")
+			d.Set("fqdn", "")
 		}
 
 		d.Set("restart_policy", string(props.RestartPolicy))
 		d.Set("os_type", string(props.OsType))
 		d.Set("dns_config", flattenContainerGroupDnsConfig(resp.DNSConfig))
 
+		// `priority`, the `Confidential` sku and the `cce_policy` aren't available in the 2019-12-01 model
+		priority := containerGroupPriorityRegular
+		if v := latest.Model.Properties.Priority; v != nil && *v != "" {
+			priority = string(*v)
+		}
+		d.Set("priority", priority)
+
+		sku := string(containerinstance.Standard)
+		if v := latest.Model.Properties.Sku; v != nil && *v != "" {
+			sku = string(*v)
+		}
+		d.Set("sku", sku)
+
+		ccePolicy := ""
+		if v := latest.Model.Properties.ConfidentialComputeProperties; v != nil && v.CcePolicy != nil {
+			ccePolicy = *v.CcePolicy
+		}
+		d.Set("cce_policy", ccePolicy)
+
+		keyVaultKeyId := ""
+		if encryption := props.EncryptionProperties; encryption != nil && encryption.VaultBaseURL != nil && encryption.KeyName != nil && encryption.KeyVersion != nil {
+			keyId, err := keyVaultParse.NewNestedItemID(*encryption.VaultBaseURL, "keys", *encryption.KeyName, *encryption.KeyVersion)
+			if err != nil {
+				return fmt.Errorf("parsing Key Vault Key ID: %+v", err)
+			}
+			keyVaultKeyId = keyId.ID()
+		}
+		d.Set("key_vault_key_id", keyVaultKeyId)
+
 		if err := d.Set("diagnostics", flattenContainerGroupDiagnostics(d, props.Diagnostics)); err != nil {
 			return fmt.Errorf("setting `diagnostics`: %+v", err)
 		}
@@ -857,13 +1060,12 @@ func containerGroupEnsureDetachedFromNetworkProfileRefreshFunc(ctx context.Conte
 	}
 }
 
-func expandContainerGroupContainers(d *pluginsdk.ResourceData) (*[]containerinstance.Container, *[]containerinstance.Port, *[]containerinstance.Volume, error) {
+func expandContainerGroupContainers(d *pluginsdk.ResourceData, addedEmptyDirs map[string]bool) (*[]containerinstance.Container, *[]containerinstance.Port, *[]containerinstance.Volume, error) {
 	containersConfig := d.Get("container").([]interface{})
 	containers := make([]containerinstance.Container, 0)
 	containerInstancePorts := make([]containerinstance.Port, 0)
 	containerGroupPorts := make([]containerinstance.Port, 0)
 	containerGroupVolumes := make([]containerinstance.Volume, 0)
-	addedEmptyDirs := map[string]bool{}
 
 	for _, containerConfig := range containersConfig {
 		data := containerConfig.(map[string]interface{})
@@ -1022,6 +1224,61 @@ func expandContainerGroupContainers(d *pluginsdk.ResourceData) (*[]containerinst
 	return &containers, &containerGroupPorts, &containerGroupVolumes, nil
 }
 
+func expandContainerGroupInitContainers(d *pluginsdk.ResourceData, addedEmptyDirs map[string]bool) (*[]containerinstance.InitContainerDefinition, []containerinstance.Volume, error) {
+	initContainersConfig := d.Get("init_container").([]interface{})
+	initContainers := make([]containerinstance.InitContainerDefinition, 0)
+	containerGroupVolumes := make([]containerinstance.Volume, 0)
+
+	for _, initContainerConfig := range initContainersConfig {
+		data := initContainerConfig.(map[string]interface{})
+
+		name := data["name"].(string)
+		image := data["image"].(string)
+
+		initContainer := containerinstance.InitContainerDefinition{
+			Name: utils.String(name),
+			InitContainerPropertiesDefinition: &containerinstance.InitContainerPropertiesDefinition{
+				Image: utils.String(image),
+			},
+		}
+
+		// Set both sensitive and non-secure environment variables
+		envVars := expandContainerEnvironmentVariables(data["environment_variables"], false)
+		secEnvVars := expandContainerEnvironmentVariables(data["secure_environment_variables"], true)
+		*envVars = append(*envVars, *secEnvVars...)
+		initContainer.EnvironmentVariables = envVars
+
+		if v, ok := data["commands"]; ok {
+			initContainer.Command = utils.ExpandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := data["volume"]; ok {
+			volumeMounts, containerGroupVolumesPartial, err := expandContainerVolumes(v)
+			if err != nil {
+				return nil, nil, err
+			}
+			initContainer.VolumeMounts = volumeMounts
+			if containerGroupVolumesPartial != nil {
+				for _, cgVol := range *containerGroupVolumesPartial {
+					if cgVol.EmptyDir != nil {
+						// init containers commonly share an empty_dir-volume with the containers they're preparing,
+						// which must only be declared once on the containerGroup
+						if addedEmptyDirs[*cgVol.Name] {
+							continue
+						}
+						addedEmptyDirs[*cgVol.Name] = true
+					}
+					containerGroupVolumes = append(containerGroupVolumes, cgVol)
+				}
+			}
+		}
+
+		initContainers = append(initContainers, initContainer)
+	}
+
+	return &initContainers, containerGroupVolumes, nil
+}
+
 func expandContainerEnvironmentVariables(input interface{}, secure bool) *[]containerinstance.EnvironmentVariable {
 	envVars := input.(map[string]interface{})
 	output := make([]containerinstance.EnvironmentVariable, 0, len(envVars))
@@ -1380,13 +1637,13 @@ func flattenContainerGroupContainers(d *pluginsdk.ResourceData, containers *[]co
 
 		if container.EnvironmentVariables != nil {
 			if len(*container.EnvironmentVariables) > 0 {
-				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, index)
+				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, "container", index)
 			}
 		}
 
 		if container.EnvironmentVariables != nil {
 			if len(*container.EnvironmentVariables) > 0 {
-				containerConfig["secure_environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, true, d, index)
+				containerConfig["secure_environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, true, d, "container", index)
 			}
 		}
 
@@ -1424,7 +1681,61 @@ func flattenContainerGroupContainers(d *pluginsdk.ResourceData, containers *[]co
 	return containerCfg
 }
 
-func flattenContainerEnvironmentVariables(input *[]containerinstance.EnvironmentVariable, isSecure bool, d *pluginsdk.ResourceData, oldContainerIndex int) map[string]interface{} {
+func flattenContainerGroupInitContainers(d *pluginsdk.ResourceData, initContainers *[]containerinstance.InitContainerDefinition, containerGroupVolumes *[]containerinstance.Volume) []interface{} {
+	if initContainers == nil {
+		return []interface{}{}
+	}
+
+	// map old container names to index so we can look up things up
+	nameIndexMap := map[string]int{}
+	for i, c := range d.Get("init_container").([]interface{}) {
+		cfg := c.(map[string]interface{})
+		nameIndexMap[cfg["name"].(string)] = i
+	}
+
+	containerCfg := make([]interface{}, 0, len(*initContainers))
+	for _, container := range *initContainers {
+		if container.Name == nil {
+			continue
+		}
+		name := *container.Name
+
+		// get index from name
+		index := nameIndexMap[name]
+
+		containerConfig := make(map[string]interface{})
+		containerConfig["name"] = name
+
+		if props := container.InitContainerPropertiesDefinition; props != nil {
+			if v := props.Image; v != nil {
+				containerConfig["image"] = *v
+			}
+
+			if props.EnvironmentVariables != nil && len(*props.EnvironmentVariables) > 0 {
+				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(props.EnvironmentVariables, false, d, "init_container", index)
+				containerConfig["secure_environment_variables"] = flattenContainerEnvironmentVariables(props.EnvironmentVariables, true, d, "init_container", index)
+			}
+
+			containerConfig["commands"] = utils.FlattenStringSlice(props.Command)
+
+			if containerGroupVolumes != nil && props.VolumeMounts != nil {
+				// Also pass in the container volume config from schema
+				var containerVolumesConfig *[]interface{}
+				if v, ok := d.GetOk(fmt.Sprintf("init_container.%d.volume", index)); ok {
+					containerVolumesRaw := v.([]interface{})
+					containerVolumesConfig = &containerVolumesRaw
+				}
+				containerConfig["volume"] = flattenContainerVolumes(props.VolumeMounts, containerGroupVolumes, containerVolumesConfig)
+			}
+		}
+
+		containerCfg = append(containerCfg, containerConfig)
+	}
+
+	return containerCfg
+}
+
+func flattenContainerEnvironmentVariables(input *[]containerinstance.EnvironmentVariable, isSecure bool, d *pluginsdk.ResourceData, containerType string, oldContainerIndex int) map[string]interface{} {
 	output := make(map[string]interface{})
 
 	if input == nil {
@@ -1434,7 +1745,7 @@ func flattenContainerEnvironmentVariables(input *[]containerinstance.Environment
 	if isSecure {
 		for _, envVar := range *input {
 			if envVar.Name != nil && envVar.Value == nil {
-				envVarValue := d.Get(fmt.Sprintf("container.%d.secure_environment_variables.%s", oldContainerIndex, *envVar.Name))
+				envVarValue := d.Get(fmt.Sprintf("%s.%d.secure_environment_variables.%s", containerType, oldContainerIndex, *envVar.Name))
 				output[*envVar.Name] = envVarValue
 			}
 		}
@@ -1744,3 +2055,46 @@ func expandContainerGroupDnsConfig(input interface{}) *containerinstance.DNSConf
 
 	return nil
 }
+
+const (
+	containerGroupIPAddressTypeNone = "None"
+	containerGroupPriorityRegular   = "Regular"
+	containerGroupPrioritySpot      = "Spot"
+	containerGroupSkuConfidential   = "Confidential"
+)
+
+// containerGroupToLatestApiVersion converts the Container Group into the 2023-05-01 model, which is wire compatible
+// with the 2019-12-01 model used to build the Container Group, other than the network profile which is unsupported
+func containerGroupToLatestApiVersion(input containerinstance.ContainerGroup) (*containerinstanceLatest.ContainerGroup, error) {
+	if input.ContainerGroupProperties != nil && input.ContainerGroupProperties.NetworkProfile != nil {
+		return nil, fmt.Errorf("network profiles aren't supported by the 2023-05-01 API")
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var output containerinstanceLatest.ContainerGroup
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// containerGroupFromLatestApiVersion converts the 2023-05-01 model into the 2019-12-01 model which the flatten
+// functions use
+func containerGroupFromLatestApiVersion(input containerinstanceLatest.ContainerGroup) (*containerinstance.ContainerGroup, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var output containerinstance.ContainerGroup
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
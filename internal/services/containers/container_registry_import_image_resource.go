@@ -0,0 +1,334 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceContainerRegistryImportImage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryImportImageCreateUpdate,
+		Read:   resourceContainerRegistryImportImageRead,
+		Update: resourceContainerRegistryImportImageCreateUpdate,
+		Delete: resourceContainerRegistryImportImageDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ContainerRegistryImportImageID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9_-]{1,50}$`),
+					"The name can only contain alphanumeric characters, dashes and underscores and must be between 1 and 50 characters",
+				),
+			},
+
+			"container_registry_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// e.g. `library/nginx:latest` or `hello-world@sha256:abc123`
+						"image": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"container_registry_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.RegistryID,
+							ExactlyOneOf: []string{"source.0.container_registry_id", "source.0.registry_uri"},
+						},
+
+						// e.g. `docker.io` or `mcr.microsoft.com`
+						"registry_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.container_registry_id", "source.0.registry_uri"},
+						},
+
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"source.0.password"},
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							RequiredWith: []string{"source.0.username"},
+						},
+					},
+				},
+			},
+
+			"target_tags": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"target_tags", "untagged_target_repositories"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"untagged_target_repositories": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"target_tags", "untagged_target_repositories"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"overwrite_existing_tags_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// changing this value re-imports the image, for example to pick up a new version of a mutable tag
+			"force_update_tag": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryImportImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	registryId, err := parse.RegistryID(d.Get("container_registry_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewContainerRegistryImportImageID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, d.Get("name").(string))
+
+	if _, err := client.Get(ctx, registryId.ResourceGroup, registryId.Name); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+	}
+
+	mode := containerregistry.NoForce
+	// the tags will already exist when re-importing, as such these need to be overwritten
+	if d.Get("overwrite_existing_tags_enabled").(bool) || !d.IsNewResource() {
+		mode = containerregistry.Force
+	}
+
+	parameters := containerregistry.ImportImageParameters{
+		Source:                     expandContainerRegistryImportImageSource(d.Get("source").([]interface{})),
+		TargetTags:                 utils.ExpandStringSlice(d.Get("target_tags").([]interface{})),
+		UntaggedTargetRepositories: utils.ExpandStringSlice(d.Get("untagged_target_repositories").([]interface{})),
+		Mode:                       mode,
+	}
+
+	locks.ByID(registryId.ID())
+	defer locks.UnlockByID(registryId.ID())
+
+	log.Printf("[DEBUG] Importing %s..", id)
+	future, err := client.ImportImage(ctx, registryId.ResourceGroup, registryId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("importing %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for import of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerRegistryImportImageRead(d, meta)
+}
+
+func resourceContainerRegistryImportImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryImportImageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	registryId := parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+	resp, err := client.RegistriesClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", registryId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", registryId, err)
+	}
+	if resp.RegistryProperties == nil || resp.RegistryProperties.LoginServer == nil {
+		return fmt.Errorf("retrieving %s: `loginServer` was nil", registryId)
+	}
+	loginServer := *resp.RegistryProperties.LoginServer
+
+	// the imported images are only available via the data plane, where each of the imported tags/repositories is checked
+	for _, v := range d.Get("target_tags").([]interface{}) {
+		repository, tag := splitContainerRegistryImportImageTargetTag(v.(string))
+		tagsClient, err := client.RegistryTagsClient(ctx, loginServer, repository, "pull")
+		if err != nil {
+			return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+		}
+
+		if tagResp, err := tagsClient.GetAttributes(ctx, repository, tag); err != nil {
+			if utils.ResponseWasNotFound(tagResp.Response) {
+				log.Printf("[DEBUG] the tag %q imported by %s was not found - removing from state", v.(string), *id)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving the tag %q imported by %s: %+v", v.(string), *id, err)
+		}
+	}
+
+	for _, v := range d.Get("untagged_target_repositories").([]interface{}) {
+		repositoryClient, err := client.RegistryRepositoryClient(ctx, loginServer, v.(string), "pull")
+		if err != nil {
+			return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+		}
+
+		if repositoryResp, err := repositoryClient.GetAttributes(ctx, v.(string)); err != nil {
+			if utils.ResponseWasNotFound(repositoryResp.Response) {
+				log.Printf("[DEBUG] the repository %q imported by %s was not found - removing from state", v.(string), *id)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving the repository %q imported by %s: %+v", v.(string), *id, err)
+		}
+	}
+
+	d.Set("name", id.ImportImageName)
+	d.Set("container_registry_id", registryId.ID())
+
+	return nil
+}
+
+func resourceContainerRegistryImportImageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryImportImageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	registryId := parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+	resp, err := client.RegistriesClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", registryId, err)
+	}
+	if resp.RegistryProperties == nil || resp.RegistryProperties.LoginServer == nil {
+		return fmt.Errorf("retrieving %s: `loginServer` was nil", registryId)
+	}
+	loginServer := *resp.RegistryProperties.LoginServer
+
+	locks.ByID(registryId.ID())
+	defer locks.UnlockByID(registryId.ID())
+
+	// the ACR Import API has no inverse operation, so the imported tags are removed - the image manifests themselves
+	// (including those imported into `untagged_target_repositories`) are left in the Registry
+	for _, v := range d.Get("target_tags").([]interface{}) {
+		repository, tag := splitContainerRegistryImportImageTargetTag(v.(string))
+		tagsClient, err := client.RegistryTagsClient(ctx, loginServer, repository, "delete")
+		if err != nil {
+			return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+		}
+
+		log.Printf("[DEBUG] Untagging %q imported by %s..", v.(string), *id)
+		if tagResp, err := tagsClient.Delete(ctx, repository, tag); err != nil && !utils.ResponseWasNotFound(tagResp) {
+			return fmt.Errorf("untagging %q imported by %s: %+v", v.(string), *id, err)
+		}
+	}
+
+	return nil
+}
+
+// splitContainerRegistryImportImageTargetTag splits a target tag (e.g. `library/nginx:latest`) into the repository
+// and the tag, defaulting to the `latest` tag when one isn't specified
+func splitContainerRegistryImportImageTargetTag(input string) (string, string) {
+	// the repository can't contain a `:`, however can contain a `/` - which a tag can't
+	if i := strings.LastIndex(input, ":"); i != -1 && !strings.Contains(input[i:], "/") {
+		return input[:i], input[i+1:]
+	}
+
+	return input, "latest"
+}
+
+func expandContainerRegistryImportImageSource(input []interface{}) *containerregistry.ImportSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := containerregistry.ImportSource{
+		SourceImage: utils.String(raw["image"].(string)),
+	}
+
+	if v := raw["container_registry_id"].(string); v != "" {
+		output.ResourceID = utils.String(v)
+	}
+
+	if v := raw["registry_uri"].(string); v != "" {
+		output.RegistryURI = utils.String(v)
+	}
+
+	if username := raw["username"].(string); username != "" {
+		output.Credentials = &containerregistry.ImportSourceCredentials{
+			Username: utils.String(username),
+			Password: utils.String(raw["password"].(string)),
+		}
+	}
+
+	return &output
+}
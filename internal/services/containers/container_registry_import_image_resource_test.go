@@ -0,0 +1,207 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type ContainerRegistryImportImageResource struct {
+}
+
+func TestAccContainerRegistryImportImage_publicRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	r := ContainerRegistryImportImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicRegistry(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "target_tags", "untagged_target_repositories", "overwrite_existing_tags_enabled", "force_update_tag"),
+		{
+			Config: r.publicRegistry(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("force_update_tag").HasValue("second"),
+			),
+		},
+	})
+}
+
+func TestAccContainerRegistryImportImage_tagRemoved(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	r := ContainerRegistryImportImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config: func(data acceptance.TestData) string {
+				return r.publicRegistry(data, "first")
+			},
+			TestResource: r,
+		}),
+	})
+}
+
+func TestAccContainerRegistryImportImage_fromRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	r := ContainerRegistryImportImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromRegistry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "target_tags", "untagged_target_repositories", "overwrite_existing_tags_enabled", "force_update_tag"),
+	})
+}
+
+func (ContainerRegistryImportImageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryImportImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistriesClient.Get(ctx, id.ResourceGroup, id.RegistryName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Container Registry for %s: %+v", *id, err)
+	}
+
+	repository, tag := splitTargetTag(state.Attributes["target_tags.0"])
+	tagsClient, err := clients.Containers.RegistryTagsClient(ctx, *resp.LoginServer, repository, "pull")
+	if err != nil {
+		return nil, fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	tagResp, err := tagsClient.GetAttributes(ctx, repository, tag)
+	if err != nil {
+		if utils.ResponseWasNotFound(tagResp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving tag %q for %s: %+v", state.Attributes["target_tags.0"], *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ContainerRegistryImportImageResource) Destroy(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryImportImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistriesClient.Get(ctx, id.ResourceGroup, id.RegistryName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Container Registry for %s: %+v", *id, err)
+	}
+
+	repository, tag := splitTargetTag(state.Attributes["target_tags.0"])
+	tagsClient, err := clients.Containers.RegistryTagsClient(ctx, *resp.LoginServer, repository, "delete")
+	if err != nil {
+		return nil, fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	if _, err := tagsClient.Delete(ctx, repository, tag); err != nil {
+		return nil, fmt.Errorf("untagging %q for %s: %+v", state.Attributes["target_tags.0"], *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func splitTargetTag(input string) (string, string) {
+	if i := strings.LastIndex(input, ":"); i != -1 {
+		return input[:i], input[i+1:]
+	}
+	return input, "latest"
+}
+
+func (ContainerRegistryImportImageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ContainerRegistryImportImageResource) publicRegistry(data acceptance.TestData, forceUpdateTag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_import_image" "test" {
+  name                  = "import%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+
+  target_tags      = ["hello-world:latest", "hello-world:v1"]
+  force_update_tag = "%s"
+}
+`, r.template(data), data.RandomInteger, forceUpdateTag)
+}
+
+func (r ContainerRegistryImportImageResource) fromRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "source" {
+  name                = "testacccrsrc%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_container_registry_import_image" "seed" {
+  name                  = "seed%d"
+  container_registry_id = azurerm_container_registry.source.id
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+
+  target_tags = ["hello-world:latest"]
+}
+
+resource "azurerm_container_registry_import_image" "test" {
+  name                  = "import%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  source {
+    container_registry_id = azurerm_container_registry.source.id
+    image                 = "hello-world:latest"
+  }
+
+  untagged_target_repositories    = ["hello-world"]
+  overwrite_existing_tags_enabled = true
+
+  depends_on = [azurerm_container_registry_import_image.seed]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,1386 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/validate"
+	identityValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/suppress"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceContainerRegistryTask() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryTaskCreateUpdate,
+		Read:   resourceContainerRegistryTaskRead,
+		Update: resourceContainerRegistryTaskCreateUpdate,
+		Delete: resourceContainerRegistryTaskDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ContainerRegistryTaskID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerRegistryTaskName,
+			},
+
+			"container_registry_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryID,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"is_system_task": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"platform": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"os": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Linux),
+								string(containerregistry.Windows),
+							}, false),
+						},
+
+						"architecture": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Amd64),
+								string(containerregistry.Arm),
+								string(containerregistry.Arm64),
+								string(containerregistry.ThreeEightSix),
+								string(containerregistry.X86),
+							}, false),
+						},
+
+						"variant": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.V6),
+								string(containerregistry.V7),
+								string(containerregistry.V8),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"agent_setting": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cpu": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"agent_pool_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 28800),
+			},
+
+			"log_template": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"docker_step": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"encoded_step", "file_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"dockerfile_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"image_names": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"cache_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"push_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"target": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"arguments": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_arguments": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"encoded_step": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"docker_step", "file_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"task_content": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value_content": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_values": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"file_step": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"docker_step", "encoded_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"task_file_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value_file_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_values": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"base_image_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.All),
+								string(containerregistry.Runtime),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"update_trigger_endpoint": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"update_trigger_payload_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.UpdateTriggerPayloadTypeDefault),
+								string(containerregistry.UpdateTriggerPayloadTypeToken),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"source_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"events": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerregistry.Commit),
+									string(containerregistry.Pullrequest),
+								}, false),
+							},
+						},
+
+						"repository_url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"source_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Github),
+								string(containerregistry.VisualStudioTeamService),
+							}, false),
+						},
+
+						"branch": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"authentication": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"token_type": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerregistry.PAT),
+											string(containerregistry.OAuth),
+										}, false),
+									},
+
+									"token": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"refresh_token": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"scope": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"expire_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"timer_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						// a CRON expression, e.g. `0 21 * * *`
+						"schedule": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"registry_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"login_mode": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerregistry.SourceRegistryLoginModeNone),
+											string(containerregistry.SourceRegistryLoginModeDefault),
+										}, false),
+									},
+								},
+							},
+						},
+
+						"custom": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"login_server": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									// either `[system]` or the Client ID of a User Assigned Identity
+									"identity": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"username": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"password": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.ResourceIdentityTypeSystemAssigned),
+								string(containerregistry.ResourceIdentityTypeUserAssigned),
+								string(containerregistry.ResourceIdentityTypeSystemAssignedUserAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: identityValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceContainerRegistryTaskCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.TasksClient
+	registriesClient := meta.(*clients.Client).Containers.RegistriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	registryId, err := parse.RegistryID(d.Get("container_registry_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewContainerRegistryTaskID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_container_registry_task", id.ID())
+		}
+	}
+
+	// Tasks must be created in the same location as the Registry
+	registry, err := registriesClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+	}
+	if registry.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *registryId)
+	}
+
+	status := containerregistry.TaskStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = containerregistry.TaskStatusEnabled
+	}
+
+	isSystemTask := d.Get("is_system_task").(bool)
+	properties := containerregistry.TaskProperties{
+		Status:             status,
+		IsSystemTask:       utils.Bool(isSystemTask),
+		Platform:           expandContainerRegistryTaskPlatform(d.Get("platform").([]interface{})),
+		AgentConfiguration: expandContainerRegistryTaskAgentSetting(d.Get("agent_setting").([]interface{})),
+		Timeout:            utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+		Credentials:        expandContainerRegistryTaskRegistryCredential(d.Get("registry_credential").([]interface{})),
+	}
+
+	// System Tasks don't support a Step or Triggers, and the Platform is determined by the service
+	if !isSystemTask {
+		if properties.Platform == nil {
+			return fmt.Errorf("`platform` must be specified when `is_system_task` is `false`")
+		}
+
+		step := expandContainerRegistryTaskStep(d)
+		if step == nil {
+			return fmt.Errorf("one of `docker_step`, `encoded_step` or `file_step` must be specified when `is_system_task` is `false`")
+		}
+		properties.Step = step
+		properties.Trigger = &containerregistry.TriggerProperties{
+			BaseImageTrigger: expandContainerRegistryTaskBaseImageTrigger(d.Get("base_image_trigger").([]interface{})),
+			SourceTriggers:   expandContainerRegistryTaskSourceTriggers(d.Get("source_trigger").([]interface{})),
+			TimerTriggers:    expandContainerRegistryTaskTimerTriggers(d.Get("timer_trigger").([]interface{})),
+		}
+	}
+
+	if v := d.Get("agent_pool_name").(string); v != "" {
+		properties.AgentPoolName = utils.String(v)
+	}
+
+	if v := d.Get("log_template").(string); v != "" {
+		properties.LogTemplate = utils.String(v)
+	}
+
+	parameters := containerregistry.Task{
+		Location:       registry.Location,
+		TaskProperties: &properties,
+		Tags:           tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("identity").([]interface{}); len(v) > 0 {
+		parameters.Identity = expandIdentityProperties(v)
+	}
+
+	// the PUT replaces the Task entirely, which allows for removing Triggers and Credentials on update
+	future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.TaskName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerRegistryTaskRead(d, meta)
+}
+
+func resourceContainerRegistryTaskRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.TasksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryTaskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TaskName)
+	d.Set("container_registry_id", parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID())
+
+	identity, err := flattenIdentityProperties(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.TaskProperties; props != nil {
+		d.Set("enabled", props.Status == containerregistry.TaskStatusEnabled)
+		d.Set("is_system_task", props.IsSystemTask != nil && *props.IsSystemTask)
+		d.Set("agent_pool_name", props.AgentPoolName)
+		d.Set("log_template", props.LogTemplate)
+
+		timeout := 0
+		if props.Timeout != nil {
+			timeout = int(*props.Timeout)
+		}
+		d.Set("timeout_in_seconds", timeout)
+
+		if err := d.Set("platform", flattenContainerRegistryTaskPlatform(props.Platform)); err != nil {
+			return fmt.Errorf("setting `platform`: %+v", err)
+		}
+
+		if err := d.Set("agent_setting", flattenContainerRegistryTaskAgentSetting(props.AgentConfiguration)); err != nil {
+			return fmt.Errorf("setting `agent_setting`: %+v", err)
+		}
+
+		dockerStep := make([]interface{}, 0)
+		encodedStep := make([]interface{}, 0)
+		fileStep := make([]interface{}, 0)
+		if step := props.Step; step != nil {
+			if v, ok := step.AsDockerBuildStep(); ok {
+				dockerStep = flattenContainerRegistryTaskDockerStep(v, d)
+			}
+			if v, ok := step.AsEncodedTaskStep(); ok {
+				encodedStep = flattenContainerRegistryTaskEncodedStep(v, d)
+			}
+			if v, ok := step.AsFileTaskStep(); ok {
+				fileStep = flattenContainerRegistryTaskFileStep(v, d)
+			}
+		}
+		if err := d.Set("docker_step", dockerStep); err != nil {
+			return fmt.Errorf("setting `docker_step`: %+v", err)
+		}
+		if err := d.Set("encoded_step", encodedStep); err != nil {
+			return fmt.Errorf("setting `encoded_step`: %+v", err)
+		}
+		if err := d.Set("file_step", fileStep); err != nil {
+			return fmt.Errorf("setting `file_step`: %+v", err)
+		}
+
+		baseImageTrigger := make([]interface{}, 0)
+		sourceTriggers := make([]interface{}, 0)
+		timerTriggers := make([]interface{}, 0)
+		if trigger := props.Trigger; trigger != nil {
+			baseImageTrigger = flattenContainerRegistryTaskBaseImageTrigger(trigger.BaseImageTrigger)
+			sourceTriggers = flattenContainerRegistryTaskSourceTriggers(trigger.SourceTriggers, d)
+			timerTriggers = flattenContainerRegistryTaskTimerTriggers(trigger.TimerTriggers)
+		}
+		if err := d.Set("base_image_trigger", baseImageTrigger); err != nil {
+			return fmt.Errorf("setting `base_image_trigger`: %+v", err)
+		}
+		if err := d.Set("source_trigger", sourceTriggers); err != nil {
+			return fmt.Errorf("setting `source_trigger`: %+v", err)
+		}
+		if err := d.Set("timer_trigger", timerTriggers); err != nil {
+			return fmt.Errorf("setting `timer_trigger`: %+v", err)
+		}
+
+		if err := d.Set("registry_credential", flattenContainerRegistryTaskRegistryCredential(props.Credentials, d)); err != nil {
+			return fmt.Errorf("setting `registry_credential`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceContainerRegistryTaskDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.TasksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryTaskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandContainerRegistryTaskPlatform(input []interface{}) *containerregistry.PlatformProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerregistry.PlatformProperties{
+		Os:           containerregistry.OS(raw["os"].(string)),
+		Architecture: containerregistry.Architecture(raw["architecture"].(string)),
+		Variant:      containerregistry.Variant(raw["variant"].(string)),
+	}
+}
+
+func flattenContainerRegistryTaskPlatform(input *containerregistry.PlatformProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"os":           string(input.Os),
+			"architecture": string(input.Architecture),
+			"variant":      string(input.Variant),
+		},
+	}
+}
+
+func expandContainerRegistryTaskAgentSetting(input []interface{}) *containerregistry.AgentProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerregistry.AgentProperties{
+		CPU: utils.Int32(int32(raw["cpu"].(int))),
+	}
+}
+
+func flattenContainerRegistryTaskAgentSetting(input *containerregistry.AgentProperties) []interface{} {
+	if input == nil || input.CPU == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu": int(*input.CPU),
+		},
+	}
+}
+
+func expandContainerRegistryTaskStep(d *pluginsdk.ResourceData) containerregistry.BasicTaskStepProperties {
+	if v := d.Get("docker_step").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		step := containerregistry.DockerBuildStep{
+			Type:               containerregistry.TypeDocker,
+			DockerFilePath:     utils.String(raw["dockerfile_path"].(string)),
+			ContextPath:        utils.String(raw["context_path"].(string)),
+			ContextAccessToken: utils.String(raw["context_access_token"].(string)),
+			ImageNames:         utils.ExpandStringSlice(raw["image_names"].([]interface{})),
+			NoCache:            utils.Bool(!raw["cache_enabled"].(bool)),
+			IsPushEnabled:      utils.Bool(raw["push_enabled"].(bool)),
+			Arguments:          expandContainerRegistryTaskArguments(raw["arguments"].(map[string]interface{}), raw["secret_arguments"].(map[string]interface{})),
+		}
+		if target := raw["target"].(string); target != "" {
+			step.Target = utils.String(target)
+		}
+		return step
+	}
+
+	if v := d.Get("encoded_step").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		step := containerregistry.EncodedTaskStep{
+			Type:               containerregistry.TypeEncodedTask,
+			EncodedTaskContent: utils.String(raw["task_content"].(string)),
+			Values:             expandContainerRegistryTaskSetValues(raw["values"].(map[string]interface{}), raw["secret_values"].(map[string]interface{})),
+		}
+		if v := raw["value_content"].(string); v != "" {
+			step.EncodedValuesContent = utils.String(v)
+		}
+		if v := raw["context_path"].(string); v != "" {
+			step.ContextPath = utils.String(v)
+		}
+		if v := raw["context_access_token"].(string); v != "" {
+			step.ContextAccessToken = utils.String(v)
+		}
+		return step
+	}
+
+	if v := d.Get("file_step").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		step := containerregistry.FileTaskStep{
+			Type:         containerregistry.TypeFileTask,
+			TaskFilePath: utils.String(raw["task_file_path"].(string)),
+			Values:       expandContainerRegistryTaskSetValues(raw["values"].(map[string]interface{}), raw["secret_values"].(map[string]interface{})),
+		}
+		if v := raw["value_file_path"].(string); v != "" {
+			step.ValuesFilePath = utils.String(v)
+		}
+		if v := raw["context_path"].(string); v != "" {
+			step.ContextPath = utils.String(v)
+		}
+		if v := raw["context_access_token"].(string); v != "" {
+			step.ContextAccessToken = utils.String(v)
+		}
+		return step
+	}
+
+	return nil
+}
+
+func expandContainerRegistryTaskArguments(values map[string]interface{}, secretValues map[string]interface{}) *[]containerregistry.Argument {
+	output := make([]containerregistry.Argument, 0)
+	for k, v := range values {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretValues {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+func expandContainerRegistryTaskSetValues(values map[string]interface{}, secretValues map[string]interface{}) *[]containerregistry.SetValue {
+	output := make([]containerregistry.SetValue, 0)
+	for k, v := range values {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretValues {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+// the API doesn't return the value of any secrets, as such these are pulled from the existing state
+func flattenContainerRegistryTaskDockerStep(input *containerregistry.DockerBuildStep, d *pluginsdk.ResourceData) []interface{} {
+	dockerfilePath := ""
+	if input.DockerFilePath != nil {
+		dockerfilePath = *input.DockerFilePath
+	}
+
+	contextPath := ""
+	if input.ContextPath != nil {
+		contextPath = *input.ContextPath
+	}
+
+	target := ""
+	if input.Target != nil {
+		target = *input.Target
+	}
+
+	arguments := make(map[string]interface{})
+	if input.Arguments != nil {
+		for _, v := range *input.Arguments {
+			if v.Name == nil || v.Value == nil || (v.IsSecret != nil && *v.IsSecret) {
+				continue
+			}
+			arguments[*v.Name] = *v.Value
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dockerfile_path":      dockerfilePath,
+			"context_path":         contextPath,
+			"context_access_token": d.Get("docker_step.0.context_access_token").(string),
+			"image_names":          utils.FlattenStringSlice(input.ImageNames),
+			"cache_enabled":        input.NoCache == nil || !*input.NoCache,
+			"push_enabled":         input.IsPushEnabled != nil && *input.IsPushEnabled,
+			"target":               target,
+			"arguments":            arguments,
+			"secret_arguments":     d.Get("docker_step.0.secret_arguments").(map[string]interface{}),
+		},
+	}
+}
+
+func flattenContainerRegistryTaskEncodedStep(input *containerregistry.EncodedTaskStep, d *pluginsdk.ResourceData) []interface{} {
+	taskContent := ""
+	if input.EncodedTaskContent != nil {
+		taskContent = *input.EncodedTaskContent
+	}
+
+	contextPath := ""
+	if input.ContextPath != nil {
+		contextPath = *input.ContextPath
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"task_content":         taskContent,
+			"value_content":        d.Get("encoded_step.0.value_content").(string),
+			"context_path":         contextPath,
+			"context_access_token": d.Get("encoded_step.0.context_access_token").(string),
+			"values":               flattenContainerRegistryTaskSetValues(input.Values),
+			"secret_values":        d.Get("encoded_step.0.secret_values").(map[string]interface{}),
+		},
+	}
+}
+
+func flattenContainerRegistryTaskFileStep(input *containerregistry.FileTaskStep, d *pluginsdk.ResourceData) []interface{} {
+	taskFilePath := ""
+	if input.TaskFilePath != nil {
+		taskFilePath = *input.TaskFilePath
+	}
+
+	valueFilePath := ""
+	if input.ValuesFilePath != nil {
+		valueFilePath = *input.ValuesFilePath
+	}
+
+	contextPath := ""
+	if input.ContextPath != nil {
+		contextPath = *input.ContextPath
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"task_file_path":       taskFilePath,
+			"value_file_path":      valueFilePath,
+			"context_path":         contextPath,
+			"context_access_token": d.Get("file_step.0.context_access_token").(string),
+			"values":               flattenContainerRegistryTaskSetValues(input.Values),
+			"secret_values":        d.Get("file_step.0.secret_values").(map[string]interface{}),
+		},
+	}
+}
+
+func flattenContainerRegistryTaskSetValues(input *[]containerregistry.SetValue) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Name == nil || v.Value == nil || (v.IsSecret != nil && *v.IsSecret) {
+			continue
+		}
+		output[*v.Name] = *v.Value
+	}
+	return output
+}
+
+func expandContainerRegistryTaskBaseImageTrigger(input []interface{}) *containerregistry.BaseImageTrigger {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	status := containerregistry.TriggerStatusDisabled
+	if raw["enabled"].(bool) {
+		status = containerregistry.TriggerStatusEnabled
+	}
+
+	output := containerregistry.BaseImageTrigger{
+		Name:                     utils.String(raw["name"].(string)),
+		BaseImageTriggerType:     containerregistry.BaseImageTriggerType(raw["type"].(string)),
+		UpdateTriggerPayloadType: containerregistry.UpdateTriggerPayloadType(raw["update_trigger_payload_type"].(string)),
+		Status:                   status,
+	}
+	if v := raw["update_trigger_endpoint"].(string); v != "" {
+		output.UpdateTriggerEndpoint = utils.String(v)
+	}
+	return &output
+}
+
+func flattenContainerRegistryTaskBaseImageTrigger(input *containerregistry.BaseImageTrigger) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	updateTriggerEndpoint := ""
+	if input.UpdateTriggerEndpoint != nil {
+		updateTriggerEndpoint = *input.UpdateTriggerEndpoint
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                        name,
+			"type":                        string(input.BaseImageTriggerType),
+			"enabled":                     input.Status == containerregistry.TriggerStatusEnabled,
+			"update_trigger_endpoint":     updateTriggerEndpoint,
+			"update_trigger_payload_type": string(input.UpdateTriggerPayloadType),
+		},
+	}
+}
+
+func expandContainerRegistryTaskSourceTriggers(input []interface{}) *[]containerregistry.SourceTrigger {
+	output := make([]containerregistry.SourceTrigger, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		status := containerregistry.TriggerStatusDisabled
+		if raw["enabled"].(bool) {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		events := make([]containerregistry.SourceTriggerEvent, 0)
+		for _, event := range raw["events"].([]interface{}) {
+			events = append(events, containerregistry.SourceTriggerEvent(event.(string)))
+		}
+
+		repository := containerregistry.SourceProperties{
+			SourceControlType: containerregistry.SourceControlType(raw["source_type"].(string)),
+			RepositoryURL:     utils.String(raw["repository_url"].(string)),
+		}
+		if v := raw["branch"].(string); v != "" {
+			repository.Branch = utils.String(v)
+		}
+
+		if auth := raw["authentication"].([]interface{}); len(auth) > 0 && auth[0] != nil {
+			authRaw := auth[0].(map[string]interface{})
+			authInfo := containerregistry.AuthInfo{
+				TokenType: containerregistry.TokenType(authRaw["token_type"].(string)),
+				Token:     utils.String(authRaw["token"].(string)),
+			}
+			if v := authRaw["refresh_token"].(string); v != "" {
+				authInfo.RefreshToken = utils.String(v)
+			}
+			if v := authRaw["scope"].(string); v != "" {
+				authInfo.Scope = utils.String(v)
+			}
+			if v := authRaw["expire_in_seconds"].(int); v != 0 {
+				authInfo.ExpiresIn = utils.Int32(int32(v))
+			}
+			repository.SourceControlAuthProperties = &authInfo
+		}
+
+		output = append(output, containerregistry.SourceTrigger{
+			Name:                utils.String(raw["name"].(string)),
+			SourceTriggerEvents: &events,
+			SourceRepository:    &repository,
+			Status:              status,
+		})
+	}
+	return &output
+}
+
+func flattenContainerRegistryTaskSourceTriggers(input *[]containerregistry.SourceTrigger, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the authentication tokens aren't returned, as such these are looked up by name from the existing state
+	existingAuth := make(map[string]interface{})
+	for _, item := range d.Get("source_trigger").([]interface{}) {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+		existingAuth[raw["name"].(string)] = raw["authentication"]
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		events := make([]interface{}, 0)
+		if item.SourceTriggerEvents != nil {
+			for _, event := range *item.SourceTriggerEvents {
+				events = append(events, string(event))
+			}
+		}
+
+		repositoryUrl := ""
+		sourceType := ""
+		branch := ""
+		if repo := item.SourceRepository; repo != nil {
+			sourceType = string(repo.SourceControlType)
+			if repo.RepositoryURL != nil {
+				repositoryUrl = *repo.RepositoryURL
+			}
+			if repo.Branch != nil {
+				branch = *repo.Branch
+			}
+		}
+
+		authentication := make([]interface{}, 0)
+		if v, ok := existingAuth[name]; ok && v != nil {
+			authentication = v.([]interface{})
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":           name,
+			"events":         events,
+			"repository_url": repositoryUrl,
+			"source_type":    sourceType,
+			"branch":         branch,
+			"enabled":        item.Status == containerregistry.TriggerStatusEnabled,
+			"authentication": authentication,
+		})
+	}
+	return output
+}
+
+func expandContainerRegistryTaskTimerTriggers(input []interface{}) *[]containerregistry.TimerTrigger {
+	output := make([]containerregistry.TimerTrigger, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		status := containerregistry.TriggerStatusDisabled
+		if raw["enabled"].(bool) {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		output = append(output, containerregistry.TimerTrigger{
+			Name:     utils.String(raw["name"].(string)),
+			Schedule: utils.String(raw["schedule"].(string)),
+			Status:   status,
+		})
+	}
+	return &output
+}
+
+func flattenContainerRegistryTaskTimerTriggers(input *[]containerregistry.TimerTrigger) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		schedule := ""
+		if item.Schedule != nil {
+			schedule = *item.Schedule
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":     name,
+			"schedule": schedule,
+			"enabled":  item.Status == containerregistry.TriggerStatusEnabled,
+		})
+	}
+	return output
+}
+
+func expandContainerRegistryTaskRegistryCredential(input []interface{}) *containerregistry.Credentials {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := containerregistry.Credentials{
+		CustomRegistries: make(map[string]*containerregistry.CustomRegistryCredentials),
+	}
+
+	if source := raw["source"].([]interface{}); len(source) > 0 && source[0] != nil {
+		sourceRaw := source[0].(map[string]interface{})
+		output.SourceRegistry = &containerregistry.SourceRegistryCredentials{
+			LoginMode: containerregistry.SourceRegistryLoginMode(sourceRaw["login_mode"].(string)),
+		}
+	}
+
+	for _, item := range raw["custom"].(*pluginsdk.Set).List() {
+		customRaw := item.(map[string]interface{})
+		credential := containerregistry.CustomRegistryCredentials{}
+
+		if v := customRaw["identity"].(string); v != "" {
+			credential.Identity = utils.String(v)
+		}
+		if v := customRaw["username"].(string); v != "" {
+			credential.UserName = &containerregistry.SecretObject{
+				Type:  containerregistry.Opaque,
+				Value: utils.String(v),
+			}
+		}
+		if v := customRaw["password"].(string); v != "" {
+			credential.Password = &containerregistry.SecretObject{
+				Type:  containerregistry.Opaque,
+				Value: utils.String(v),
+			}
+		}
+
+		output.CustomRegistries[customRaw["login_server"].(string)] = &credential
+	}
+
+	return &output
+}
+
+func flattenContainerRegistryTaskRegistryCredential(input *containerregistry.Credentials, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	source := make([]interface{}, 0)
+	if input.SourceRegistry != nil {
+		source = append(source, map[string]interface{}{
+			"login_mode": string(input.SourceRegistry.LoginMode),
+		})
+	}
+
+	// the username and password aren't returned, as such these are looked up by login server from the existing state
+	existing := make(map[string]map[string]interface{})
+	if v := d.Get("registry_credential").([]interface{}); len(v) > 0 && v[0] != nil {
+		for _, item := range v[0].(map[string]interface{})["custom"].(*pluginsdk.Set).List() {
+			raw := item.(map[string]interface{})
+			existing[raw["login_server"].(string)] = raw
+		}
+	}
+
+	custom := make([]interface{}, 0)
+	for loginServer, credential := range input.CustomRegistries {
+		if credential == nil {
+			continue
+		}
+
+		identity := ""
+		if credential.Identity != nil {
+			identity = *credential.Identity
+		}
+
+		username := ""
+		password := ""
+		if v, ok := existing[loginServer]; ok {
+			username = v["username"].(string)
+			password = v["password"].(string)
+		}
+
+		custom = append(custom, map[string]interface{}{
+			"login_server": loginServer,
+			"identity":     identity,
+			"username":     username,
+			"password":     password,
+		})
+	}
+
+	if len(source) == 0 && len(custom) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source": source,
+			"custom": custom,
+		},
+	}
+}
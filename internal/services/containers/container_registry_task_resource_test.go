@@ -0,0 +1,261 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type ContainerRegistryTaskResource struct {
+	githubRepo githubRepo
+}
+
+type githubRepo struct {
+	url   string
+	token string
+}
+
+func preCheckGithubRepo(t *testing.T) githubRepo {
+	variables := []string{
+		"ARM_TEST_ACR_TASK_GITHUB_REPO_URL",
+		"ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN",
+	}
+
+	for _, variable := range variables {
+		if os.Getenv(variable) == "" {
+			t.Skipf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+
+	return githubRepo{
+		url:   os.Getenv("ARM_TEST_ACR_TASK_GITHUB_REPO_URL"),
+		token: os.Getenv("ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN"),
+	}
+}
+
+func TestAccContainerRegistryTask_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_step.0.value_content", "encoded_step.0.secret_values"),
+	})
+}
+
+func TestAccContainerRegistryTask_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryTask_systemTask(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.systemTask(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryTask_dockerStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{githubRepo: preCheckGithubRepo(t)}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_trigger.#").HasValue("1"),
+				check.That(data.ResourceName).Key("timer_trigger.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token", "docker_step.0.secret_arguments", "source_trigger.0.authentication"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_trigger.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("encoded_step.0.value_content", "encoded_step.0.secret_values"),
+	})
+}
+
+func (ContainerRegistryTaskResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryTaskID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.TasksClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ContainerRegistryTaskResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  encoded_step {
+    task_content = base64encode(<<EOT
+version: v1.1.0
+steps:
+  - cmd: mcr.microsoft.com/hello-world
+EOT
+    )
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "import" {
+  name                  = azurerm_container_registry_task.test.name
+  container_registry_id = azurerm_container_registry_task.test.container_registry_id
+
+  platform {
+    os = "Linux"
+  }
+
+  encoded_step {
+    task_content = azurerm_container_registry_task.test.encoded_step.0.task_content
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryTaskResource) systemTask(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "quickrun"
+  container_registry_id = azurerm_container_registry.test.id
+  is_system_task        = true
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryTaskResource) dockerStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  timeout_in_seconds    = 600
+
+  platform {
+    os           = "Linux"
+    architecture = "amd64"
+  }
+
+  agent_setting {
+    cpu = 2
+  }
+
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "%[3]s"
+    context_access_token = "%[4]s"
+    image_names          = ["helloworld:{{.Run.ID}}"]
+
+    arguments = {
+      foo = "bar"
+    }
+
+    secret_arguments = {
+      secret = "value"
+    }
+  }
+
+  source_trigger {
+    name           = "default"
+    events         = ["commit"]
+    repository_url = "%[3]s"
+    source_type    = "Github"
+
+    authentication {
+      token_type = "PAT"
+      token      = "%[4]s"
+    }
+  }
+
+  timer_trigger {
+    name     = "nightly"
+    schedule = "0 21 * * *"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, r.githubRepo.url, r.githubRepo.token)
+}
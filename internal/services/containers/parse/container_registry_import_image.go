@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type ContainerRegistryImportImageId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	RegistryName    string
+	ImportImageName string
+}
+
+func NewContainerRegistryImportImageID(subscriptionId, resourceGroup, registryName, importImageName string) ContainerRegistryImportImageId {
+	return ContainerRegistryImportImageId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		RegistryName:    registryName,
+		ImportImageName: importImageName,
+	}
+}
+
+func (id ContainerRegistryImportImageId) String() string {
+	segments := []string{
+		fmt.Sprintf("Import Image Name %q", id.ImportImageName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Import Image", segmentsStr)
+}
+
+func (id ContainerRegistryImportImageId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/importImages/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.ImportImageName)
+}
+
+// ContainerRegistryImportImageID parses a ContainerRegistryImportImage ID into an ContainerRegistryImportImageId struct
+func ContainerRegistryImportImageID(input string) (*ContainerRegistryImportImageId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerRegistryImportImageId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImportImageName, err = id.PopSegment("importImages"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,15 @@
+package containerinstance
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContainerInstanceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContainerInstanceClientWithBaseURI(endpoint string) ContainerInstanceClient {
+	return ContainerInstanceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
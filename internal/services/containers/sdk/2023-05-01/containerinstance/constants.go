@@ -0,0 +1,165 @@
+package containerinstance
+
+type ContainerGroupIPAddressType string
+
+const (
+	ContainerGroupIPAddressTypePrivate ContainerGroupIPAddressType = "Private"
+	ContainerGroupIPAddressTypePublic  ContainerGroupIPAddressType = "Public"
+)
+
+func PossibleValuesForContainerGroupIPAddressType() []string {
+	return []string{
+		string(ContainerGroupIPAddressTypePrivate),
+		string(ContainerGroupIPAddressTypePublic),
+	}
+}
+
+type ContainerGroupNetworkProtocol string
+
+const (
+	ContainerGroupNetworkProtocolTCP ContainerGroupNetworkProtocol = "TCP"
+	ContainerGroupNetworkProtocolUDP ContainerGroupNetworkProtocol = "UDP"
+)
+
+func PossibleValuesForContainerGroupNetworkProtocol() []string {
+	return []string{
+		string(ContainerGroupNetworkProtocolTCP),
+		string(ContainerGroupNetworkProtocolUDP),
+	}
+}
+
+type ContainerGroupPriority string
+
+const (
+	ContainerGroupPriorityRegular ContainerGroupPriority = "Regular"
+	ContainerGroupPrioritySpot    ContainerGroupPriority = "Spot"
+)
+
+func PossibleValuesForContainerGroupPriority() []string {
+	return []string{
+		string(ContainerGroupPriorityRegular),
+		string(ContainerGroupPrioritySpot),
+	}
+}
+
+type ContainerGroupRestartPolicy string
+
+const (
+	ContainerGroupRestartPolicyAlways    ContainerGroupRestartPolicy = "Always"
+	ContainerGroupRestartPolicyNever     ContainerGroupRestartPolicy = "Never"
+	ContainerGroupRestartPolicyOnFailure ContainerGroupRestartPolicy = "OnFailure"
+)
+
+func PossibleValuesForContainerGroupRestartPolicy() []string {
+	return []string{
+		string(ContainerGroupRestartPolicyAlways),
+		string(ContainerGroupRestartPolicyNever),
+		string(ContainerGroupRestartPolicyOnFailure),
+	}
+}
+
+type ContainerGroupSku string
+
+const (
+	ContainerGroupSkuConfidential ContainerGroupSku = "Confidential"
+	ContainerGroupSkuDedicated    ContainerGroupSku = "Dedicated"
+	ContainerGroupSkuStandard     ContainerGroupSku = "Standard"
+)
+
+func PossibleValuesForContainerGroupSku() []string {
+	return []string{
+		string(ContainerGroupSkuConfidential),
+		string(ContainerGroupSkuDedicated),
+		string(ContainerGroupSkuStandard),
+	}
+}
+
+type ContainerNetworkProtocol string
+
+const (
+	ContainerNetworkProtocolTCP ContainerNetworkProtocol = "TCP"
+	ContainerNetworkProtocolUDP ContainerNetworkProtocol = "UDP"
+)
+
+func PossibleValuesForContainerNetworkProtocol() []string {
+	return []string{
+		string(ContainerNetworkProtocolTCP),
+		string(ContainerNetworkProtocolUDP),
+	}
+}
+
+type GpuSku string
+
+const (
+	GpuSkuKEightZero  GpuSku = "K80"
+	GpuSkuPOneHundred GpuSku = "P100"
+	GpuSkuVOneHundred GpuSku = "V100"
+)
+
+func PossibleValuesForGpuSku() []string {
+	return []string{
+		string(GpuSkuKEightZero),
+		string(GpuSkuPOneHundred),
+		string(GpuSkuVOneHundred),
+	}
+}
+
+type LogAnalyticsLogType string
+
+const (
+	LogAnalyticsLogTypeContainerInsights     LogAnalyticsLogType = "ContainerInsights"
+	LogAnalyticsLogTypeContainerInstanceLogs LogAnalyticsLogType = "ContainerInstanceLogs"
+)
+
+func PossibleValuesForLogAnalyticsLogType() []string {
+	return []string{
+		string(LogAnalyticsLogTypeContainerInsights),
+		string(LogAnalyticsLogTypeContainerInstanceLogs),
+	}
+}
+
+type OperatingSystemTypes string
+
+const (
+	OperatingSystemTypesLinux   OperatingSystemTypes = "Linux"
+	OperatingSystemTypesWindows OperatingSystemTypes = "Windows"
+)
+
+func PossibleValuesForOperatingSystemTypes() []string {
+	return []string{
+		string(OperatingSystemTypesLinux),
+		string(OperatingSystemTypesWindows),
+	}
+}
+
+type ResourceIdentityType string
+
+const (
+	ResourceIdentityTypeNone                       ResourceIdentityType = "None"
+	ResourceIdentityTypeSystemAssigned             ResourceIdentityType = "SystemAssigned"
+	ResourceIdentityTypeSystemAssignedUserAssigned ResourceIdentityType = "SystemAssigned, UserAssigned"
+	ResourceIdentityTypeUserAssigned               ResourceIdentityType = "UserAssigned"
+)
+
+func PossibleValuesForResourceIdentityType() []string {
+	return []string{
+		string(ResourceIdentityTypeNone),
+		string(ResourceIdentityTypeSystemAssigned),
+		string(ResourceIdentityTypeSystemAssignedUserAssigned),
+		string(ResourceIdentityTypeUserAssigned),
+	}
+}
+
+type Scheme string
+
+const (
+	SchemeHttp  Scheme = "http"
+	SchemeHttps Scheme = "https"
+)
+
+func PossibleValuesForScheme() []string {
+	return []string{
+		string(SchemeHttp),
+		string(SchemeHttps),
+	}
+}
@@ -0,0 +1,108 @@
+package containerinstance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerGroupId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ContainerGroupName string
+}
+
+func NewContainerGroupID(subscriptionId, resourceGroup, containerGroupName string) ContainerGroupId {
+	return ContainerGroupId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ContainerGroupName: containerGroupName,
+	}
+}
+
+func (id ContainerGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Container Group Name %q", id.ContainerGroupName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Group", segmentsStr)
+}
+
+func (id ContainerGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerInstance/containerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ContainerGroupName)
+}
+
+// ParseContainerGroupID parses a ContainerGroup ID into an ContainerGroupId struct
+func ParseContainerGroupID(input string) (*ContainerGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ContainerGroupName, err = id.PopSegment("containerGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseContainerGroupIDInsensitively parses an ContainerGroup ID into an ContainerGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseContainerGroupID method should be used instead for validation etc.
+func ParseContainerGroupIDInsensitively(input string) (*ContainerGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'containerGroups' segment
+	containerGroupsKey := "containerGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, containerGroupsKey) {
+			containerGroupsKey = key
+			break
+		}
+	}
+	if resourceId.ContainerGroupName, err = id.PopSegment(containerGroupsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,8 @@
+package containerinstance
+
+type AzureFileVolume struct {
+	ReadOnly           *bool   `json:"readOnly,omitempty"`
+	ShareName          string  `json:"shareName"`
+	StorageAccountKey  *string `json:"storageAccountKey,omitempty"`
+	StorageAccountName string  `json:"storageAccountName"`
+}
@@ -0,0 +1,5 @@
+package containerinstance
+
+type ConfidentialComputeProperties struct {
+	CcePolicy *string `json:"ccePolicy,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerinstance
+
+type Container struct {
+	Name       string              `json:"name"`
+	Properties ContainerProperties `json:"properties"`
+}
@@ -0,0 +1,5 @@
+package containerinstance
+
+type ContainerExec struct {
+	Command *[]string `json:"command,omitempty"`
+}
@@ -0,0 +1,5 @@
+package containerinstance
+
+type ContainerGroupDiagnostics struct {
+	LogAnalytics *LogAnalytics `json:"logAnalytics,omitempty"`
+}
@@ -0,0 +1,8 @@
+package containerinstance
+
+type ContainerGroupIdentity struct {
+	PrincipalId            *string                            `json:"principalId,omitempty"`
+	TenantId               *string                            `json:"tenantId,omitempty"`
+	Type                   *ResourceIdentityType              `json:"type,omitempty"`
+	UserAssignedIdentities *map[string]UserAssignedIdentities `json:"userAssignedIdentities,omitempty"`
+}
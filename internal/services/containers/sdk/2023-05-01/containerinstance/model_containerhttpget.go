@@ -0,0 +1,8 @@
+package containerinstance
+
+type ContainerHttpGet struct {
+	HttpHeaders *[]HttpHeader `json:"httpHeaders,omitempty"`
+	Path        *string       `json:"path,omitempty"`
+	Port        int64         `json:"port"`
+	Scheme      *Scheme       `json:"scheme,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerinstance
+
+type ContainerPort struct {
+	Port     int64                     `json:"port"`
+	Protocol *ContainerNetworkProtocol `json:"protocol,omitempty"`
+}
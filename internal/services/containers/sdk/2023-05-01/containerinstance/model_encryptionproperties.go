@@ -0,0 +1,8 @@
+package containerinstance
+
+type EncryptionProperties struct {
+	Identity     *string `json:"identity,omitempty"`
+	KeyName      string  `json:"keyName"`
+	KeyVersion   string  `json:"keyVersion"`
+	VaultBaseUrl string  `json:"vaultBaseUrl"`
+}
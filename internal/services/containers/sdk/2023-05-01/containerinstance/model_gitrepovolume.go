@@ -0,0 +1,7 @@
+package containerinstance
+
+type GitRepoVolume struct {
+	Directory  *string `json:"directory,omitempty"`
+	Repository string  `json:"repository"`
+	Revision   *string `json:"revision,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerinstance
+
+type GpuResource struct {
+	Count int64  `json:"count"`
+	Sku   GpuSku `json:"sku"`
+}
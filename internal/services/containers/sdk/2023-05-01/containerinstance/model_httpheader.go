@@ -0,0 +1,6 @@
+package containerinstance
+
+type HttpHeader struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,9 @@
+package containerinstance
+
+type ImageRegistryCredential struct {
+	Identity    *string `json:"identity,omitempty"`
+	IdentityUrl *string `json:"identityUrl,omitempty"`
+	Password    *string `json:"password,omitempty"`
+	Server      string  `json:"server"`
+	Username    *string `json:"username,omitempty"`
+}
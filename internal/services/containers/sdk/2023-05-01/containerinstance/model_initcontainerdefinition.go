@@ -0,0 +1,6 @@
+package containerinstance
+
+type InitContainerDefinition struct {
+	Name       string                            `json:"name"`
+	Properties InitContainerPropertiesDefinition `json:"properties"`
+}
@@ -0,0 +1,9 @@
+package containerinstance
+
+type LogAnalytics struct {
+	LogType             *LogAnalyticsLogType `json:"logType,omitempty"`
+	Metadata            *map[string]string   `json:"metadata,omitempty"`
+	WorkspaceId         string               `json:"workspaceId"`
+	WorkspaceKey        string               `json:"workspaceKey"`
+	WorkspaceResourceId *string              `json:"workspaceResourceId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerinstance
+
+type Port struct {
+	Port     int64                          `json:"port"`
+	Protocol *ContainerGroupNetworkProtocol `json:"protocol,omitempty"`
+}
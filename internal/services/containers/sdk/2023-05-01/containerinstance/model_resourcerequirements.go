@@ -0,0 +1,6 @@
+package containerinstance
+
+type ResourceRequirements struct {
+	Limits   *ResourceLimits  `json:"limits,omitempty"`
+	Requests ResourceRequests `json:"requests"`
+}
@@ -0,0 +1,6 @@
+package containerinstance
+
+type UserAssignedIdentities struct {
+	ClientId    *string `json:"clientId,omitempty"`
+	PrincipalId *string `json:"principalId,omitempty"`
+}
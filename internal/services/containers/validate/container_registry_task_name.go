@@ -0,0 +1,12 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+)
+
+func ContainerRegistryTaskName(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]{5,50}$`), fmt.Sprintf("only alpha numeric characters, dashes and underscores in length of 5 to 50 are allowed in %q", k))(v, k)
+}
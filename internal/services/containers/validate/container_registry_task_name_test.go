@@ -0,0 +1,55 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/containers/validate"
+)
+
+func TestContainerRegistryTaskName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "5five",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello.world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello/world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a1234567890123456789012345678901234567890123456789",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a12345678901234567890123456789012345678901234567890",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryTaskName(tc.Value, "azurerm_container_registry_task")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for the Azure RM Container Registry Task Name %q but got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
@@ -3,19 +3,22 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/sdk/2021-10-15-preview/mongorbacs"
 )
 
 type Client struct {
-	CassandraClient            *documentdb.CassandraResourcesClient
-	CassandraClustersClient    *documentdb.CassandraClustersClient
-	CassandraDatacentersClient *documentdb.CassandraDataCentersClient
-	DatabaseClient             *documentdb.DatabaseAccountsClient
-	GremlinClient              *documentdb.GremlinResourcesClient
-	MongoDbClient              *documentdb.MongoDBResourcesClient
-	NotebookWorkspaceClient    *documentdb.NotebookWorkspacesClient
-	SqlClient                  *documentdb.SQLResourcesClient
-	SqlResourceClient          *documentdb.SQLResourcesClient
-	TableClient                *documentdb.TableResourcesClient
+	CassandraClient                  *documentdb.CassandraResourcesClient
+	CassandraClustersClient          *documentdb.CassandraClustersClient
+	CassandraDatacentersClient       *documentdb.CassandraDataCentersClient
+	DatabaseClient                   *documentdb.DatabaseAccountsClient
+	GremlinClient                    *documentdb.GremlinResourcesClient
+	MongoDbClient                    *documentdb.MongoDBResourcesClient
+	MongoRBACClient                  *mongorbacs.MongorbacsClient
+	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
+	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
+	SqlClient                        *documentdb.SQLResourcesClient
+	SqlResourceClient                *documentdb.SQLResourcesClient
+	TableClient                      *documentdb.TableResourcesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -37,9 +40,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	mongoDbClient := documentdb.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&mongoDbClient.Client, o.ResourceManagerAuthorizer)
 
+	mongoRBACClient := mongorbacs.NewMongorbacsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&mongoRBACClient.Client, o.ResourceManagerAuthorizer)
+
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	restorableDatabaseAccountsClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&restorableDatabaseAccountsClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlClient.Client, o.ResourceManagerAuthorizer)
 
@@ -50,15 +59,17 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&tableClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		CassandraClient:            &cassandraClient,
-		CassandraClustersClient:    &cassandraClustersClient,
-		CassandraDatacentersClient: &cassandraDatacentersClient,
-		DatabaseClient:             &databaseClient,
-		GremlinClient:              &gremlinClient,
-		MongoDbClient:              &mongoDbClient,
-		NotebookWorkspaceClient:    &notebookWorkspaceClient,
-		SqlClient:                  &sqlClient,
-		SqlResourceClient:          &sqlResourceClient,
-		TableClient:                &tableClient,
+		CassandraClient:                  &cassandraClient,
+		CassandraClustersClient:          &cassandraClustersClient,
+		CassandraDatacentersClient:       &cassandraDatacentersClient,
+		DatabaseClient:                   &databaseClient,
+		GremlinClient:                    &gremlinClient,
+		MongoDbClient:                    &mongoDbClient,
+		MongoRBACClient:                  &mongoRBACClient,
+		NotebookWorkspaceClient:          &notebookWorkspaceClient,
+		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
+		SqlClient:                        &sqlClient,
+		SqlResourceClient:                &sqlResourceClient,
+		TableClient:                      &tableClient,
 	}
 }
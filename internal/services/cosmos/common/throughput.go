@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
@@ -53,9 +55,46 @@ func SetResourceDataThroughputFromResponse(throughputResponse documentdb.Through
 	d.Set("autoscale_settings", autoscaleSettings)
 }
 
-func CheckForChangeFromAutoscaleAndManualThroughput(d *pluginsdk.ResourceData) error {
-	if d.HasChange("throughput") && d.HasChange("autoscale_settings") {
-		return fmt.Errorf("switching between autoscale and manually provisioned throughput via Terraform is not supported at this time")
+// ThroughputMigrationFuture is the long running operation returned when migrating the throughput of a Cosmos DB resource
+type ThroughputMigrationFuture interface {
+	WaitForCompletionRef(ctx context.Context, client autorest.Client) error
+}
+
+// ThroughputMigrationFunc starts migrating the throughput of a Cosmos DB resource between manual and autoscale
+type ThroughputMigrationFunc func() (ThroughputMigrationFuture, error)
+
+// MigrateThroughputIfRequired migrates the throughput of a Cosmos DB resource in-place when switching between
+// `throughput` and `autoscale_settings`, so that the new throughput settings can then be applied as an update
+func MigrateThroughputIfRequired(ctx context.Context, d *pluginsdk.ResourceData, client autorest.Client, migrateToAutoscale ThroughputMigrationFunc, migrateToManualThroughput ThroughputMigrationFunc) error {
+	if !d.HasChange("autoscale_settings") {
+		return nil
+	}
+
+	oldAutoscale, newAutoscale := d.GetChange("autoscale_settings")
+	wasAutoscale := len(oldAutoscale.([]interface{})) > 0
+	isAutoscale := len(newAutoscale.([]interface{})) > 0
+
+	// a resource without dedicated throughput (e.g. using the throughput of the database) can't be migrated
+	oldThroughput, _ := d.GetChange("throughput")
+	wasManual := !wasAutoscale && oldThroughput.(int) != 0
+
+	var migrate ThroughputMigrationFunc
+	switch {
+	case wasManual && isAutoscale:
+		migrate = migrateToAutoscale
+	case wasAutoscale && !isAutoscale:
+		migrate = migrateToManualThroughput
+	default:
+		return nil
+	}
+
+	future, err := migrate()
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client); err != nil {
+		return fmt.Errorf("waiting for the throughput migration: %+v", err)
 	}
 
 	return nil
@@ -11,12 +11,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/common"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/validate"
 	keyVaultParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/parse"
 	keyVaultSuppress "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
@@ -73,6 +75,28 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				}
 				return nil
 			}),
+
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// a restore can only be performed from an account using continuous backups, and the restored
+				// account must itself use continuous backups
+				createMode := diff.Get("create_mode").(string)
+				restore := diff.Get("restore").([]interface{})
+
+				if createMode == string(documentdb.CreateModeRestore) {
+					if len(restore) == 0 {
+						return fmt.Errorf("`restore` must be specified when `create_mode` is %q", string(documentdb.CreateModeRestore))
+					}
+
+					backup := diff.Get("backup").([]interface{})
+					if len(backup) == 0 || backup[0] == nil || backup[0].(map[string]interface{})["type"].(string) != string(documentdb.TypeContinuous) {
+						return fmt.Errorf("`backup` must be of `type` %q when `create_mode` is %q", string(documentdb.TypeContinuous), string(documentdb.CreateModeRestore))
+					}
+				} else if len(restore) > 0 && diff.HasChange("restore") {
+					return fmt.Errorf("`restore` can only be specified when `create_mode` is %q", string(documentdb.CreateModeRestore))
+				}
+
+				return nil
+			}),
 		),
 
 		// TODO: replace this with an importer which validates the ID during import
@@ -260,6 +284,7 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 								"EnableTable",
 								"EnableServerless",
 								"EnableMongo",
+								"EnableMongoRoleBasedAccessControl",
 								"MongoDBv3.4",
 								"mongoEnableDocLevelTTL",
 								"DisableRateLimitingResponses",
@@ -375,6 +400,68 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"create_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(documentdb.CreateModeDefault),
+					string(documentdb.CreateModeRestore),
+				}, false),
+			},
+
+			"restore": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_cosmosdb_account_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.RestorableDatabaseAccountID,
+						},
+
+						"restore_timestamp_in_utc": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+
+						"database": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"collection_names": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -594,6 +681,18 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		account.DatabaseAccountCreateUpdateProperties.BackupPolicy = policy
 	}
 
+	if v, ok := d.GetOk("create_mode"); ok {
+		account.DatabaseAccountCreateUpdateProperties.CreateMode = documentdb.CreateMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("restore"); ok {
+		restoreParameters, err := expandCosmosdbAccountRestoreParameters(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `restore`: %+v", err)
+		}
+		account.DatabaseAccountCreateUpdateProperties.RestoreParameters = restoreParameters
+	}
+
 	if keyVaultKeyIDRaw, ok := d.GetOk("key_vault_key_id"); ok {
 		keyVaultKey, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyIDRaw.(string))
 		if err != nil {
@@ -884,6 +983,12 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 			return fmt.Errorf("setting `backup`: %+v", err)
 		}
 
+		d.Set("create_mode", string(props.CreateMode))
+
+		if err = d.Set("restore", flattenCosmosdbAccountRestoreParameters(props.RestoreParameters)); err != nil {
+			return fmt.Errorf("setting `restore`: %+v", err)
+		}
+
 		d.Set("cors_rule", common.FlattenCosmosCorsRule(props.Cors))
 	}
 
@@ -1408,3 +1513,88 @@ func flattenAzureRmdocumentdbMachineIdentity(identity *documentdb.ManagedService
 	},
 	}
 }
+
+func expandCosmosdbAccountRestoreParameters(input []interface{}) (*documentdb.RestoreParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	restoreTimestamp, err := time.Parse(time.RFC3339, v["restore_timestamp_in_utc"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `restore_timestamp_in_utc`: %+v", err)
+	}
+
+	sourceId, err := parse.RestorableDatabaseAccountID(v["source_cosmosdb_account_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentdb.RestoreParameters{
+		RestoreMode:           documentdb.RestoreModePointInTime,
+		RestoreSource:         utils.String(sourceId.ID()),
+		RestoreTimestampInUtc: &date.Time{Time: restoreTimestamp},
+		DatabasesToRestore:    expandCosmosdbAccountDatabasesToRestore(v["database"].(*pluginsdk.Set).List()),
+	}, nil
+}
+
+func expandCosmosdbAccountDatabasesToRestore(input []interface{}) *[]documentdb.DatabaseRestoreResource {
+	results := make([]documentdb.DatabaseRestoreResource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, documentdb.DatabaseRestoreResource{
+			DatabaseName:    utils.String(v["name"].(string)),
+			CollectionNames: utils.ExpandStringSlice(v["collection_names"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosdbAccountRestoreParameters(input *documentdb.RestoreParameters) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	restoreSource := ""
+	if input.RestoreSource != nil {
+		restoreSource = *input.RestoreSource
+	}
+
+	restoreTimestamp := ""
+	if input.RestoreTimestampInUtc != nil {
+		restoreTimestamp = input.RestoreTimestampInUtc.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"database":                   flattenCosmosdbAccountDatabasesToRestore(input.DatabasesToRestore),
+			"source_cosmosdb_account_id": restoreSource,
+			"restore_timestamp_in_utc":   restoreTimestamp,
+		},
+	}
+}
+
+func flattenCosmosdbAccountDatabasesToRestore(input *[]documentdb.DatabaseRestoreResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.DatabaseName != nil {
+			name = *item.DatabaseName
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"collection_names": utils.FlattenStringSlice(item.CollectionNames),
+		})
+	}
+
+	return results
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
@@ -735,6 +736,28 @@ func TestAccCosmosDBAccount_backupContinuous(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDBAccount_restore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "restore")
+	r := CosmosDBAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restoreSource(data),
+		},
+		{
+			// the restore timestamp is 15 minutes after the source account was created, which must be in the
+			// past and after the source containers were created
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.restore(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("create_mode").HasValue("Restore"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccCosmosDBAccount_networkBypass(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "test")
 	r := CosmosDBAccountResource{}
@@ -2297,3 +2320,68 @@ resource "azurerm_cosmosdb_account" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, string(kind), string(consistency))
 }
+
+func (r CosmosDBAccountResource) restoreSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-sqldb-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-sqlcontainer-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+}
+
+data "azurerm_cosmosdb_restorable_database_accounts" "test" {
+  name     = azurerm_cosmosdb_account.test.name
+  location = azurerm_resource_group.test.location
+
+  depends_on = [azurerm_cosmosdb_sql_container.test]
+}
+`, r.basicWithBackupContinuous(data, documentdb.DatabaseAccountKindGlobalDocumentDB, documentdb.DefaultConsistencyLevelEventual), data.RandomInteger)
+}
+
+func (r CosmosDBAccountResource) restore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_account" "restore" {
+  name                = "acctest-ca-restore-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+  create_mode         = "Restore"
+
+  restore {
+    source_cosmosdb_account_id = data.azurerm_cosmosdb_restorable_database_accounts.test.accounts[0].id
+    restore_timestamp_in_utc   = timeadd(data.azurerm_cosmosdb_restorable_database_accounts.test.accounts[0].creation_time, "15m")
+
+    database {
+      name             = azurerm_cosmosdb_sql_database.test.name
+      collection_names = [azurerm_cosmosdb_sql_container.test.name]
+    }
+  }
+
+  consistency_policy {
+    consistency_level = "Eventual"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  backup {
+    type = "Continuous"
+  }
+}
+`, r.restoreSource(data), data.RandomInteger)
+}
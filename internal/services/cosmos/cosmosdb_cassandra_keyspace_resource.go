@@ -144,11 +144,6 @@ func resourceCosmosDbCassandraKeyspaceUpdate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Cassandra Keyspace %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.CassandraKeyspaceCreateUpdateParameters{
 		CassandraKeyspaceCreateUpdateProperties: &documentdb.CassandraKeyspaceCreateUpdateProperties{
 			Resource: &documentdb.CassandraKeyspaceResource{
@@ -168,6 +163,16 @@ func resourceCosmosDbCassandraKeyspaceUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if common.HasThroughputChange(d) {
+		migrateToAutoscale := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateCassandraKeyspaceToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		}
+		migrateToManualThroughput := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateCassandraKeyspaceToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		}
+		if err := common.MigrateThroughputIfRequired(ctx, d, client.Client, migrateToAutoscale, migrateToManualThroughput); err != nil {
+			return fmt.Errorf("migrating the throughput of Cosmos Cassandra Keyspace %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
+		}
+
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateCassandraKeyspaceThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
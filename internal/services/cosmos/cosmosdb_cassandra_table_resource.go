@@ -159,11 +159,6 @@ func resourceCosmosDbCassandraTableUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
-	}
-
 	table := documentdb.CassandraTableCreateUpdateParameters{
 		CassandraTableCreateUpdateProperties: &documentdb.CassandraTableCreateUpdateProperties{
 			Resource: &documentdb.CassandraTableResource{
@@ -189,6 +184,16 @@ func resourceCosmosDbCassandraTableUpdate(d *pluginsdk.ResourceData, meta interf
 	}
 
 	if common.HasThroughputChange(d) {
+		migrateToAutoscale := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateCassandraTableToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+		}
+		migrateToManualThroughput := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateCassandraTableToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+		}
+		if err := common.MigrateThroughputIfRequired(ctx, d, client.Client, migrateToAutoscale, migrateToManualThroughput); err != nil {
+			return fmt.Errorf("migrating the throughput of %s: %+v", *id, err)
+		}
+
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateCassandraTableThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName, *throughputParameters)
 		if err != nil {
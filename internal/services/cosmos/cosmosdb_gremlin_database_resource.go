@@ -144,11 +144,6 @@ func resourceCosmosGremlinDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Gremlin Database %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.GremlinDatabaseCreateUpdateParameters{
 		GremlinDatabaseCreateUpdateProperties: &documentdb.GremlinDatabaseCreateUpdateProperties{
 			Resource: &documentdb.GremlinDatabaseResource{
@@ -168,6 +163,16 @@ func resourceCosmosGremlinDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	if common.HasThroughputChange(d) {
+		migrateToAutoscale := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateGremlinDatabaseToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		}
+		migrateToManualThroughput := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateGremlinDatabaseToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		}
+		if err := common.MigrateThroughputIfRequired(ctx, d, client.Client, migrateToAutoscale, migrateToManualThroughput); err != nil {
+			return fmt.Errorf("migrating the throughput of Cosmos Gremlin Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
+		}
+
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateGremlinDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
@@ -276,11 +276,6 @@ func resourceCosmosDbGremlinGraphUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Gremlin Graph %q (Account: %q, Database: %q): %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
-	}
-
 	partitionkeypaths := d.Get("partition_key_path").(string)
 
 	db := documentdb.GremlinGraphCreateUpdateParameters{
@@ -324,6 +319,16 @@ func resourceCosmosDbGremlinGraphUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	if common.HasThroughputChange(d) {
+		migrateToAutoscale := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateGremlinGraphToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
+		}
+		migrateToManualThroughput := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateGremlinGraphToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
+		}
+		if err := common.MigrateThroughputIfRequired(ctx, d, client.Client, migrateToAutoscale, migrateToManualThroughput); err != nil {
+			return fmt.Errorf("migrating the throughput of Cosmos Gremlin Graph %q (Account: %q, Database: %q): %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
+		}
+
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateGremlinGraphThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName, *throughputParameters)
 		if err != nil {
@@ -229,11 +229,6 @@ func resourceCosmosDbMongoCollectionUpdate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Mongo Collection %q (Account: %q, Database: %q): %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
-	}
-
 	var ttl *int
 	if v := d.Get("default_ttl_seconds").(int); v > 0 {
 		ttl = utils.Int(v)
@@ -269,6 +264,16 @@ func resourceCosmosDbMongoCollectionUpdate(d *pluginsdk.ResourceData, meta inter
 	}
 
 	if common.HasThroughputChange(d) {
+		migrateToAutoscale := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateMongoDBCollectionToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+		}
+		migrateToManualThroughput := func() (common.ThroughputMigrationFuture, error) {
+			return client.MigrateMongoDBCollectionToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+		}
+		if err := common.MigrateThroughputIfRequired(ctx, d, client.Client, migrateToAutoscale, migrateToManualThroughput); err != nil {
+			return fmt.Errorf("migrating the throughput of Cosmos Mongo Collection %q (Account: %q, Database: %q): %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
+		}
+
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateMongoDBCollectionThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName, *throughputParameters)
 		if err != nil {
@@ -0,0 +1,336 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/sdk/2021-10-15-preview/mongorbacs"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCosmosDbMongoRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbMongoRoleDefinitionCreate,
+		Read:   resourceCosmosDbMongoRoleDefinitionRead,
+		Update: resourceCosmosDbMongoRoleDefinitionUpdate,
+		Delete: resourceCosmosDbMongoRoleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := mongorbacs.ParseMongodbRoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"cosmos_mongo_database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MongodbDatabaseID,
+			},
+
+			"role_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"inherited_role_names": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"privilege": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"resource": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"collection_name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"db_name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCosmosDbMongoRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.MongodbDatabaseID(d.Get("cosmos_mongo_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	roleName := d.Get("role_name").(string)
+	id := mongorbacs.NewMongodbRoleDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, roleName))
+
+	existing, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_mongo_role_definition", id.ID())
+	}
+
+	roleType := mongorbacs.MongoRoleDefinitionTypeCustomRole
+	parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+		Properties: &mongorbacs.MongoRoleDefinitionResource{
+			DatabaseName: utils.String(databaseId.Name),
+			RoleName:     utils.String(roleName),
+			Type:         &roleType,
+			Roles:        expandCosmosDbMongoRoles(databaseId.Name, d.Get("inherited_role_names").([]interface{})),
+			Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(d.Get("privilege").([]interface{})),
+		},
+	}
+
+	if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCosmosDbMongoRoleDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbMongoRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			databaseName := ""
+			if props.DatabaseName != nil {
+				databaseName = *props.DatabaseName
+			}
+			d.Set("cosmos_mongo_database_id", parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, databaseName).ID())
+			d.Set("role_name", props.RoleName)
+
+			if err := d.Set("inherited_role_names", flattenCosmosDbMongoRoles(props.Roles)); err != nil {
+				return fmt.Errorf("setting `inherited_role_names`: %+v", err)
+			}
+
+			if err := d.Set("privilege", flattenCosmosDbMongoRoleDefinitionPrivileges(props.Privileges)); err != nil {
+				return fmt.Errorf("setting `privilege`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbMongoRoleDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseId, err := parse.MongodbDatabaseID(d.Get("cosmos_mongo_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	roleType := mongorbacs.MongoRoleDefinitionTypeCustomRole
+	parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+		Properties: &mongorbacs.MongoRoleDefinitionResource{
+			DatabaseName: utils.String(databaseId.Name),
+			RoleName:     utils.String(d.Get("role_name").(string)),
+			Type:         &roleType,
+			Roles:        expandCosmosDbMongoRoles(databaseId.Name, d.Get("inherited_role_names").([]interface{})),
+			Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(d.Get("privilege").([]interface{})),
+		},
+	}
+
+	if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbMongoRoleDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbMongoRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.MongoDBResourcesDeleteMongoRoleDefinitionThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// expandCosmosDbMongoRoles builds the inherited roles for a Mongo Role or User Definition, which are always
+// looked up within the same Mongo Database
+func expandCosmosDbMongoRoles(databaseName string, input []interface{}) *[]mongorbacs.Role {
+	results := make([]mongorbacs.Role, 0)
+
+	for _, item := range input {
+		results = append(results, mongorbacs.Role{
+			Db:   utils.String(databaseName),
+			Role: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosDbMongoRoles(input *[]mongorbacs.Role) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Role != nil {
+			results = append(results, *item.Role)
+		}
+	}
+
+	return results
+}
+
+func expandCosmosDbMongoRoleDefinitionPrivileges(input []interface{}) *[]mongorbacs.Privilege {
+	results := make([]mongorbacs.Privilege, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, mongorbacs.Privilege{
+			Actions:  utils.ExpandStringSlice(v["actions"].(*pluginsdk.Set).List()),
+			Resource: expandCosmosDbMongoRoleDefinitionPrivilegeResource(v["resource"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandCosmosDbMongoRoleDefinitionPrivilegeResource(input []interface{}) *mongorbacs.PrivilegeResource {
+	if len(input) == 0 || input[0] == nil {
+		return &mongorbacs.PrivilegeResource{}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	result := mongorbacs.PrivilegeResource{}
+	if collectionName := v["collection_name"].(string); collectionName != "" {
+		result.Collection = utils.String(collectionName)
+	}
+	if dbName := v["db_name"].(string); dbName != "" {
+		result.Db = utils.String(dbName)
+	}
+
+	return &result
+}
+
+func flattenCosmosDbMongoRoleDefinitionPrivileges(input *[]mongorbacs.Privilege) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		resource := make([]interface{}, 0)
+		if item.Resource != nil {
+			collectionName := ""
+			if item.Resource.Collection != nil {
+				collectionName = *item.Resource.Collection
+			}
+
+			dbName := ""
+			if item.Resource.Db != nil {
+				dbName = *item.Resource.Db
+			}
+
+			resource = append(resource, map[string]interface{}{
+				"collection_name": collectionName,
+				"db_name":         dbName,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"actions":  utils.FlattenStringSlice(item.Actions),
+			"resource": resource,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,203 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/sdk/2021-10-15-preview/mongorbacs"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCosmosDbMongoUserDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbMongoUserDefinitionCreate,
+		Read:   resourceCosmosDbMongoUserDefinitionRead,
+		Update: resourceCosmosDbMongoUserDefinitionUpdate,
+		Delete: resourceCosmosDbMongoUserDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := mongorbacs.ParseMongodbUserDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"cosmos_mongo_database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MongodbDatabaseID,
+			},
+
+			"username": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"inherited_role_names": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceCosmosDbMongoUserDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.MongodbDatabaseID(d.Get("cosmos_mongo_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	userName := d.Get("username").(string)
+	id := mongorbacs.NewMongodbUserDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, userName))
+
+	existing, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_mongo_user_definition", id.ID())
+	}
+
+	parameters := mongorbacs.MongoUserDefinitionCreateUpdateParameters{
+		Properties: &mongorbacs.MongoUserDefinitionResource{
+			DatabaseName: utils.String(databaseId.Name),
+			UserName:     utils.String(userName),
+			Password:     utils.String(d.Get("password").(string)),
+			Mechanisms:   utils.String("SCRAM-SHA-256"),
+			Roles:        expandCosmosDbMongoRoles(databaseId.Name, d.Get("inherited_role_names").([]interface{})),
+		},
+	}
+
+	if err := client.MongoDBResourcesCreateUpdateMongoUserDefinitionThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCosmosDbMongoUserDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbMongoUserDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbUserDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			databaseName := ""
+			if props.DatabaseName != nil {
+				databaseName = *props.DatabaseName
+			}
+			d.Set("cosmos_mongo_database_id", parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, databaseName).ID())
+			d.Set("username", props.UserName)
+
+			// the password isn't returned by the API so we keep the value from the config
+
+			if err := d.Set("inherited_role_names", flattenCosmosDbMongoRoles(props.Roles)); err != nil {
+				return fmt.Errorf("setting `inherited_role_names`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbMongoUserDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbUserDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseId, err := parse.MongodbDatabaseID(d.Get("cosmos_mongo_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := mongorbacs.MongoUserDefinitionCreateUpdateParameters{
+		Properties: &mongorbacs.MongoUserDefinitionResource{
+			DatabaseName: utils.String(databaseId.Name),
+			UserName:     utils.String(d.Get("username").(string)),
+			Password:     utils.String(d.Get("password").(string)),
+			Mechanisms:   utils.String("SCRAM-SHA-256"),
+			Roles:        expandCosmosDbMongoRoles(databaseId.Name, d.Get("inherited_role_names").([]interface{})),
+		},
+	}
+
+	if err := client.MongoDBResourcesCreateUpdateMongoUserDefinitionThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbMongoUserDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbMongoUserDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoRBACClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := mongorbacs.ParseMongodbUserDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.MongoDBResourcesDeleteMongoUserDefinitionThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/sdk/2021-10-15-preview/mongorbacs"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type CosmosDbMongoUserDefinitionResource struct{}
+
+func TestAccCosmosDbMongoUserDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+	r := CosmosDbMongoUserDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccCosmosDbMongoUserDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+	r := CosmosDbMongoUserDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCosmosDbMongoUserDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+	r := CosmosDbMongoUserDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inherited_role_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (CosmosDbMongoUserDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := mongorbacs.ParseMongodbUserDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cosmos.MongoRBACClient.MongoDBResourcesGetMongoUserDefinition(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (CosmosDbMongoUserDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_user_definition" "test" {
+  cosmos_mongo_database_id = azurerm_cosmosdb_mongo_database.test.id
+  username                 = "acctestmongouser%d"
+  password                 = "QAZwsx123"
+}
+`, CosmosDbMongoRoleDefinitionResource{}.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbMongoUserDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_user_definition" "import" {
+  cosmos_mongo_database_id = azurerm_cosmosdb_mongo_user_definition.test.cosmos_mongo_database_id
+  username                 = azurerm_cosmosdb_mongo_user_definition.test.username
+  password                 = azurerm_cosmosdb_mongo_user_definition.test.password
+}
+`, r.basic(data))
+}
+
+func (CosmosDbMongoUserDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_mongo_role_definition" "test" {
+  cosmos_mongo_database_id = azurerm_cosmosdb_mongo_database.test.id
+  role_name                = "acctestmongorole%[2]d"
+}
+
+resource "azurerm_cosmosdb_mongo_user_definition" "test" {
+  cosmos_mongo_database_id = azurerm_cosmosdb_mongo_database.test.id
+  username                 = "acctestmongouser%[2]d"
+  password                 = "QAZwsx456"
+  inherited_role_names     = [azurerm_cosmosdb_mongo_role_definition.test.role_name]
+}
+`, CosmosDbMongoRoleDefinitionResource{}.template(data), data.RandomInteger)
+}
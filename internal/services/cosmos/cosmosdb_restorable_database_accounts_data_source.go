@@ -0,0 +1,186 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+)
+
+func dataSourceCosmosDbRestorableDatabaseAccounts() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbRestorableDatabaseAccountsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"accounts": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"api_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"deletion_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"restorable_locations": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"creation_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"deletion_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"location": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"regional_database_account_instance_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmosDbRestorableDatabaseAccountsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.RestorableDatabaseAccountsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.ListByLocation(ctx, loc)
+	if err != nil {
+		return fmt.Errorf("retrieving Cosmos DB Restorable Database Accounts (Location %q): %+v", loc, err)
+	}
+
+	accounts := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, item := range *resp.Value {
+			// the API doesn't support filtering on the account name, so this is done client-side
+			if props := item.RestorableDatabaseAccountProperties; props != nil && props.AccountName != nil && *props.AccountName == name {
+				accounts = append(accounts, flattenCosmosDbRestorableDatabaseAccount(item))
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.DocumentDB/locations/%s/restorableDatabaseAccounts/%s", subscriptionId, loc, name))
+	d.Set("name", name)
+	d.Set("location", loc)
+
+	if err := d.Set("accounts", accounts); err != nil {
+		return fmt.Errorf("setting `accounts`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenCosmosDbRestorableDatabaseAccount(input documentdb.RestorableDatabaseAccountGetResult) map[string]interface{} {
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	apiType := ""
+	creationTime := ""
+	deletionTime := ""
+	restorableLocations := make([]interface{}, 0)
+	if props := input.RestorableDatabaseAccountProperties; props != nil {
+		apiType = string(props.APIType)
+
+		if props.CreationTime != nil {
+			creationTime = props.CreationTime.Format(time.RFC3339)
+		}
+
+		if props.DeletionTime != nil {
+			deletionTime = props.DeletionTime.Format(time.RFC3339)
+		}
+
+		if props.RestorableLocations != nil {
+			for _, item := range *props.RestorableLocations {
+				restorableLocations = append(restorableLocations, flattenCosmosDbRestorableLocation(item))
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                   id,
+		"api_type":             apiType,
+		"creation_time":        creationTime,
+		"deletion_time":        deletionTime,
+		"restorable_locations": restorableLocations,
+	}
+}
+
+func flattenCosmosDbRestorableLocation(input documentdb.RestorableLocationResource) map[string]interface{} {
+	creationTime := ""
+	if input.CreationTime != nil {
+		creationTime = input.CreationTime.Format(time.RFC3339)
+	}
+
+	deletionTime := ""
+	if input.DeletionTime != nil {
+		deletionTime = input.DeletionTime.Format(time.RFC3339)
+	}
+
+	regionalDatabaseAccountInstanceId := ""
+	if input.RegionalDatabaseAccountInstanceID != nil {
+		regionalDatabaseAccountInstanceId = *input.RegionalDatabaseAccountInstanceID
+	}
+
+	return map[string]interface{}{
+		"creation_time":                         creationTime,
+		"deletion_time":                         deletionTime,
+		"location":                              location.NormalizeNilable(input.LocationName),
+		"regional_database_account_instance_id": regionalDatabaseAccountInstanceId,
+	}
+}
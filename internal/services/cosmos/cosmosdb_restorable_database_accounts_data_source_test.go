@@ -0,0 +1,40 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type CosmosDbRestorableDatabaseAccountsDataSource struct{}
+
+func TestAccCosmosDbRestorableDatabaseAccountsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_restorable_database_accounts", "test")
+	r := CosmosDbRestorableDatabaseAccountsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("accounts.#").HasValue("1"),
+				check.That(data.ResourceName).Key("accounts.0.id").Exists(),
+				check.That(data.ResourceName).Key("accounts.0.api_type").HasValue("Sql"),
+				check.That(data.ResourceName).Key("accounts.0.restorable_locations.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (CosmosDbRestorableDatabaseAccountsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_restorable_database_accounts" "test" {
+  name     = azurerm_cosmosdb_account.test.name
+  location = azurerm_resource_group.test.location
+}
+`, CosmosDBAccountResource{}.basicWithBackupContinuous(data, documentdb.DatabaseAccountKindGlobalDocumentDB, documentdb.DefaultConsistencyLevelEventual))
+}
@@ -0,0 +1,310 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceCosmosDbSQLRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbSQLRoleDefinitionCreate,
+		Read:   resourceCosmosDbSQLRoleDefinitionRead,
+		Update: resourceCosmosDbSQLRoleDefinitionUpdate,
+		Delete: resourceCosmosDbSQLRoleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SqlRoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.SqlRoleScope,
+				},
+			},
+
+			"permissions": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(documentdb.RoleDefinitionTypeCustomRole),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(documentdb.RoleDefinitionTypeBuiltInRole),
+					string(documentdb.RoleDefinitionTypeCustomRole),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCosmosDbSQLRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	if roleDefinitionId == "" {
+		generated, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Cosmos DB SQL Role Definition: %+v", err)
+		}
+		roleDefinitionId = generated
+	}
+
+	id := parse.NewSqlRoleDefinitionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), roleDefinitionId)
+
+	existing, err := client.GetSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_role_definition", id.ID())
+	}
+
+	parameters, err := expandCosmosDbSQLRoleDefinitionParameters(d, id)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.CreateUpdateSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, *parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCosmosDbSQLRoleDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbSQLRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("role_definition_id", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.DatabaseAccountName)
+
+	if props := resp.SQLRoleDefinitionResource; props != nil {
+		d.Set("name", props.RoleName)
+		d.Set("type", string(props.Type))
+
+		if err := d.Set("assignable_scopes", utils.FlattenStringSlice(props.AssignableScopes)); err != nil {
+			return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+		}
+
+		if err := d.Set("permissions", flattenCosmosDbSQLRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permissions`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbSQLRoleDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters, err := expandCosmosDbSQLRoleDefinitionParameters(d, *id)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.CreateUpdateSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, *parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbSQLRoleDefinitionRead(d, meta)
+}
+
+func resourceCosmosDbSQLRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteSQLRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCosmosDbSQLRoleDefinitionParameters(d *pluginsdk.ResourceData, id parse.SqlRoleDefinitionId) (*documentdb.SQLRoleDefinitionCreateUpdateParameters, error) {
+	accountId := parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName)
+
+	assignableScopes := make([]string, 0)
+	for _, v := range d.Get("assignable_scopes").(*pluginsdk.Set).List() {
+		scope := v.(string)
+		if err := validateCosmosDbSQLRoleScopeWithinAccount(scope, accountId); err != nil {
+			return nil, fmt.Errorf("validating `assignable_scopes`: %+v", err)
+		}
+		assignableScopes = append(assignableScopes, scope)
+	}
+
+	return &documentdb.SQLRoleDefinitionCreateUpdateParameters{
+		SQLRoleDefinitionResource: &documentdb.SQLRoleDefinitionResource{
+			RoleName:         utils.String(d.Get("name").(string)),
+			Type:             documentdb.RoleDefinitionType(d.Get("type").(string)),
+			AssignableScopes: &assignableScopes,
+			Permissions:      expandCosmosDbSQLRoleDefinitionPermissions(d.Get("permissions").(*pluginsdk.Set).List()),
+		},
+	}, nil
+}
+
+// validateCosmosDbSQLRoleScopeWithinAccount ensures a data plane scope refers to the Database Account the
+// Role Definition or Role Assignment belongs to, since the API only reports this once the request is sent
+func validateCosmosDbSQLRoleScopeWithinAccount(input string, accountId parse.DatabaseAccountId) error {
+	scope, err := parse.SqlRoleScope(input)
+	if err != nil {
+		return err
+	}
+
+	if scope.DatabaseAccountId != accountId {
+		return fmt.Errorf("the scope %q must be within the Database Account %q", input, accountId.ID())
+	}
+
+	return nil
+}
+
+func expandCosmosDbSQLRoleDefinitionPermissions(input []interface{}) *[]documentdb.Permission {
+	results := make([]documentdb.Permission, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, documentdb.Permission{
+			DataActions: utils.ExpandStringSlice(v["data_actions"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosDbSQLRoleDefinitionPermissions(input *[]documentdb.Permission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"data_actions": utils.FlattenStringSlice(item.DataActions),
+		})
+	}
+
+	return results
+}
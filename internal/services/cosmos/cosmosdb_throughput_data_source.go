@@ -0,0 +1,148 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/cosmos/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceCosmosDbThroughput() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbThroughputRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"throughput": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"minimum_throughput": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"autoscale_settings": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"max_throughput": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"offer_replace_pending": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmosDbThroughputRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Get("resource_id").(string)
+
+	resp, err := getCosmosDbThroughputSettings(ctx, meta.(*clients.Client), resourceId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("throughput settings for %q were not found", resourceId)
+		}
+		return fmt.Errorf("retrieving throughput settings for %q: %+v", resourceId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/throughputSettings/default", resourceId))
+	d.Set("resource_id", resourceId)
+
+	throughput := 0
+	minimumThroughput := 0
+	offerReplacePending := false
+	if props := resp.ThroughputSettingsGetProperties; props != nil {
+		if res := props.Resource; res != nil {
+			if res.Throughput != nil {
+				throughput = int(*res.Throughput)
+			}
+
+			if res.MinimumThroughput != nil {
+				v, err := strconv.Atoi(*res.MinimumThroughput)
+				if err != nil {
+					return fmt.Errorf("parsing `minimum_throughput` %q: %+v", *res.MinimumThroughput, err)
+				}
+				minimumThroughput = v
+			}
+
+			if res.OfferReplacePending != nil {
+				offerReplacePending = strings.EqualFold(*res.OfferReplacePending, "true")
+			}
+		}
+	}
+	d.Set("throughput", throughput)
+	d.Set("minimum_throughput", minimumThroughput)
+	d.Set("offer_replace_pending", offerReplacePending)
+
+	if err := d.Set("autoscale_settings", common.FlattenCosmosDbAutoscaleSettings(resp)); err != nil {
+		return fmt.Errorf("setting `autoscale_settings`: %+v", err)
+	}
+
+	return nil
+}
+
+// getCosmosDbThroughputSettings retrieves the throughput settings for any Cosmos DB Database, Collection, Container,
+// Keyspace, Graph or Table which supports dedicated throughput
+func getCosmosDbThroughputSettings(ctx context.Context, client *clients.Client, resourceId string) (documentdb.ThroughputSettingsGetResults, error) {
+	if id, err := parse.SqlContainerID(resourceId); err == nil {
+		return client.Cosmos.SqlClient.GetSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	}
+	if id, err := parse.SqlDatabaseID(resourceId); err == nil {
+		return client.Cosmos.SqlClient.GetSQLDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	}
+	if id, err := parse.MongodbCollectionID(resourceId); err == nil {
+		return client.Cosmos.MongoDbClient.GetMongoDBCollectionThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+	}
+	if id, err := parse.MongodbDatabaseID(resourceId); err == nil {
+		return client.Cosmos.MongoDbClient.GetMongoDBDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	}
+	if id, err := parse.CassandraTableID(resourceId); err == nil {
+		return client.Cosmos.CassandraClient.GetCassandraTableThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+	}
+	if id, err := parse.CassandraKeyspaceID(resourceId); err == nil {
+		return client.Cosmos.CassandraClient.GetCassandraKeyspaceThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	}
+	if id, err := parse.GremlinGraphID(resourceId); err == nil {
+		return client.Cosmos.GremlinClient.GetGremlinGraphThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
+	}
+	if id, err := parse.GremlinDatabaseID(resourceId); err == nil {
+		return client.Cosmos.GremlinClient.GetGremlinDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	}
+	if id, err := parse.TableID(resourceId); err == nil {
+		return client.Cosmos.TableClient.GetTableThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	}
+
+	return documentdb.ThroughputSettingsGetResults{}, fmt.Errorf("%q is not a Cosmos DB resource which supports throughput", resourceId)
+}
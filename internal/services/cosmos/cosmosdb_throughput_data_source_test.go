@@ -0,0 +1,62 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type CosmosDbThroughputDataSource struct{}
+
+func TestAccDataSourceCosmosDbThroughput_manual(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_throughput", "test")
+	r := CosmosDbThroughputDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.manual(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("throughput").HasValue("700"),
+				check.That(data.ResourceName).Key("minimum_throughput").Exists(),
+				check.That(data.ResourceName).Key("autoscale_settings.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceCosmosDbThroughput_autoscale(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_throughput", "test")
+	r := CosmosDbThroughputDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.autoscale(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("minimum_throughput").Exists(),
+				check.That(data.ResourceName).Key("autoscale_settings.0.max_throughput").HasValue("4000"),
+			),
+		},
+	})
+}
+
+func (CosmosDbThroughputDataSource) manual(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_throughput" "test" {
+  resource_id = azurerm_cosmosdb_sql_database.test.id
+}
+`, CosmosSqlDatabaseResource{}.throughput(data, 700))
+}
+
+func (CosmosDbThroughputDataSource) autoscale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_throughput" "test" {
+  resource_id = azurerm_cosmosdb_sql_database.test.id
+}
+`, CosmosSqlDatabaseResource{}.autoscale(data, 4000))
+}
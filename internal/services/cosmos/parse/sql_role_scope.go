@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SqlRoleScopeId is the data plane scope used by SQL Role Definitions and SQL Role Assignments, which is
+// a Database Account ID optionally followed by `/dbs/{databaseName}` and then `/colls/{containerName}`
+type SqlRoleScopeId struct {
+	DatabaseAccountId DatabaseAccountId
+	DatabaseName      string
+	ContainerName     string
+}
+
+func NewSqlRoleScopeID(databaseAccountId DatabaseAccountId, databaseName, containerName string) SqlRoleScopeId {
+	return SqlRoleScopeId{
+		DatabaseAccountId: databaseAccountId,
+		DatabaseName:      databaseName,
+		ContainerName:     containerName,
+	}
+}
+
+func (id SqlRoleScopeId) ID() string {
+	scope := id.DatabaseAccountId.ID()
+	if id.DatabaseName != "" {
+		scope += fmt.Sprintf("/dbs/%s", id.DatabaseName)
+		if id.ContainerName != "" {
+			scope += fmt.Sprintf("/colls/%s", id.ContainerName)
+		}
+	}
+	return scope
+}
+
+// SqlRoleScope parses a SQL Role scope into an SqlRoleScopeId struct
+func SqlRoleScope(input string) (*SqlRoleScopeId, error) {
+	accountId := input
+	var databaseName, containerName string
+
+	if index := strings.Index(input, "/dbs/"); index != -1 {
+		accountId = input[:index]
+
+		segments := strings.Split(input[index+len("/dbs/"):], "/")
+		switch {
+		case len(segments) == 1 && segments[0] != "":
+			databaseName = segments[0]
+		case len(segments) == 3 && segments[0] != "" && segments[1] == "colls" && segments[2] != "":
+			databaseName = segments[0]
+			containerName = segments[2]
+		default:
+			return nil, fmt.Errorf("expected the scope %q to end with `/dbs/{databaseName}` or `/dbs/{databaseName}/colls/{containerName}`", input)
+		}
+	}
+
+	if strings.Contains(accountId, "/sqlDatabases/") {
+		return nil, fmt.Errorf("the scope %q must use the data plane format `/dbs/{databaseName}/colls/{containerName}` rather than a SQL Database or SQL Container resource ID", input)
+	}
+
+	databaseAccountId, err := DatabaseAccountID(accountId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Database Account within the scope %q: %+v", input, err)
+	}
+
+	return &SqlRoleScopeId{
+		DatabaseAccountId: *databaseAccountId,
+		DatabaseName:      databaseName,
+		ContainerName:     containerName,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestSqlRoleScopeFormatter(t *testing.T) {
+	accountId := NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1")
+
+	testData := []struct {
+		Input    SqlRoleScopeId
+		Expected string
+	}{
+		{
+			Input:    NewSqlRoleScopeID(accountId, "", ""),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1",
+		},
+		{
+			Input:    NewSqlRoleScopeID(accountId, "db1", ""),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1",
+		},
+		{
+			Input:    NewSqlRoleScopeID(accountId, "db1", "container1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/colls/container1",
+		},
+	}
+
+	for _, v := range testData {
+		if actual := v.Input.ID(); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestSqlRoleScope(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *SqlRoleScopeId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/",
+			Error: true,
+		},
+
+		{
+			// account
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+			},
+		},
+
+		{
+			// database
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+				DatabaseName:      "db1",
+			},
+		},
+
+		{
+			// missing value for database
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/",
+			Error: true,
+		},
+
+		{
+			// container
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/colls/container1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+				DatabaseName:      "db1",
+				ContainerName:     "container1",
+			},
+		},
+
+		{
+			// missing value for container
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/colls/",
+			Error: true,
+		},
+
+		{
+			// unexpected segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/containers/container1",
+			Error: true,
+		},
+
+		{
+			// sql container resource id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlDatabases/db1/containers/container1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := SqlRoleScope(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.DatabaseAccountId != v.Expected.DatabaseAccountId {
+			t.Fatalf("Expected %+v but got %+v for DatabaseAccountId", v.Expected.DatabaseAccountId, actual.DatabaseAccountId)
+		}
+		if actual.DatabaseName != v.Expected.DatabaseName {
+			t.Fatalf("Expected %q but got %q for DatabaseName", v.Expected.DatabaseName, actual.DatabaseName)
+		}
+		if actual.ContainerName != v.Expected.ContainerName {
+			t.Fatalf("Expected %q but got %q for ContainerName", v.Expected.ContainerName, actual.ContainerName)
+		}
+	}
+}
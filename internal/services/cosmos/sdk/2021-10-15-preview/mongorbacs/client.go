@@ -0,0 +1,15 @@
+package mongorbacs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MongorbacsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMongorbacsClientWithBaseURI(endpoint string) MongorbacsClient {
+	return MongorbacsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
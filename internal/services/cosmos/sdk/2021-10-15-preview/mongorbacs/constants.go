@@ -0,0 +1,15 @@
+package mongorbacs
+
+type MongoRoleDefinitionType string
+
+const (
+	MongoRoleDefinitionTypeBuiltInRole MongoRoleDefinitionType = "BuiltInRole"
+	MongoRoleDefinitionTypeCustomRole  MongoRoleDefinitionType = "CustomRole"
+)
+
+func PossibleValuesForMongoRoleDefinitionType() []string {
+	return []string{
+		string(MongoRoleDefinitionTypeBuiltInRole),
+		string(MongoRoleDefinitionTypeCustomRole),
+	}
+}
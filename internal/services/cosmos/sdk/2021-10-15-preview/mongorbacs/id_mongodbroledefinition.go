@@ -0,0 +1,126 @@
+package mongorbacs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MongodbRoleDefinitionId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	DatabaseAccountName     string
+	MongoRoleDefinitionName string
+}
+
+func NewMongodbRoleDefinitionID(subscriptionId, resourceGroup, databaseAccountName, mongoRoleDefinitionName string) MongodbRoleDefinitionId {
+	return MongodbRoleDefinitionId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		DatabaseAccountName:     databaseAccountName,
+		MongoRoleDefinitionName: mongoRoleDefinitionName,
+	}
+}
+
+func (id MongodbRoleDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Mongo Role Definition Name %q", id.MongoRoleDefinitionName),
+		fmt.Sprintf("Database Account Name %q", id.DatabaseAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Mongodb Role Definition", segmentsStr)
+}
+
+func (id MongodbRoleDefinitionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbRoleDefinitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongoRoleDefinitionName)
+}
+
+// ParseMongodbRoleDefinitionID parses a MongodbRoleDefinition ID into an MongodbRoleDefinitionId struct
+func ParseMongodbRoleDefinitionID(input string) (*MongodbRoleDefinitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MongodbRoleDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.MongoRoleDefinitionName, err = id.PopSegment("mongodbRoleDefinitions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseMongodbRoleDefinitionIDInsensitively parses an MongodbRoleDefinition ID into an MongodbRoleDefinitionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseMongodbRoleDefinitionID method should be used instead for validation etc.
+func ParseMongodbRoleDefinitionIDInsensitively(input string) (*MongodbRoleDefinitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MongodbRoleDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'databaseAccounts' segment
+	databaseAccountsKey := "databaseAccounts"
+	for key := range id.Path {
+		if strings.EqualFold(key, databaseAccountsKey) {
+			databaseAccountsKey = key
+			break
+		}
+	}
+	if resourceId.DatabaseAccountName, err = id.PopSegment(databaseAccountsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'mongodbRoleDefinitions' segment
+	mongodbRoleDefinitionsKey := "mongodbRoleDefinitions"
+	for key := range id.Path {
+		if strings.EqualFold(key, mongodbRoleDefinitionsKey) {
+			mongodbRoleDefinitionsKey = key
+			break
+		}
+	}
+	if resourceId.MongoRoleDefinitionName, err = id.PopSegment(mongodbRoleDefinitionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
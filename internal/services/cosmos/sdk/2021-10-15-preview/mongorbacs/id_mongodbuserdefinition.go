@@ -0,0 +1,126 @@
+package mongorbacs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MongodbUserDefinitionId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	DatabaseAccountName     string
+	MongoUserDefinitionName string
+}
+
+func NewMongodbUserDefinitionID(subscriptionId, resourceGroup, databaseAccountName, mongoUserDefinitionName string) MongodbUserDefinitionId {
+	return MongodbUserDefinitionId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		DatabaseAccountName:     databaseAccountName,
+		MongoUserDefinitionName: mongoUserDefinitionName,
+	}
+}
+
+func (id MongodbUserDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Mongo User Definition Name %q", id.MongoUserDefinitionName),
+		fmt.Sprintf("Database Account Name %q", id.DatabaseAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Mongodb User Definition", segmentsStr)
+}
+
+func (id MongodbUserDefinitionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbUserDefinitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongoUserDefinitionName)
+}
+
+// ParseMongodbUserDefinitionID parses a MongodbUserDefinition ID into an MongodbUserDefinitionId struct
+func ParseMongodbUserDefinitionID(input string) (*MongodbUserDefinitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MongodbUserDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.MongoUserDefinitionName, err = id.PopSegment("mongodbUserDefinitions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseMongodbUserDefinitionIDInsensitively parses an MongodbUserDefinition ID into an MongodbUserDefinitionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseMongodbUserDefinitionID method should be used instead for validation etc.
+func ParseMongodbUserDefinitionIDInsensitively(input string) (*MongodbUserDefinitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MongodbUserDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'databaseAccounts' segment
+	databaseAccountsKey := "databaseAccounts"
+	for key := range id.Path {
+		if strings.EqualFold(key, databaseAccountsKey) {
+			databaseAccountsKey = key
+			break
+		}
+	}
+	if resourceId.DatabaseAccountName, err = id.PopSegment(databaseAccountsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'mongodbUserDefinitions' segment
+	mongodbUserDefinitionsKey := "mongodbUserDefinitions"
+	for key := range id.Path {
+		if strings.EqualFold(key, mongodbUserDefinitionsKey) {
+			mongodbUserDefinitionsKey = key
+			break
+		}
+	}
+	if resourceId.MongoUserDefinitionName, err = id.PopSegment(mongodbUserDefinitionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,64 @@
+package mongorbacs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type MongoDBResourcesGetMongoUserDefinitionResponse struct {
+	HttpResponse *http.Response
+	Model        *MongoUserDefinitionGetResults
+}
+
+// MongoDBResourcesGetMongoUserDefinition ...
+func (c MongorbacsClient) MongoDBResourcesGetMongoUserDefinition(ctx context.Context, id MongodbUserDefinitionId) (result MongoDBResourcesGetMongoUserDefinitionResponse, err error) {
+	req, err := c.preparerForMongoDBResourcesGetMongoUserDefinition(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mongorbacs.MongorbacsClient", "MongoDBResourcesGetMongoUserDefinition", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mongorbacs.MongorbacsClient", "MongoDBResourcesGetMongoUserDefinition", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForMongoDBResourcesGetMongoUserDefinition(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mongorbacs.MongorbacsClient", "MongoDBResourcesGetMongoUserDefinition", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForMongoDBResourcesGetMongoUserDefinition prepares the MongoDBResourcesGetMongoUserDefinition request.
+func (c MongorbacsClient) preparerForMongoDBResourcesGetMongoUserDefinition(ctx context.Context, id MongodbUserDefinitionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForMongoDBResourcesGetMongoUserDefinition handles the response to the MongoDBResourcesGetMongoUserDefinition request. The method always
+// closes the http.Response Body.
+func (c MongorbacsClient) responderForMongoDBResourcesGetMongoUserDefinition(resp *http.Response) (result MongoDBResourcesGetMongoUserDefinitionResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package mongorbacs
+
+type MongoRoleDefinitionCreateUpdateParameters struct {
+	Properties *MongoRoleDefinitionResource `json:"properties,omitempty"`
+}
@@ -0,0 +1,8 @@
+package mongorbacs
+
+type MongoRoleDefinitionGetResults struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *MongoRoleDefinitionResource `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
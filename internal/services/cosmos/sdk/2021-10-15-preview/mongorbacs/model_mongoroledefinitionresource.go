@@ -0,0 +1,9 @@
+package mongorbacs
+
+type MongoRoleDefinitionResource struct {
+	DatabaseName *string                  `json:"databaseName,omitempty"`
+	Privileges   *[]Privilege             `json:"privileges,omitempty"`
+	RoleName     *string                  `json:"roleName,omitempty"`
+	Roles        *[]Role                  `json:"roles,omitempty"`
+	Type         *MongoRoleDefinitionType `json:"type,omitempty"`
+}
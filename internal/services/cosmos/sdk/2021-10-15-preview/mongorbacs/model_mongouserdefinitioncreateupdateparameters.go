@@ -0,0 +1,5 @@
+package mongorbacs
+
+type MongoUserDefinitionCreateUpdateParameters struct {
+	Properties *MongoUserDefinitionResource `json:"properties,omitempty"`
+}
@@ -0,0 +1,10 @@
+package mongorbacs
+
+type MongoUserDefinitionResource struct {
+	CustomData   *string `json:"customData,omitempty"`
+	DatabaseName *string `json:"databaseName,omitempty"`
+	Mechanisms   *string `json:"mechanisms,omitempty"`
+	Password     *string `json:"password,omitempty"`
+	Roles        *[]Role `json:"roles,omitempty"`
+	UserName     *string `json:"userName,omitempty"`
+}
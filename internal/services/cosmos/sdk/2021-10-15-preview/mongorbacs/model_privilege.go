@@ -0,0 +1,6 @@
+package mongorbacs
+
+type Privilege struct {
+	Actions  *[]string          `json:"actions,omitempty"`
+	Resource *PrivilegeResource `json:"resource,omitempty"`
+}
@@ -0,0 +1,6 @@
+package mongorbacs
+
+type PrivilegeResource struct {
+	Collection *string `json:"collection,omitempty"`
+	Db         *string `json:"db,omitempty"`
+}
@@ -0,0 +1,6 @@
+package mongorbacs
+
+type Role struct {
+	Db   *string `json:"db,omitempty"`
+	Role *string `json:"role,omitempty"`
+}
@@ -0,0 +1,9 @@
+package mongorbacs
+
+import "fmt"
+
+const defaultApiVersion = "2021-10-15-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/mongorbacs/%s", defaultApiVersion)
+}
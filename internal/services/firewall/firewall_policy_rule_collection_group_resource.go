@@ -1,6 +1,8 @@
 package firewall
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -60,10 +62,17 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(100, 65000),
 			},
 
+			"split_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"application_rule_collection": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -84,114 +93,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validate.FirewallPolicyRuleName(),
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validate.FirewallPolicyRuleName(),
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"type": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
-													}, false),
-												},
-												"port": {
-													Type:         pluginsdk.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(0, 64000),
-												},
-											},
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_urls": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdn_tags": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"terminate_tls": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"web_categories": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyApplicationRuleSchema(),
 					},
 				},
 			},
@@ -200,6 +102,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -220,89 +123,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validate.FirewallPolicyRuleName(),
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolAny),
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-												string(network.FirewallPolicyRuleNetworkProtocolICMP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											// Can be IP address, CIDR, "*", or service tag
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												azValidate.PortOrPortRangeWithin(1, 65535),
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyNetworkRuleSchema(),
 					},
 				},
 			},
@@ -311,6 +132,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				MinItems: 1,
+				Set:      resourceFirewallPolicyNameHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -334,84 +156,296 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								"Dnat",
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validate.FirewallPolicyRuleName(),
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_address": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										ValidateFunc: validation.Any(
-											validation.IsIPAddress,
-											validation.IsCIDR,
-										),
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
-										},
-									},
-									"translated_address": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsIPAddress,
-									},
-									"translated_port": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IsPortNumber,
-									},
-									"translated_fqdn": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
+						"rule": firewallPolicyNatRuleSchema(),
+					},
+				},
+			},
+
+			"split_rule_collection_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyApplicationRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Set:      resourceFirewallPolicyNameHash,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.FirewallPolicyRuleName(),
+				},
+				"description": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.FirewallPolicyRuleName(),
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"type": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+								}, false),
+							},
+							"port": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 64000),
 							},
 						},
 					},
 				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_urls": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdn_tags": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"terminate_tls": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"web_categories": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNetworkRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Set:      resourceFirewallPolicyNameHash,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.FirewallPolicyRuleName(),
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolAny),
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+							string(network.FirewallPolicyRuleNetworkProtocolICMP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						// Can be IP address, CIDR, "*", or service tag
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							azValidate.PortOrPortRangeWithin(1, 65535),
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNatRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Set:      resourceFirewallPolicyNameHash,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.FirewallPolicyRuleName(),
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_address": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+					),
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
+					},
+				},
+				"translated_address": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				"translated_port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"translated_fqdn": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
 			},
 		},
 	}
@@ -444,11 +478,6 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
-	param := network.FirewallPolicyRuleCollectionGroup{
-		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
-			Priority: utils.Int32(int32(d.Get("priority").(int))),
-		},
-	}
 	var rulesCollections []network.BasicFirewallPolicyRuleCollection
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
@@ -459,14 +488,38 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	}
 	rulesCollections = append(rulesCollections, natRules...)
 
-	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
-
-	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
+	// a single Rule Collection Group is limited in both the number of rules and the size of the request, when
+	// `split_enabled` is set the Rule Collections are spread across additional groups with consecutive priorities
+	batches, err := splitFirewallPolicyRuleCollections(rulesCollections, firewallPolicyRuleCollectionGroupMaxRules, firewallPolicyRuleCollectionGroupMaxSizeInBytes)
 	if err != nil {
-		return fmt.Errorf("creating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
+		return fmt.Errorf("splitting Rule Collections for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
+	if len(batches) > 1 && !d.Get("split_enabled").(bool) {
+		return fmt.Errorf("the Rule Collections for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) exceed the limit of %d rules or %d bytes for a single Rule Collection Group - set `split_enabled` to spread them across multiple Rule Collection Groups", name, policyId.ResourceGroup, policyId.Name, firewallPolicyRuleCollectionGroupMaxRules, firewallPolicyRuleCollectionGroupMaxSizeInBytes)
+	}
+
+	priority := d.Get("priority").(int)
+	if last := priority + len(batches) - 1; last > 65000 {
+		return fmt.Errorf("splitting Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) requires %d Rule Collection Groups with priorities %d-%d but the maximum priority is 65000", name, policyId.ResourceGroup, policyId.Name, len(batches), priority, last)
+	}
+
+	for i, batch := range batches {
+		groupName := name
+		if i > 0 {
+			groupName = firewallPolicyRuleCollectionGroupSplitName(name, i)
+		}
+		groupId := parse.NewFirewallPolicyRuleCollectionGroupID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, groupName)
+		if err := createOrUpdateFirewallPolicyRuleCollectionGroup(ctx, client, groupId, priority+i, batch); err != nil {
+			return err
+		}
+	}
+
+	// remove any split Rule Collection Groups which are no longer required
+	for i := len(batches); i <= len(d.Get("split_rule_collection_group_ids").([]interface{})); i++ {
+		groupId := parse.NewFirewallPolicyRuleCollectionGroupID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, firewallPolicyRuleCollectionGroupSplitName(name, i))
+		if err := deleteFirewallPolicyRuleCollectionGroup(ctx, client, groupId); err != nil {
+			return err
+		}
 	}
 
 	resp, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
@@ -508,10 +561,43 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("priority", resp.Priority)
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
-	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
+	ruleCollections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	if props := resp.FirewallPolicyRuleCollectionGroupProperties; props != nil {
+		d.Set("priority", props.Priority)
+
+		if props.RuleCollections != nil {
+			ruleCollections = append(ruleCollections, *props.RuleCollections...)
+		}
+	}
+
+	// the Rule Collections of a split group live in consecutively numbered Rule Collection Groups alongside this one
+	splitIds := make([]interface{}, 0)
+	for i := 1; ; i++ {
+		splitId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, firewallPolicyRuleCollectionGroupSplitName(id.RuleCollectionGroupName, i))
+		splitResp, err := client.Get(ctx, splitId.ResourceGroup, splitId.FirewallPolicyName, splitId.RuleCollectionGroupName)
+		if err != nil {
+			if utils.ResponseWasNotFound(splitResp.Response) {
+				break
+			}
+
+			return fmt.Errorf("retrieving split %s: %+v", splitId, err)
+		}
+
+		if props := splitResp.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+			ruleCollections = append(ruleCollections, *props.RuleCollections...)
+		}
+		splitIds = append(splitIds, splitId.ID())
+	}
+	if len(splitIds) > 0 {
+		d.Set("split_enabled", true)
+	}
+	if err := d.Set("split_rule_collection_group_ids", splitIds); err != nil {
+		return fmt.Errorf("setting `split_rule_collection_group_ids`: %+v", err)
+	}
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(&ruleCollections)
 	if err != nil {
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 	}
@@ -542,6 +628,40 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
 
+	for _, v := range d.Get("split_rule_collection_group_ids").([]interface{}) {
+		splitId, err := parse.FirewallPolicyRuleCollectionGroupID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		if err := deleteFirewallPolicyRuleCollectionGroup(ctx, client, *splitId); err != nil {
+			return err
+		}
+	}
+
+	return deleteFirewallPolicyRuleCollectionGroup(ctx, client, *id)
+}
+
+func createOrUpdateFirewallPolicyRuleCollectionGroup(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId, priority int, ruleCollections []network.BasicFirewallPolicyRuleCollection) error {
+	param := network.FirewallPolicyRuleCollectionGroup{
+		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
+			Priority:        utils.Int32(int32(priority)),
+			RuleCollections: &ruleCollections,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, param)
+	if err != nil {
+		return fmt.Errorf("creating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+
+	return nil
+}
+
+func deleteFirewallPolicyRuleCollectionGroup(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId) error {
 	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
 	if err != nil {
 		return fmt.Errorf("deleting Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
@@ -555,6 +675,17 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func resourceFirewallPolicyNameHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	// rule collections and rules are keyed by name so that changing one doesn't replace its siblings in the plan
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
 	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
 }
@@ -134,6 +134,22 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_splitEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.splitEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("split_rule_collection_group_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("split_enabled"),
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	var id, err = parse.FirewallPolicyRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -741,3 +757,45 @@ resource "azurerm_firewall_policy_rule_collection_group" "import" {
 }
 `, template)
 }
+
+func (FirewallPolicyRuleCollectionGroupResource) splitEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  split_enabled      = true
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "192.168.1.2"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+    rule {
+      name                  = "network_rule_collection1_rule2"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.2"]
+      destination_addresses = ["192.168.1.3"]
+      destination_ports     = ["443"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,119 @@
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+)
+
+const (
+	// firewallPolicyRuleCollectionGroupMaxRules is the maximum number of rules which can be sent for a single Rule Collection Group
+	firewallPolicyRuleCollectionGroupMaxRules = 20000
+
+	// firewallPolicyRuleCollectionGroupMaxSizeInBytes is the maximum size of the Rule Collections sent for a single Rule Collection Group,
+	// this leaves some headroom below the 2MB limit for the remainder of the request body
+	firewallPolicyRuleCollectionGroupMaxSizeInBytes = 2*1024*1024 - 16*1024
+)
+
+// firewallPolicyRuleCollectionGroupSplitName returns the name of the nth additional Rule Collection Group
+// used to hold the Rule Collections of a group which has been split
+func firewallPolicyRuleCollectionGroupSplitName(name string, index int) string {
+	return fmt.Sprintf("%s-split-%d", name, index)
+}
+
+// splitFirewallPolicyRuleCollections orders the Rule Collections by priority and then partitions them into
+// batches which each fit within the rule count and size limits of a single Rule Collection Group. A single
+// batch is always returned, even when there are no Rule Collections, since the group itself must exist.
+func splitFirewallPolicyRuleCollections(input []network.BasicFirewallPolicyRuleCollection, maxRules int, maxSizeInBytes int) ([][]network.BasicFirewallPolicyRuleCollection, error) {
+	collections := make([]network.BasicFirewallPolicyRuleCollection, len(input))
+	copy(collections, input)
+	sort.SliceStable(collections, func(i, j int) bool {
+		iName, iPriority := firewallPolicyRuleCollectionNameAndPriority(collections[i])
+		jName, jPriority := firewallPolicyRuleCollectionNameAndPriority(collections[j])
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		return iName < jName
+	})
+
+	batches := [][]network.BasicFirewallPolicyRuleCollection{make([]network.BasicFirewallPolicyRuleCollection, 0)}
+	batchRules := 0
+	batchSize := 0
+	for _, collection := range collections {
+		name, _ := firewallPolicyRuleCollectionNameAndPriority(collection)
+
+		rules := firewallPolicyRuleCollectionRuleCount(collection)
+		if rules > maxRules {
+			return nil, fmt.Errorf("rule collection %q contains %d rules which exceeds the maximum of %d for a single Rule Collection Group", name, rules, maxRules)
+		}
+
+		b, err := json.Marshal(collection)
+		if err != nil {
+			return nil, fmt.Errorf("serializing rule collection %q: %+v", name, err)
+		}
+		size := len(b)
+		if size > maxSizeInBytes {
+			return nil, fmt.Errorf("rule collection %q is %d bytes which exceeds the maximum of %d bytes for a single Rule Collection Group", name, size, maxSizeInBytes)
+		}
+
+		current := len(batches) - 1
+		if len(batches[current]) > 0 && (batchRules+rules > maxRules || batchSize+size > maxSizeInBytes) {
+			batches = append(batches, make([]network.BasicFirewallPolicyRuleCollection, 0))
+			current++
+			batchRules = 0
+			batchSize = 0
+		}
+
+		batches[current] = append(batches[current], collection)
+		batchRules += rules
+		batchSize += size
+	}
+
+	return batches, nil
+}
+
+func firewallPolicyRuleCollectionNameAndPriority(input network.BasicFirewallPolicyRuleCollection) (string, int32) {
+	var name *string
+	var priority *int32
+	switch collection := input.(type) {
+	case *network.FirewallPolicyFilterRuleCollection:
+		name, priority = collection.Name, collection.Priority
+	case network.FirewallPolicyFilterRuleCollection:
+		name, priority = collection.Name, collection.Priority
+	case *network.FirewallPolicyNatRuleCollection:
+		name, priority = collection.Name, collection.Priority
+	case network.FirewallPolicyNatRuleCollection:
+		name, priority = collection.Name, collection.Priority
+	}
+
+	var outputName string
+	if name != nil {
+		outputName = *name
+	}
+	var outputPriority int32
+	if priority != nil {
+		outputPriority = *priority
+	}
+	return outputName, outputPriority
+}
+
+func firewallPolicyRuleCollectionRuleCount(input network.BasicFirewallPolicyRuleCollection) int {
+	var rules *[]network.BasicFirewallPolicyRule
+	switch collection := input.(type) {
+	case *network.FirewallPolicyFilterRuleCollection:
+		rules = collection.Rules
+	case network.FirewallPolicyFilterRuleCollection:
+		rules = collection.Rules
+	case *network.FirewallPolicyNatRuleCollection:
+		rules = collection.Rules
+	case network.FirewallPolicyNatRuleCollection:
+		rules = collection.Rules
+	}
+
+	if rules == nil {
+		return 0
+	}
+	return len(*rules)
+}
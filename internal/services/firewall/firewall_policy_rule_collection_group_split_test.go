@@ -0,0 +1,132 @@
+package firewall
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func TestSplitFirewallPolicyRuleCollections(t *testing.T) {
+	collection := func(name string, priority int32, rules int) network.BasicFirewallPolicyRuleCollection {
+		items := make([]network.BasicFirewallPolicyRule, 0)
+		for i := 0; i < rules; i++ {
+			items = append(items, &network.Rule{
+				Name:     utils.String("rule"),
+				RuleType: network.RuleTypeNetworkRule,
+			})
+		}
+		return &network.FirewallPolicyFilterRuleCollection{
+			Name:               utils.String(name),
+			Priority:           utils.Int32(priority),
+			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
+			Rules:              &items,
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []network.BasicFirewallPolicyRuleCollection
+		MaxRules int
+		MaxSize  int
+		Expected [][]string
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    []network.BasicFirewallPolicyRuleCollection{},
+			MaxRules: 10,
+			MaxSize:  1024 * 1024,
+			Expected: [][]string{{}},
+		},
+		{
+			Name: "Within Limits",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("b", 200, 3),
+				collection("a", 100, 3),
+			},
+			MaxRules: 10,
+			MaxSize:  1024 * 1024,
+			Expected: [][]string{{"a", "b"}},
+		},
+		{
+			Name: "Split On Rule Count",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("c", 300, 4),
+				collection("a", 100, 4),
+				collection("b", 200, 4),
+			},
+			MaxRules: 10,
+			MaxSize:  1024 * 1024,
+			Expected: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			Name: "Split On Size",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("a", 100, 5),
+				collection("b", 200, 5),
+			},
+			MaxRules: 100,
+			MaxSize:  400,
+			Expected: [][]string{{"a"}, {"b"}},
+		},
+		{
+			Name: "Equal Priorities Ordered By Name",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("b", 100, 1),
+				collection("a", 100, 1),
+			},
+			MaxRules: 1,
+			MaxSize:  1024 * 1024,
+			Expected: [][]string{{"a"}, {"b"}},
+		},
+		{
+			Name: "Single Collection Exceeds Rule Count",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("a", 100, 11),
+			},
+			MaxRules: 10,
+			MaxSize:  1024 * 1024,
+			Error:    true,
+		},
+		{
+			Name: "Single Collection Exceeds Size",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				collection("a", 100, 10),
+			},
+			MaxRules: 100,
+			MaxSize:  100,
+			Error:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := splitFirewallPolicyRuleCollections(v.Input, v.MaxRules, v.MaxSize)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d batches but got %d", len(v.Expected), len(actual))
+		}
+		for i, batch := range actual {
+			if len(batch) != len(v.Expected[i]) {
+				t.Fatalf("expected %d rule collections in batch %d but got %d", len(v.Expected[i]), i, len(batch))
+			}
+			for j, item := range batch {
+				name, _ := firewallPolicyRuleCollectionNameAndPriority(item)
+				if name != v.Expected[i][j] {
+					t.Fatalf("expected rule collection %d in batch %d to be %q but got %q", j, i, v.Expected[i][j], name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/firewall/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/firewall/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceFirewallPolicyRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyRuleCollectionCreate,
+		Read:   resourceFirewallPolicyRuleCollectionRead,
+		Update: resourceFirewallPolicyRuleCollectionUpdate,
+		Delete: resourceFirewallPolicyRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"firewall_policy_rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+					string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+					// see the note on `nat_rule_collection` in the Rule Collection Group resource for why this isn't `DNAT`
+					"Dnat",
+				}, false),
+			},
+
+			"application_rule": firewallPolicyRuleCollectionRuleSchema(firewallPolicyApplicationRuleSchema()),
+
+			"network_rule": firewallPolicyRuleCollectionRuleSchema(firewallPolicyNetworkRuleSchema()),
+
+			"nat_rule": firewallPolicyRuleCollectionRuleSchema(firewallPolicyNatRuleSchema()),
+		},
+	}
+}
+
+func firewallPolicyRuleCollectionRuleSchema(input *pluginsdk.Schema) *pluginsdk.Schema {
+	input.Required = false
+	input.Optional = true
+	input.ExactlyOneOf = []string{"application_rule", "network_rule", "nat_rule"}
+	return input
+}
+
+func resourceFirewallPolicyRuleCollectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("firewall_policy_rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewFirewallPolicyRuleCollectionID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("name").(string))
+
+	collection, err := expandFirewallPolicyRuleCollectionResource(d)
+	if err != nil {
+		return err
+	}
+
+	// the Rule Collection is only addressable through its Rule Collection Group, so every change is a
+	// read-modify-write of the group which must be serialised with everything else touching the policy
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *groupId, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *groupId)
+	}
+
+	ruleCollections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	if group.RuleCollections != nil {
+		ruleCollections = append(ruleCollections, *group.RuleCollections...)
+	}
+	if findFirewallPolicyRuleCollection(ruleCollections, id.RuleCollectionName) != -1 {
+		return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection", id.ID())
+	}
+	ruleCollections = append(ruleCollections, collection)
+
+	if err := createOrUpdateFirewallPolicyRuleCollectionGroup(ctx, client, *groupId, int(utils.NormaliseNilableInt32(group.Priority)), ruleCollections); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyRuleCollectionRead(d, meta)
+}
+
+func resourceFirewallPolicyRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", groupId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+
+	ruleCollections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	if props := group.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+		ruleCollections = *props.RuleCollections
+	}
+
+	index := findFirewallPolicyRuleCollection(ruleCollections, id.RuleCollectionName)
+	if index == -1 {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(&[]network.BasicFirewallPolicyRuleCollection{ruleCollections[index]})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleCollectionName)
+	d.Set("firewall_policy_rule_collection_group_id", groupId.ID())
+
+	applicationRules := make([]interface{}, 0)
+	networkRules := make([]interface{}, 0)
+	natRules := make([]interface{}, 0)
+	var collection map[string]interface{}
+	switch {
+	case len(applicationRuleCollections) > 0:
+		collection = applicationRuleCollections[0].(map[string]interface{})
+		applicationRules = collection["rule"].([]interface{})
+	case len(networkRuleCollections) > 0:
+		collection = networkRuleCollections[0].(map[string]interface{})
+		networkRules = collection["rule"].([]interface{})
+	case len(natRuleCollections) > 0:
+		collection = natRuleCollections[0].(map[string]interface{})
+		natRules = collection["rule"].([]interface{})
+	}
+	if collection != nil {
+		d.Set("priority", collection["priority"])
+		d.Set("action", collection["action"])
+	}
+
+	if err := d.Set("application_rule", applicationRules); err != nil {
+		return fmt.Errorf("setting `application_rule`: %+v", err)
+	}
+	if err := d.Set("network_rule", networkRules); err != nil {
+		return fmt.Errorf("setting `network_rule`: %+v", err)
+	}
+	if err := d.Set("nat_rule", natRules); err != nil {
+		return fmt.Errorf("setting `nat_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyRuleCollectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	collection, err := expandFirewallPolicyRuleCollectionResource(d)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil || group.RuleCollections == nil {
+		return fmt.Errorf("retrieving %s: `properties.ruleCollections` was nil", groupId)
+	}
+
+	ruleCollections := *group.RuleCollections
+	index := findFirewallPolicyRuleCollection(ruleCollections, id.RuleCollectionName)
+	if index == -1 {
+		return fmt.Errorf("%s was not found", *id)
+	}
+	ruleCollections[index] = collection
+
+	if err := createOrUpdateFirewallPolicyRuleCollectionGroup(ctx, client, groupId, int(utils.NormaliseNilableInt32(group.Priority)), ruleCollections); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceFirewallPolicyRuleCollectionRead(d, meta)
+}
+
+func resourceFirewallPolicyRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.FirewallPolicyRuleCollectionGroupProperties == nil || group.RuleCollections == nil {
+		return nil
+	}
+
+	ruleCollections := *group.RuleCollections
+	index := findFirewallPolicyRuleCollection(ruleCollections, id.RuleCollectionName)
+	if index == -1 {
+		return nil
+	}
+	ruleCollections = append(ruleCollections[:index], ruleCollections[index+1:]...)
+
+	if err := createOrUpdateFirewallPolicyRuleCollectionGroup(ctx, client, groupId, int(utils.NormaliseNilableInt32(group.Priority)), ruleCollections); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFirewallPolicyRuleCollectionResource(d *pluginsdk.ResourceData) (network.BasicFirewallPolicyRuleCollection, error) {
+	action := d.Get("action").(string)
+	input := map[string]interface{}{
+		"name":     d.Get("name").(string),
+		"priority": d.Get("priority").(int),
+		"action":   action,
+	}
+
+	if v := d.Get("nat_rule").(*pluginsdk.Set); v.Len() > 0 {
+		if action != "Dnat" {
+			return nil, fmt.Errorf("`action` must be `Dnat` when `nat_rule` is specified")
+		}
+
+		input["rule"] = v
+		collections, err := expandFirewallPolicyRuleCollectionNat([]interface{}{input})
+		if err != nil {
+			return nil, fmt.Errorf("expanding `nat_rule`: %+v", err)
+		}
+		return collections[0], nil
+	}
+
+	if action == "Dnat" {
+		return nil, fmt.Errorf("`action` must be `Allow` or `Deny` when `application_rule` or `network_rule` is specified")
+	}
+
+	if v := d.Get("application_rule").(*pluginsdk.Set); v.Len() > 0 {
+		input["rule"] = v
+		return expandFirewallPolicyRuleCollectionApplication([]interface{}{input})[0], nil
+	}
+
+	input["rule"] = d.Get("network_rule").(*pluginsdk.Set)
+	return expandFirewallPolicyRuleCollectionNetwork([]interface{}{input})[0], nil
+}
+
+func findFirewallPolicyRuleCollection(input []network.BasicFirewallPolicyRuleCollection, name string) int {
+	for i, item := range input {
+		if itemName, _ := firewallPolicyRuleCollectionNameAndPriority(item); itemName == name {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,261 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/firewall/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type FirewallPolicyRuleCollectionResource struct{}
+
+func TestAccFirewallPolicyRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection", "test")
+	r := FirewallPolicyRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection", "test")
+	r := FirewallPolicyRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection", "test")
+	r := FirewallPolicyRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("priority").HasValue("600"),
+				check.That(data.ResourceName).Key("network_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollection_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection", "test")
+	r := FirewallPolicyRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection.application").ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection.nat").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupClient.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.FirewallPolicyRuleCollectionGroupProperties == nil || resp.RuleCollections == nil {
+		return utils.Bool(false), nil
+	}
+	for _, item := range *resp.RuleCollections {
+		var name *string
+		switch collection := item.(type) {
+		case network.FirewallPolicyFilterRuleCollection:
+			name = collection.Name
+		case network.FirewallPolicyNatRuleCollection:
+			name = collection.Name
+		}
+		if name != nil && *name == id.RuleCollectionName {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (FirewallPolicyRuleCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RC-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  lifecycle {
+    ignore_changes = [application_rule_collection, network_rule_collection, nat_rule_collection]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection" "test" {
+  name                                     = "network_rule_collection1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                                 = 500
+  action                                   = "Deny"
+
+  network_rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1", "192.168.1.2"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection" "import" {
+  name                                     = azurerm_firewall_policy_rule_collection.test.name
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection.test.firewall_policy_rule_collection_group_id
+  priority                                 = azurerm_firewall_policy_rule_collection.test.priority
+  action                                   = azurerm_firewall_policy_rule_collection.test.action
+
+  network_rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1", "192.168.1.2"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyRuleCollectionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection" "test" {
+  name                                     = "network_rule_collection1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                                 = 600
+  action                                   = "Allow"
+
+  network_rule {
+    name                  = "network_rule_collection1_rule0"
+    protocols             = ["TCP"]
+    source_addresses      = ["10.0.0.3"]
+    destination_addresses = ["192.168.1.3"]
+    destination_ports     = ["443"]
+  }
+
+  network_rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1", "192.168.1.2"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleCollectionResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection" "application" {
+  name                                     = "app_rule_collection1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                                 = 400
+  action                                   = "Deny"
+
+  application_rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+
+resource "azurerm_firewall_policy_rule_collection" "nat" {
+  name                                     = "nat_rule_collection1"
+  firewall_policy_rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                                 = 300
+  action                                   = "Dnat"
+
+  nat_rule {
+    name                = "nat_rule_collection1_rule1"
+    protocols           = ["TCP", "UDP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_address  = "192.168.0.1"
+    translated_port     = "8080"
+  }
+}
+`, r.basic(data))
+}
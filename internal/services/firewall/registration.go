@@ -31,6 +31,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_firewall_application_rule_collection":  resourceFirewallApplicationRuleCollection(),
 		"azurerm_firewall_policy":                       resourceFirewallPolicy(),
+		"azurerm_firewall_policy_rule_collection":       resourceFirewallPolicyRuleCollection(),
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
 		"azurerm_firewall_nat_rule_collection":          resourceFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
@@ -23,6 +23,9 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
+// NOTE: this resource manages Front Door (classic) - Front Door (Standard/Premium) lives in the `cdn` package
+// as the `azurerm_cdn_frontdoor_*` resources. There's no in-place upgrade between the two, instead the migrated
+// resources are imported (see `website/docs/guides/frontdoor-standard-premium-migration.html.markdown`).
 func resourceFrontDoor() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFrontDoorCreate,
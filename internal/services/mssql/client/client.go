@@ -1,8 +1,11 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sqlvirtualmachine/mgmt/2017-03-01-preview/sqlvirtualmachine"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
 )
 
@@ -15,6 +18,15 @@ type Client struct {
 	DatabasesClient                                    *sql.DatabasesClient
 	ElasticPoolsClient                                 *sql.ElasticPoolsClient
 	FailoverGroupsClient                               *sql.FailoverGroupsClient
+	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
+	ManagedInstancesClient                             *sql.ManagedInstancesClient
+	ManagedInstanceAdministratorsClient                *sql.ManagedInstanceAdministratorsClient
+	ManagedInstanceAzureADOnlyAuthenticationsClient    *sql.ManagedInstanceAzureADOnlyAuthenticationsClient
+	ManagedInstanceEncryptionProtectorClient           *sql.ManagedInstanceEncryptionProtectorsClient
+	ManagedInstanceFailoverGroupsClient                *sql.InstanceFailoverGroupsClient
+	ManagedInstanceKeysClient                          *sql.ManagedInstanceKeysClient
+	ManagedInstanceServerSecurityAlertPoliciesClient   *sql.ManagedServerSecurityAlertPoliciesClient
+	ManagedInstanceVulnerabilityAssessmentsClient      *sql.ManagedInstanceVulnerabilityAssessmentsClient
 	FirewallRulesClient                                *sql.FirewallRulesClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
@@ -32,6 +44,8 @@ type Client struct {
 	GeoBackupPoliciesClient                            *sql.GeoBackupPoliciesClient
 	EncryptionProtectorClient                          *sql.EncryptionProtectorsClient
 	ServerKeysClient                                   *sql.ServerKeysClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -71,6 +85,33 @@ func NewClient(o *common.ClientOptions) *Client {
 	replicationLinksClient := sql.NewReplicationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&replicationLinksClient.Client, o.ResourceManagerAuthorizer)
 
+	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstancesClient := sql.NewManagedInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstancesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceAdministratorsClient := sql.NewManagedInstanceAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceAzureADOnlyAuthenticationsClient := sql.NewManagedInstanceAzureADOnlyAuthenticationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceAzureADOnlyAuthenticationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceEncryptionProtectorClient := sql.NewManagedInstanceEncryptionProtectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceEncryptionProtectorClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceFailoverGroupsClient := sql.NewInstanceFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceFailoverGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceKeysClient := sql.NewManagedInstanceKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceKeysClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceServerSecurityAlertPoliciesClient := sql.NewManagedServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceServerSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceVulnerabilityAssessmentsClient := sql.NewManagedInstanceVulnerabilityAssessmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceVulnerabilityAssessmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	restorableDroppedDatabasesClient := sql.NewRestorableDroppedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&restorableDroppedDatabasesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -123,6 +164,15 @@ func NewClient(o *common.ClientOptions) *Client {
 		JobCredentialsClient:                               &jobCredentialsClient,
 		FailoverGroupsClient:                               &failoverGroupsClient,
 		FirewallRulesClient:                                &firewallRulesClient,
+		ManagedDatabasesClient:                             &managedDatabasesClient,
+		ManagedInstancesClient:                             &managedInstancesClient,
+		ManagedInstanceAdministratorsClient:                &managedInstanceAdministratorsClient,
+		ManagedInstanceAzureADOnlyAuthenticationsClient:    &managedInstanceAzureADOnlyAuthenticationsClient,
+		ManagedInstanceEncryptionProtectorClient:           &managedInstanceEncryptionProtectorClient,
+		ManagedInstanceFailoverGroupsClient:                &managedInstanceFailoverGroupsClient,
+		ManagedInstanceKeysClient:                          &managedInstanceKeysClient,
+		ManagedInstanceServerSecurityAlertPoliciesClient:   &managedInstanceServerSecurityAlertPoliciesClient,
+		ManagedInstanceVulnerabilityAssessmentsClient:      &managedInstanceVulnerabilityAssessmentsClient,
 		ReplicationLinksClient:                             &replicationLinksClient,
 		RestorableDroppedDatabasesClient:                   &restorableDroppedDatabasesClient,
 		ServerAzureADAdministratorsClient:                  &serverAzureADAdministratorsClient,
@@ -136,5 +186,26 @@ func NewClient(o *common.ClientOptions) *Client {
 		VirtualNetworkRulesClient:                          &sqlVirtualNetworkRulesClient,
 		GeoBackupPoliciesClient:                            &geoBackupPoliciesClient,
 		ServerKeysClient:                                   &sqlServerKeysClient,
+
+		options: o,
 	}
 }
+
+// DatabasesClientForSubscription returns a Databases Client scoped to the specified subscription, for use when
+// working with databases outside of the subscription the provider is configured for
+func (client Client) DatabasesClientForSubscription(subscriptionId string) *sql.DatabasesClient {
+	databasesClient := sql.NewDatabasesClientWithBaseURI(client.options.ResourceManagerEndpoint, subscriptionId)
+	client.options.ConfigureClient(&databasesClient.Client, client.options.ResourceManagerAuthorizer)
+	return &databasesClient
+}
+
+// DatabaseAuthorizer returns an Authorizer with a token scoped to the Azure SQL Database data plane, for use when
+// connecting directly to a database using Azure Active Directory authentication
+func (client Client) DatabaseAuthorizer() (autorest.Authorizer, error) {
+	if client.options.TokenFunc == nil {
+		return nil, fmt.Errorf("no token function was configured for the provider")
+	}
+
+	endpoint := fmt.Sprintf("https://%s/", client.options.Environment.SQLDatabaseDNSSuffix)
+	return client.options.TokenFunc(endpoint)
+}
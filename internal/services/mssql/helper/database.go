@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
@@ -109,3 +110,62 @@ func FindDatabaseReplicationPartners(ctx context.Context, databasesClient *sql.D
 
 	return partnerDatabases, nil
 }
+
+// FindDatabaseReplicationLink returns the first replication link for the specified database where the local
+// replication role matches one of the specified roles, or nil if there is no such link.
+func FindDatabaseReplicationLink(ctx context.Context, replicationLinksClient *sql.ReplicationLinksClient, id parse.DatabaseId, rolesToFind []sql.ReplicationRole) (*sql.ReplicationLink, error) {
+	for linksIterator, err := replicationLinksClient.ListByDatabaseComplete(ctx, id.ResourceGroup, id.ServerName, id.Name); linksIterator.NotDone(); err = linksIterator.NextWithContext(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("reading Replication Links for %s: %+v", id, err)
+		}
+
+		link := linksIterator.Value()
+		if link.Name == nil || link.ReplicationLinkProperties == nil {
+			log.Printf("[INFO] Replication Link was invalid for %s", id)
+			continue
+		}
+
+		for _, role := range rolesToFind {
+			if link.ReplicationLinkProperties.Role == role {
+				return &link, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// FindDatabaseReplicationLinkWithPartner returns the replication link for the specified database whose partner is
+// the specified partner database and where the local replication role matches one of the specified roles, or nil
+// if there is no such link.
+func FindDatabaseReplicationLinkWithPartner(ctx context.Context, replicationLinksClient *sql.ReplicationLinksClient, id parse.DatabaseId, partnerServerName, partnerDatabaseName string, rolesToFind []sql.ReplicationRole) (*sql.ReplicationLink, error) {
+	for linksIterator, err := replicationLinksClient.ListByDatabaseComplete(ctx, id.ResourceGroup, id.ServerName, id.Name); linksIterator.NotDone(); err = linksIterator.NextWithContext(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("reading Replication Links for %s: %+v", id, err)
+		}
+
+		link := linksIterator.Value()
+		if link.Name == nil || link.ReplicationLinkProperties == nil || link.PartnerServer == nil || link.PartnerDatabase == nil {
+			log.Printf("[INFO] Replication Link was invalid for %s", id)
+			continue
+		}
+
+		if !strings.EqualFold(*link.PartnerServer, partnerServerName) || !strings.EqualFold(*link.PartnerDatabase, partnerDatabaseName) {
+			continue
+		}
+
+		for _, role := range rolesToFind {
+			if link.ReplicationLinkProperties.Role == role {
+				return &link, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// IsSecondaryCreateMode returns whether the specified create mode results in a database which is a secondary
+// replica of another database.
+func IsSecondaryCreateMode(createMode string) bool {
+	return createMode == string(sql.CreateModeSecondary) || createMode == string(sql.CreateModeOnlineSecondary)
+}
@@ -0,0 +1,186 @@
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/denisenkom/go-mssqldb/batch"
+)
+
+// DatabaseScriptMigration is a single versioned T-SQL script which is applied to a database at most once
+type DatabaseScriptMigration struct {
+	Version string
+	Script  string
+}
+
+// DatabaseScriptChecksum returns the checksum recorded in the tracking table for a migration script
+func DatabaseScriptChecksum(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+// DatabaseScriptPendingMigrations returns the migrations which have yet to be applied, in the order they were
+// specified, given a map of already applied versions to their checksums. An error is returned when a version is
+// specified more than once, or when the script for an applied version has since been changed
+func DatabaseScriptPendingMigrations(migrations []DatabaseScriptMigration, applied map[string]string) ([]DatabaseScriptMigration, error) {
+	pending := make([]DatabaseScriptMigration, 0)
+	seen := make(map[string]struct{})
+
+	for _, migration := range migrations {
+		if _, ok := seen[migration.Version]; ok {
+			return nil, fmt.Errorf("migration version %q was specified more than once", migration.Version)
+		}
+		seen[migration.Version] = struct{}{}
+
+		checksum := DatabaseScriptChecksum(migration.Script)
+		existing, ok := applied[migration.Version]
+		if !ok {
+			pending = append(pending, migration)
+			continue
+		}
+
+		if !strings.EqualFold(existing, checksum) {
+			return nil, fmt.Errorf("migration version %q has already been applied with checksum %q but the script now has checksum %q - applied migrations cannot be changed, add a new migration instead", migration.Version, existing, checksum)
+		}
+	}
+
+	return pending, nil
+}
+
+// EnsureDatabaseScriptTrackingTable creates the table used to record applied migrations when it doesn't exist
+func EnsureDatabaseScriptTrackingTable(ctx context.Context, db *sql.DB, tableName string) error {
+	table := quoteDatabaseScriptIdentifier(tableName)
+	query := fmt.Sprintf(`
+IF OBJECT_ID(N'[dbo].%[1]s', N'U') IS NULL
+BEGIN
+	CREATE TABLE [dbo].%[1]s (
+		[script_name] NVARCHAR(128) NOT NULL,
+		[version] NVARCHAR(128) NOT NULL,
+		[checksum] CHAR(64) NOT NULL,
+		[applied_on] DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME(),
+		PRIMARY KEY ([script_name], [version])
+	)
+END`, table)
+
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("creating tracking table %s: %+v", table, err)
+	}
+
+	return nil
+}
+
+// ReadDatabaseScriptMigrations returns a map of the versions applied for the named script to their checksums,
+// and whether the tracking table exists
+func ReadDatabaseScriptMigrations(ctx context.Context, db *sql.DB, tableName, scriptName string) (map[string]string, bool, error) {
+	table := quoteDatabaseScriptIdentifier(tableName)
+
+	var objectId sql.NullInt64
+	if err := db.QueryRowContext(ctx, fmt.Sprintf("SELECT OBJECT_ID(N'[dbo].%s', N'U')", table)).Scan(&objectId); err != nil {
+		return nil, false, fmt.Errorf("looking up tracking table %s: %+v", table, err)
+	}
+	if !objectId.Valid {
+		return nil, false, nil
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT [version], [checksum] FROM [dbo].%s WHERE [script_name] = @name", table), sql.Named("name", scriptName))
+	if err != nil {
+		return nil, true, fmt.Errorf("reading tracking table %s: %+v", table, err)
+	}
+	defer rows.Close()
+
+	applied := make(map[string]string)
+	for rows.Next() {
+		var version, checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, true, fmt.Errorf("reading tracking table %s: %+v", table, err)
+		}
+		applied[version] = strings.TrimSpace(checksum)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, true, fmt.Errorf("reading tracking table %s: %+v", table, err)
+	}
+
+	return applied, true, nil
+}
+
+// ApplyDatabaseScriptMigrations runs each pending migration for the named script in order. Every migration is run in
+// its own transaction together with the insert into the tracking table, so a failing migration leaves no trace and
+// is retried on the next apply. Scripts may contain multiple batches separated by `GO`, as accepted by sqlcmd
+func ApplyDatabaseScriptMigrations(ctx context.Context, db *sql.DB, tableName, scriptName string, migrations []DatabaseScriptMigration) error {
+	if err := EnsureDatabaseScriptTrackingTable(ctx, db, tableName); err != nil {
+		return err
+	}
+
+	applied, _, err := ReadDatabaseScriptMigrations(ctx, db, tableName, scriptName)
+	if err != nil {
+		return err
+	}
+
+	pending, err := DatabaseScriptPendingMigrations(migrations, applied)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range pending {
+		if err := applyDatabaseScriptMigration(ctx, db, tableName, scriptName, migration); err != nil {
+			return fmt.Errorf("applying migration version %q: %+v", migration.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteDatabaseScriptMigrations removes the tracking records for the named script. The effects of the migrations
+// themselves are left in place
+func DeleteDatabaseScriptMigrations(ctx context.Context, db *sql.DB, tableName, scriptName string) error {
+	table := quoteDatabaseScriptIdentifier(tableName)
+	query := fmt.Sprintf(`
+IF OBJECT_ID(N'[dbo].%[1]s', N'U') IS NOT NULL
+BEGIN
+	DELETE FROM [dbo].%[1]s WHERE [script_name] = @name
+END`, table)
+
+	if _, err := db.ExecContext(ctx, query, sql.Named("name", scriptName)); err != nil {
+		return fmt.Errorf("removing records from tracking table %s: %+v", table, err)
+	}
+
+	return nil
+}
+
+func applyDatabaseScriptMigration(ctx context.Context, db *sql.DB, tableName, scriptName string, migration DatabaseScriptMigration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %+v", err)
+	}
+
+	for _, statement := range batch.Split(migration.Script, "GO") {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	query := fmt.Sprintf("INSERT INTO [dbo].%s ([script_name], [version], [checksum]) VALUES (@name, @version, @checksum)", quoteDatabaseScriptIdentifier(tableName))
+	if _, err := tx.ExecContext(ctx, query, sql.Named("name", scriptName), sql.Named("version", migration.Version), sql.Named("checksum", DatabaseScriptChecksum(migration.Script))); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("recording migration: %+v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %+v", err)
+	}
+
+	return nil
+}
+
+func quoteDatabaseScriptIdentifier(name string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(name, "]", "]]"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
+const mssqlDatabaseReplicationLinkResourceName = "azurerm_mssql_database_replication_link"
+
 func resourceMsSqlDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlDatabaseCreateUpdate,
@@ -75,7 +78,6 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 			"create_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default:  string(sql.CreateModeDefault),
 				ValidateFunc: validation.StringInSlice([]string{
 					string(sql.CreateModeCopy),
@@ -185,12 +187,25 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			// changing this forces a new resource, unless it's changed alongside the `create_mode` to swap the roles of the
+			// primary and secondary databases - see the CustomizeDiff
 			"creation_source_database_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Computed:     true,
-				ValidateFunc: validate.DatabaseID,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.DatabaseID,
+				DiffSuppressFunc: msSqlDatabaseSuppressReplicationDiffForPrimary,
+			},
+
+			"secondary_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.SecondaryTypeGeo),
+					string(sql.SecondaryTypeNamed),
+				}, false),
+				DiffSuppressFunc: msSqlDatabaseSuppressReplicationDiffForPrimary,
 			},
 
 			"storage_account_type": {
@@ -313,6 +328,47 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				// "hyperscale can not change to other sku
 				return strings.HasPrefix(old.(string), "HS") && !strings.HasPrefix(new.(string), "HS")
 			}),
+
+			// a geo-secondary can be promoted to the primary and a primary can be demoted to a geo-secondary in-place,
+			// any other change requires a new database
+			pluginsdk.ForceNewIfChange("create_mode", func(ctx context.Context, old, new, _ interface{}) bool {
+				return !msSqlDatabaseIsReplicationRoleChange(old.(string), new.(string))
+			}),
+
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				createMode := diff.Get("create_mode").(string)
+				secondaryType := diff.Get("secondary_type").(string)
+
+				if diff.Id() != "" {
+					oldCreateMode, _ := diff.GetChange("create_mode")
+					if msSqlDatabaseIsReplicationRoleChange(oldCreateMode.(string), createMode) {
+						if helper.IsSecondaryCreateMode(oldCreateMode.(string)) && secondaryType == string(sql.SecondaryTypeNamed) {
+							return fmt.Errorf("a named replica cannot be promoted to a primary database")
+						}
+						if helper.IsSecondaryCreateMode(createMode) && diff.HasChange("secondary_type") && secondaryType == string(sql.SecondaryTypeNamed) {
+							return fmt.Errorf("a primary database can only be demoted to a geo-secondary, not a named replica")
+						}
+						return nil
+					}
+
+					// outside of swapping the roles of the primary and secondary databases, these can't be changed in-place
+					for _, key := range []string{"creation_source_database_id", "secondary_type"} {
+						if diff.HasChange(key) {
+							if err := diff.ForceNew(key); err != nil {
+								return err
+							}
+						}
+					}
+
+					return nil
+				}
+
+				if diff.NewValueKnown("secondary_type") && secondaryType != "" && !helper.IsSecondaryCreateMode(createMode) {
+					return fmt.Errorf("`secondary_type` can only be specified when `create_mode` is %q or %q", string(sql.CreateModeSecondary), string(sql.CreateModeOnlineSecondary))
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -466,6 +522,15 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// changing `create_mode` between a secondary mode and `Default` swaps the roles of the primary and secondary databases
+	if !d.IsNewResource() && d.HasChange("create_mode") {
+		if old, new := d.GetChange("create_mode"); msSqlDatabaseIsReplicationRoleChange(old.(string), new.(string)) {
+			if err := msSqlDatabaseChangeReplicationRole(ctx, replicationLinksClient, id, new.(string) == string(sql.CreateModeDefault), d.Get("creation_source_database_id").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	params := sql.Database{
 		Name:     &name,
 		Location: &location,
@@ -485,7 +550,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	createMode, ok := d.GetOk("create_mode")
-	if _, dbok := d.GetOk("creation_source_database_id"); ok && (createMode.(string) == string(sql.CreateModeCopy) || createMode.(string) == string(sql.CreateModePointInTimeRestore) || createMode.(string) == string(sql.CreateModeSecondary)) && !dbok {
+	if _, dbok := d.GetOk("creation_source_database_id"); ok && (createMode.(string) == string(sql.CreateModeCopy) || createMode.(string) == string(sql.CreateModePointInTimeRestore) || helper.IsSecondaryCreateMode(createMode.(string))) && !dbok {
 		return fmt.Errorf("'creation_source_database_id' is required for create_mode %s", createMode.(string))
 	}
 	if _, dbok := d.GetOk("recover_database_id"); ok && createMode.(string) == string(sql.CreateModeRecovery) && !dbok {
@@ -497,19 +562,30 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 
 	params.DatabaseProperties.CreateMode = sql.CreateMode(createMode.(string))
 
+	// the database may be a secondary regardless of the `create_mode`, e.g. when it's been demoted by a failover
+	// initiated from the partner database, in which case the properties which only apply to a primary can't be set
+	isSecondary := helper.IsSecondaryCreateMode(createMode.(string))
+	if !d.IsNewResource() {
+		link, err := helper.FindDatabaseReplicationLink(ctx, replicationLinksClient, id, []sql.ReplicationRole{sql.ReplicationRoleSecondary, sql.ReplicationRoleNonReadableSecondary})
+		if err != nil {
+			return err
+		}
+		isSecondary = link != nil
+	}
+
 	auditingPolicies := d.Get("extended_auditing_policy").([]interface{})
-	if (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary)) && len(auditingPolicies) > 0 {
-		return fmt.Errorf("cannot configure `extended_auditing_policy` in secondary create mode for %s", id)
+	if isSecondary && len(auditingPolicies) > 0 {
+		return fmt.Errorf("cannot configure `extended_auditing_policy` for %s since it is a secondary database", id)
 	}
 
 	if v, ok := d.GetOk("max_size_gb"); ok {
 		// `max_size_gb` is Computed, so has a value after the first run
-		if createMode != string(sql.CreateModeOnlineSecondary) && createMode != string(sql.CreateModeSecondary) {
+		if !isSecondary {
 			params.DatabaseProperties.MaxSizeBytes = utils.Int64(int64(v.(int) * 1073741824))
 		}
 		// `max_size_gb` only has change if it is configured
-		if d.HasChange("max_size_gb") && (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary)) {
-			return fmt.Errorf("it is not possible to change maximum size nor advised to configure maximum size in secondary create mode for %s", id)
+		if d.HasChange("max_size_gb") && isSecondary {
+			return fmt.Errorf("it is not possible to change maximum size nor advised to configure maximum size for %s since it is a secondary database", id)
 		}
 	}
 
@@ -537,8 +613,33 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	if v, ok := d.GetOk("creation_source_database_id"); ok {
-		params.DatabaseProperties.SourceDatabaseID = utils.String(v.(string))
+	// once a secondary has been promoted to primary the source database is no longer relevant
+	if v, ok := d.GetOk("creation_source_database_id"); ok && createMode.(string) != string(sql.CreateModeDefault) {
+		sourceDatabaseId, err := parse.DatabaseID(v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing `creation_source_database_id`: %+v", err)
+		}
+
+		// the source database may live in a different subscription (e.g. when copying a database between
+		// subscriptions) so we check it exists using a client scoped to that subscription
+		if d.IsNewResource() {
+			sourceDatabasesClient := client
+			if sourceDatabaseId.SubscriptionId != serverId.SubscriptionId {
+				sourceDatabasesClient = meta.(*clients.Client).MSSQL.DatabasesClientForSubscription(sourceDatabaseId.SubscriptionId)
+			}
+			if resp, err := sourceDatabasesClient.Get(ctx, sourceDatabaseId.ResourceGroup, sourceDatabaseId.ServerName, sourceDatabaseId.Name); err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("the source %s for %s was not found", sourceDatabaseId, id)
+				}
+				return fmt.Errorf("retrieving source %s for %s: %+v", sourceDatabaseId, id, err)
+			}
+		}
+
+		params.DatabaseProperties.SourceDatabaseID = utils.String(sourceDatabaseId.ID())
+	}
+
+	if v, ok := d.GetOk("secondary_type"); ok && helper.IsSecondaryCreateMode(createMode.(string)) {
+		params.DatabaseProperties.SecondaryType = sql.SecondaryType(v.(string))
 	}
 
 	if v, ok := d.GetOk("recover_database_id"); ok {
@@ -589,7 +690,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("setting database threat detection policy for %s: %+v", id, err)
 	}
 
-	if createMode != string(sql.CreateModeOnlineSecondary) && createMode != string(sql.CreateModeSecondary) {
+	if !isSecondary {
 		auditingProps := sql.ExtendedDatabaseBlobAuditingPolicy{
 			ExtendedDatabaseBlobAuditingPolicyProperties: helper.ExpandMsSqlDBBlobAuditingPolicies(auditingPolicies),
 		}
@@ -644,6 +745,101 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	return resourceMsSqlDatabaseRead(d, meta)
 }
 
+// msSqlDatabaseIsReplicationRoleChange returns whether changing the `create_mode` from the old to the new value swaps
+// the roles of the primary and secondary databases, which is done in-place by failing over their replication link
+func msSqlDatabaseIsReplicationRoleChange(oldCreateMode, newCreateMode string) bool {
+	if helper.IsSecondaryCreateMode(oldCreateMode) {
+		return newCreateMode == string(sql.CreateModeDefault)
+	}
+	return oldCreateMode == string(sql.CreateModeDefault) && helper.IsSecondaryCreateMode(newCreateMode)
+}
+
+// msSqlDatabaseSuppressReplicationDiffForPrimary suppresses diffs for the replication properties of an existing
+// primary database, since these no longer apply once a secondary database has been promoted
+func msSqlDatabaseSuppressReplicationDiffForPrimary(_, _, _ string, d *pluginsdk.ResourceData) bool {
+	return d.Id() != "" && d.Get("create_mode").(string) == string(sql.CreateModeDefault)
+}
+
+// msSqlDatabaseChangeReplicationRole promotes the database to be the primary, or demotes it to be the secondary of the
+// partner database, by failing over the replication link between them. Since the failover swaps the roles of both
+// databases this can be requested from either side, after which the other side finds it already has the new role.
+func msSqlDatabaseChangeReplicationRole(ctx context.Context, client *sql.ReplicationLinksClient, id parse.DatabaseId, promote bool, partnerDatabaseId string) error {
+	secondaryRoles := []sql.ReplicationRole{sql.ReplicationRoleSecondary, sql.ReplicationRoleNonReadableSecondary}
+
+	// a failover has to be requested on the secondary database, which is this database when it's being promoted
+	// and the partner database (the database being promoted in its place) when it's being demoted
+	findSecondaryLink := func() (*parse.DatabaseId, *sql.ReplicationLink, error) {
+		if promote {
+			link, err := helper.FindDatabaseReplicationLink(ctx, client, id, secondaryRoles)
+			return &id, link, err
+		}
+
+		partnerId, err := parse.DatabaseID(partnerDatabaseId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing `creation_source_database_id`: %+v", err)
+		}
+		link, err := helper.FindDatabaseReplicationLinkWithPartner(ctx, client, *partnerId, id.ServerName, id.Name, secondaryRoles)
+		return partnerId, link, err
+	}
+
+	secondaryId, link, err := findSecondaryLink()
+	if err != nil {
+		return fmt.Errorf("retrieving Replication Links for %s: %+v", id, err)
+	}
+
+	if link == nil {
+		desiredRoles := secondaryRoles
+		if promote {
+			desiredRoles = []sql.ReplicationRole{sql.ReplicationRolePrimary}
+		}
+		existing, err := helper.FindDatabaseReplicationLink(ctx, client, id, desiredRoles)
+		if err != nil {
+			return fmt.Errorf("retrieving Replication Links for %s: %+v", id, err)
+		}
+		if existing == nil {
+			return fmt.Errorf("changing the replication role of %s: no Replication Link was found to fail over", id)
+		}
+
+		log.Printf("[DEBUG] %s already has the requested replication role", id)
+		return nil
+	}
+
+	if link.PartnerServer == nil || link.PartnerDatabase == nil {
+		return fmt.Errorf("retrieving Replication Link %q of %s: `partnerServer` and `partnerDatabase` were nil", *link.Name, *secondaryId)
+	}
+
+	// both databases can request the failover, so it's serialised on the pair of databases in the replication link,
+	// which is then checked again in case the failover was already performed from the partner database
+	lockNames := []string{
+		strings.ToLower(fmt.Sprintf("%s/%s", secondaryId.ServerName, secondaryId.Name)),
+		strings.ToLower(fmt.Sprintf("%s/%s", *link.PartnerServer, *link.PartnerDatabase)),
+	}
+	sort.Strings(lockNames)
+	lockName := strings.Join(lockNames, "|")
+	locks.ByName(lockName, mssqlDatabaseReplicationLinkResourceName)
+	defer locks.UnlockByName(lockName, mssqlDatabaseReplicationLinkResourceName)
+
+	if secondaryId, link, err = findSecondaryLink(); err != nil {
+		return fmt.Errorf("retrieving Replication Links for %s: %+v", id, err)
+	}
+	if link == nil {
+		log.Printf("[DEBUG] %s already has the requested replication role", id)
+		return nil
+	}
+
+	log.Printf("[INFO] Failing over Replication Link %q of %s", *link.Name, *secondaryId)
+	future, err := client.Failover(ctx, secondaryId.ResourceGroup, secondaryId.ServerName, secondaryId.Name, *link.Name)
+	if err != nil {
+		return fmt.Errorf("failing over Replication Link %q of %s: %+v", *link.Name, *secondaryId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for failover of Replication Link %q of %s: %+v", *link.Name, *secondaryId, err)
+	}
+
+	return nil
+}
+
 func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MSSQL.DatabasesClient
 	securityAlertPoliciesClient := meta.(*clients.Client).MSSQL.DatabaseSecurityAlertPoliciesClient
@@ -694,6 +890,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			skuName = *props.CurrentServiceObjectiveName
 		}
 		d.Set("sku_name", skuName)
+		d.Set("secondary_type", string(props.SecondaryType))
 		d.Set("storage_account_type", flattenMsSqlBackupStorageRedundancy(props.CurrentBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
@@ -706,7 +903,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	extendedAuditingPolicy := []interface{}{}
-	if createMode, ok := d.GetOk("create_mode"); !ok || createMode.(string) != "Secondary" {
+	if createMode, ok := d.GetOk("create_mode"); !ok || !helper.IsSecondaryCreateMode(createMode.(string)) {
 		auditingResp, err := auditingClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 		if err != nil {
 			return fmt.Errorf("retrieving Blob Auditing Policies for %s: %+v", id, err)
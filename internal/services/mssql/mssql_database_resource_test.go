@@ -280,6 +280,94 @@ func TestAccMsSqlDatabase_createSecondaryMode(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_promoteSecondary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "secondary")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createSecondaryMode(data, "test1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Geo"),
+			),
+		},
+		data.ImportStep("sample_name"),
+		{
+			Config: r.promoteSecondary(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("create_mode").HasValue("Default"),
+			),
+		},
+		data.ImportStep("create_mode", "creation_source_database_id", "sample_name"),
+	})
+}
+
+func TestAccMsSqlDatabase_failover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "secondary")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createSecondaryMode(data, "test1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Geo"),
+			),
+		},
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue(""),
+				check.That("azurerm_mssql_database.test").Key("secondary_type").HasValue("Geo"),
+			),
+		},
+		{
+			Config: r.createSecondaryMode(data, "test1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Geo"),
+				check.That("azurerm_mssql_database.test").Key("secondary_type").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccMsSqlDatabase_createNamedReplica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "named")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createNamedReplica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Named"),
+				check.That(data.ResourceName).Key("sku_name").HasValue("HS_Gen5_2"),
+			),
+		},
+		data.ImportStep("create_mode", "creation_source_database_id"),
+	})
+}
+
+func TestAccMsSqlDatabase_createCopyModeCrossSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "copy")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createCopyModeCrossSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("GP_Gen5_2"),
+			),
+		},
+		data.ImportStep("create_mode", "creation_source_database_id"),
+	})
+}
+
 func TestAccMsSqlDatabase_scaleReplicaSet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "primary")
 	r := MsSqlDatabaseResource{}
@@ -986,6 +1074,154 @@ resource "azurerm_mssql_database" "secondary" {
 `, r.complete(data), data.RandomInteger, data.Locations.Secondary, tag)
 }
 
+func (r MsSqlDatabaseResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name                        = "acctest-db-%[2]d"
+  server_id                   = azurerm_mssql_server.test.id
+  collation                   = "SQL_AltDiction_CP850_CI_AI"
+  license_type                = "BasePrice"
+  max_size_gb                 = 1
+  sample_name                 = "AdventureWorksLT"
+  sku_name                    = "GP_Gen5_2"
+  create_mode                 = "Secondary"
+  creation_source_database_id = "${azurerm_mssql_server.second.id}/databases/acctest-dbs-%[2]d"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+
+resource "azurerm_resource_group" "second" {
+  name     = "acctestRG-mssql2-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_mssql_server" "second" {
+  name                         = "acctest-sqlserver2-%[2]d"
+  resource_group_name          = azurerm_resource_group.second.name
+  location                     = azurerm_resource_group.second.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "secondary" {
+  name                        = "acctest-dbs-%[2]d"
+  server_id                   = azurerm_mssql_server.second.id
+  create_mode                 = "Default"
+  creation_source_database_id = azurerm_mssql_database.test.id
+
+  tags = {
+    tag = "test1"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r MsSqlDatabaseResource) promoteSecondary(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_resource_group" "second" {
+  name     = "acctestRG-mssql2-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_mssql_server" "second" {
+  name                         = "acctest-sqlserver2-%[2]d"
+  resource_group_name          = azurerm_resource_group.second.name
+  location                     = azurerm_resource_group.second.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "secondary" {
+  name        = "acctest-dbs-%[2]d"
+  server_id   = azurerm_mssql_server.second.id
+  create_mode = "Default"
+
+  tags = {
+    tag = "test1"
+  }
+}
+`, r.complete(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r MsSqlDatabaseResource) createNamedReplica(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_server" "second" {
+  name                         = "acctest-sqlserver2-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "named" {
+  name                        = "acctest-dbn-%[2]d"
+  server_id                   = azurerm_mssql_server.second.id
+  create_mode                 = "Secondary"
+  secondary_type              = "Named"
+  creation_source_database_id = azurerm_mssql_database.test.id
+  sku_name                    = "HS_Gen5_2"
+}
+`, r.hs(data), data.RandomInteger)
+}
+
+// This test spans 2 subscriptions to check that a database can be copied from a server in a non-local subscription
+func (r MsSqlDatabaseResource) createCopyModeCrossSubscription(data acceptance.TestData) string {
+	clientData := data.Client()
+	return fmt.Sprintf(`
+%[1]s
+
+provider "azurerm-alt" {
+  subscription_id = "%[2]s"
+  tenant_id       = "%[3]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "alt" {
+  provider = azurerm-alt
+
+  name     = "acctestRG-mssql-alt-%[4]d"
+  location = "%[5]s"
+}
+
+resource "azurerm_mssql_server" "alt" {
+  provider = azurerm-alt
+
+  name                         = "acctest-sqlserver-alt-%[4]d"
+  resource_group_name          = azurerm_resource_group.alt.name
+  location                     = azurerm_resource_group.alt.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "alt" {
+  provider = azurerm-alt
+
+  name      = "acctest-db-alt-%[4]d"
+  server_id = azurerm_mssql_server.alt.id
+  sku_name  = "GP_Gen5_2"
+}
+
+resource "azurerm_mssql_database" "copy" {
+  name                        = "acctest-dbc-%[4]d"
+  server_id                   = azurerm_mssql_server.test.id
+  create_mode                 = "Copy"
+  creation_source_database_id = azurerm_mssql_database.alt.id
+}
+`, r.template(data), clientData.SubscriptionIDAlt, clientData.TenantID, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r MsSqlDatabaseResource) scaleReplicaSet(data acceptance.TestData, sku string) string {
 	return fmt.Sprintf(`
 %[1]s
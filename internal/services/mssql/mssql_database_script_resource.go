@@ -0,0 +1,433 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/sdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/helper"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlDatabaseScriptModel struct {
+	Name              string                            `tfschema:"name"`
+	DatabaseId        string                            `tfschema:"database_id"`
+	Migrations        []DatabaseScriptMigrationModel    `tfschema:"migration"`
+	SqlAuthentication []DatabaseScriptSqlAuthentication `tfschema:"sql_authentication"`
+	TrackingTableName string                            `tfschema:"tracking_table_name"`
+	AppliedMigrations map[string]string                 `tfschema:"applied_migrations"`
+}
+
+type DatabaseScriptMigrationModel struct {
+	Version string `tfschema:"version"`
+	Script  string `tfschema:"script"`
+}
+
+type DatabaseScriptSqlAuthentication struct {
+	Username string `tfschema:"username"`
+	Password string `tfschema:"password"`
+}
+
+var _ sdk.Resource = MsSqlDatabaseScriptResource{}
+var _ sdk.ResourceWithUpdate = MsSqlDatabaseScriptResource{}
+var _ sdk.ResourceWithCustomizeDiff = MsSqlDatabaseScriptResource{}
+
+type MsSqlDatabaseScriptResource struct{}
+
+func (r MsSqlDatabaseScriptResource) ResourceType() string {
+	return "azurerm_mssql_database_script"
+}
+
+func (r MsSqlDatabaseScriptResource) ModelObject() interface{} {
+	return &MsSqlDatabaseScriptModel{}
+}
+
+func (r MsSqlDatabaseScriptResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DatabaseScriptID
+}
+
+func (r MsSqlDatabaseScriptResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,128}$`),
+				"`name` may only contain alphanumeric characters, underscores, periods and hyphens, and must be between 1 and 128 characters",
+			),
+		},
+
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DatabaseID,
+		},
+
+		"migration": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"sql_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tracking_table_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "__terraform_migrations",
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,127}$`),
+				"`tracking_table_name` must start with a letter or underscore, may only contain alphanumeric characters and underscores, and must be at most 128 characters",
+			),
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"applied_migrations": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlDatabaseScriptModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("DecodeDiff: %+v", err)
+			}
+
+			// the scripts may not be known until apply, in which case the checks happen then
+			for _, m := range model.Migrations {
+				if m.Version == "" || m.Script == "" {
+					return nil
+				}
+			}
+
+			// `applied_migrations` holds what was found in the tracking table during the last refresh, so any migration
+			// which is missing from there (or which had its record removed out-of-band) needs to be applied
+			old, _ := metadata.ResourceDiff.GetChange("applied_migrations")
+			applied := make(map[string]string)
+			if v, ok := old.(map[string]interface{}); ok {
+				for version, checksum := range v {
+					applied[version] = checksum.(string)
+				}
+			}
+
+			pending, err := helper.DatabaseScriptPendingMigrations(expandDatabaseScriptMigrations(model.Migrations), applied)
+			if err != nil {
+				return err
+			}
+
+			if len(pending) > 0 && metadata.ResourceDiff.Id() != "" {
+				if err := metadata.ResourceDiff.SetNewComputed("applied_migrations"); err != nil {
+					return fmt.Errorf("setting `applied_migrations` to computed: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlDatabaseScriptModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.DatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewDatabaseScriptID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, model.Name)
+
+			db, err := openDatabaseScriptConnection(ctx, metadata, *databaseId, model.SqlAuthentication)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			existing, _, err := helper.ReadDatabaseScriptMigrations(ctx, db, model.TrackingTableName, id.ScriptName)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Applying %d migration(s) for %s", len(model.Migrations), id)
+
+			if err := helper.ApplyDatabaseScriptMigrations(ctx, db, model.TrackingTableName, id.ScriptName, expandDatabaseScriptMigrations(model.Migrations)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			databasesClient := metadata.Client.MSSQL.DatabasesClient
+
+			id, err := parse.DatabaseScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state MsSqlDatabaseScriptModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName)
+
+			database, err := databasesClient.Get(ctx, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(database.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+			}
+
+			// `tracking_table_name` isn't part of the ID, so fall back to the default when importing
+			trackingTableName := state.TrackingTableName
+			if trackingTableName == "" {
+				trackingTableName = "__terraform_migrations"
+			}
+
+			db, err := openDatabaseScriptConnection(ctx, metadata, databaseId, state.SqlAuthentication)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			applied, exists, err := helper.ReadDatabaseScriptMigrations(ctx, db, trackingTableName, id.ScriptName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if !exists || len(applied) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			model := MsSqlDatabaseScriptModel{
+				Name:              id.ScriptName,
+				DatabaseId:        databaseId.ID(),
+				Migrations:        state.Migrations,
+				SqlAuthentication: state.SqlAuthentication,
+				TrackingTableName: trackingTableName,
+				AppliedMigrations: applied,
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.DatabaseScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlDatabaseScriptModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName)
+
+			db, err := openDatabaseScriptConnection(ctx, metadata, databaseId, model.SqlAuthentication)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			metadata.Logger.Infof("Applying pending migrations for %s", id)
+
+			if err := helper.ApplyDatabaseScriptMigrations(ctx, db, model.TrackingTableName, id.ScriptName, expandDatabaseScriptMigrations(model.Migrations)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseScriptResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.DatabaseScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlDatabaseScriptModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName)
+
+			db, err := openDatabaseScriptConnection(ctx, metadata, databaseId, model.SqlAuthentication)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			metadata.Logger.Infof("Removing tracking records for %s", id)
+
+			if err := helper.DeleteDatabaseScriptMigrations(ctx, db, model.TrackingTableName, id.ScriptName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDatabaseScriptMigrations(input []DatabaseScriptMigrationModel) []helper.DatabaseScriptMigration {
+	migrations := make([]helper.DatabaseScriptMigration, 0)
+	for _, v := range input {
+		migrations = append(migrations, helper.DatabaseScriptMigration{
+			Version: v.Version,
+			Script:  v.Script,
+		})
+	}
+	return migrations
+}
+
+// openDatabaseScriptConnection connects to the database over TDS, either with the supplied SQL credentials or with an
+// Azure Active Directory token for the credentials the provider is configured with
+func openDatabaseScriptConnection(ctx context.Context, metadata sdk.ResourceMetaData, databaseId parse.DatabaseId, sqlAuthentication []DatabaseScriptSqlAuthentication) (*sql.DB, error) {
+	serversClient := metadata.Client.MSSQL.ServersClient
+
+	server, err := serversClient.Get(ctx, databaseId.ResourceGroup, databaseId.ServerName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Server %q (Resource Group %q): %+v", databaseId.ServerName, databaseId.ResourceGroup, err)
+	}
+	if server.ServerProperties == nil || server.ServerProperties.FullyQualifiedDomainName == nil {
+		return nil, fmt.Errorf("retrieving Server %q (Resource Group %q): `fullyQualifiedDomainName` was nil", databaseId.ServerName, databaseId.ResourceGroup)
+	}
+
+	query := url.Values{}
+	query.Set("database", databaseId.Name)
+	query.Set("encrypt", "true")
+	dsn := url.URL{
+		Scheme:   "sqlserver",
+		Host:     fmt.Sprintf("%s:1433", *server.ServerProperties.FullyQualifiedDomainName),
+		RawQuery: query.Encode(),
+	}
+
+	var connector driver.Connector
+	if len(sqlAuthentication) > 0 {
+		dsn.User = url.UserPassword(sqlAuthentication[0].Username, sqlAuthentication[0].Password)
+		connector, err = mssql.NewConnector(dsn.String())
+	} else {
+		authorizer, authErr := metadata.Client.MSSQL.DatabaseAuthorizer()
+		if authErr != nil {
+			return nil, fmt.Errorf("obtaining authorizer for %s: %+v", databaseId, authErr)
+		}
+
+		connector, err = mssql.NewAccessTokenConnector(dsn.String(), func() (string, error) {
+			return databaseScriptAccessToken(ctx, authorizer)
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("building connection to %s: %+v", databaseId, err)
+	}
+
+	db := sql.OpenDB(connector)
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connecting to %s: %+v", databaseId, err)
+	}
+
+	return db, nil
+}
+
+// databaseScriptAccessToken extracts the bearer token from an Authorizer, since the TDS protocol requires the raw
+// access token rather than an Authorization header
+func databaseScriptAccessToken(ctx context.Context, authorizer autorest.Authorizer) (string, error) {
+	req, err := autorest.Prepare((&http.Request{Header: http.Header{}}).WithContext(ctx), authorizer.WithAuthorization())
+	if err != nil {
+		return "", fmt.Errorf("obtaining access token: %+v", err)
+	}
+
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return "", fmt.Errorf("obtaining access token: no bearer token was returned")
+	}
+
+	return token, nil
+}
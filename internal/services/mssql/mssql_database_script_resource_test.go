@@ -0,0 +1,283 @@
+package mssql_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"testing"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/helper"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlDatabaseScriptResource struct{}
+
+func TestAccMsSqlDatabaseScript_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_script", "test")
+	r := MsSqlDatabaseScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("applied_migrations.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("migration", "sql_authentication"),
+	})
+}
+
+func TestAccMsSqlDatabaseScript_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_script", "test")
+	r := MsSqlDatabaseScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlDatabaseScript_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_script", "test")
+	r := MsSqlDatabaseScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("applied_migrations.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("migration", "sql_authentication"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("applied_migrations.%").HasValue("2"),
+			),
+		},
+		data.ImportStep("migration", "sql_authentication"),
+	})
+}
+
+func TestAccMsSqlDatabaseScript_azureActiveDirectory(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_script", "test")
+	r := MsSqlDatabaseScriptResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureActiveDirectory(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("applied_migrations.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("migration"),
+	})
+}
+
+func (MsSqlDatabaseScriptResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseScriptID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Database %q (Server %q / Resource Group %q): %+v", id.DatabaseName, id.ServerName, id.ResourceGroup, err)
+	}
+
+	// connecting using Azure Active Directory requires the provider's credentials, so only inspect the tracking table
+	// directly when SQL authentication is used
+	username := state.Attributes["sql_authentication.0.username"]
+	if username == "" {
+		return utils.Bool(state.Attributes["applied_migrations.%"] != "0"), nil
+	}
+
+	server, err := client.MSSQL.ServersClient.Get(ctx, id.ResourceGroup, id.ServerName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Server %q (Resource Group %q): %+v", id.ServerName, id.ResourceGroup, err)
+	}
+	if server.ServerProperties == nil || server.ServerProperties.FullyQualifiedDomainName == nil {
+		return nil, fmt.Errorf("retrieving Server %q (Resource Group %q): `fullyQualifiedDomainName` was nil", id.ServerName, id.ResourceGroup)
+	}
+
+	dsn := url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(username, state.Attributes["sql_authentication.0.password"]),
+		Host:     fmt.Sprintf("%s:1433", *server.ServerProperties.FullyQualifiedDomainName),
+		RawQuery: url.Values{"database": []string{id.DatabaseName}}.Encode(),
+	}
+	connector, err := mssql.NewConnector(dsn.String())
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	applied, _, err := helper.ReadDatabaseScriptMigrations(ctx, db, state.Attributes["tracking_table_name"], id.ScriptName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(len(applied) > 0), nil
+}
+
+func (r MsSqlDatabaseScriptResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_script" "test" {
+  name        = "acctest-script-%d"
+  database_id = azurerm_mssql_database.test.id
+
+  sql_authentication {
+    username = azurerm_mssql_server.test.administrator_login
+    password = azurerm_mssql_server.test.administrator_login_password
+  }
+
+  migration {
+    version = "1"
+    script  = <<SQL
+CREATE TABLE [dbo].[Customers] (
+  [Id] INT IDENTITY(1,1) PRIMARY KEY,
+  [Name] NVARCHAR(200) NOT NULL
+)
+GO
+INSERT INTO [dbo].[Customers] ([Name]) VALUES (N'Contoso')
+SQL
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseScriptResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_script" "import" {
+  name        = azurerm_mssql_database_script.test.name
+  database_id = azurerm_mssql_database_script.test.database_id
+
+  sql_authentication {
+    username = azurerm_mssql_server.test.administrator_login
+    password = azurerm_mssql_server.test.administrator_login_password
+  }
+
+  migration {
+    version = "1"
+    script  = "SELECT 1"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlDatabaseScriptResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_script" "test" {
+  name        = "acctest-script-%d"
+  database_id = azurerm_mssql_database.test.id
+
+  sql_authentication {
+    username = azurerm_mssql_server.test.administrator_login
+    password = azurerm_mssql_server.test.administrator_login_password
+  }
+
+  migration {
+    version = "1"
+    script  = <<SQL
+CREATE TABLE [dbo].[Customers] (
+  [Id] INT IDENTITY(1,1) PRIMARY KEY,
+  [Name] NVARCHAR(200) NOT NULL
+)
+GO
+INSERT INTO [dbo].[Customers] ([Name]) VALUES (N'Contoso')
+SQL
+  }
+
+  migration {
+    version = "2"
+    script  = "ALTER TABLE [dbo].[Customers] ADD [Email] NVARCHAR(320) NULL"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseScriptResource) azureActiveDirectory(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_script" "test" {
+  name        = "acctest-script-%d"
+  database_id = azurerm_mssql_database.test.id
+
+  migration {
+    version = "1"
+    script  = "CREATE TABLE [dbo].[Orders] ([Id] INT PRIMARY KEY)"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlDatabaseScriptResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  # importing connects using Azure Active Directory, since the SQL credentials aren't available
+  azuread_administrator {
+    login_username = "AzureAD Admin"
+    object_id      = data.azurerm_client_config.current.object_id
+  }
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "AllowAll"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "255.255.255.255"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[1]d"
+  server_id = azurerm_mssql_server.test.id
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,280 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/gofrs/uuid"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/sdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorModel struct {
+	ManagedInstanceId         string `tfschema:"managed_instance_id"`
+	AzureADAuthenticationOnly bool   `tfschema:"azuread_authentication_only"`
+	LoginUsername             string `tfschema:"login_username"`
+	ObjectId                  string `tfschema:"object_id"`
+	TenantId                  string `tfschema:"tenant_id"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorResource struct{}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_active_directory_administrator"
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceActiveDirectoryAdministratorModel{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceAzureActiveDirectoryAdministratorID
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"login_username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"azuread_authentication_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+
+			var model MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceAzureActiveDirectoryAdministratorID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, string(sql.AdministratorTypeActiveDirectory))
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName), model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName), model); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceActiveDirectoryAdministratorModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if props := existing.ManagedInstanceAdministratorProperties; props != nil {
+				if props.Login != nil {
+					model.LoginUsername = *props.Login
+				}
+				if props.Sid != nil {
+					model.ObjectId = props.Sid.String()
+				}
+				if props.TenantID != nil {
+					model.TenantId = props.TenantID.String()
+				}
+			}
+
+			aadOnly, err := aadOnlyClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil && !utils.ResponseWasNotFound(aadOnly.Response) {
+				return fmt.Errorf("retrieving Azure Active Directory Only Authentication for %s: %+v", id, err)
+			}
+			if props := aadOnly.ManagedInstanceAzureADOnlyAuthProperties; props != nil && props.AzureADOnlyAuthentication != nil {
+				model.AzureADAuthenticationOnly = *props.AzureADOnlyAuthentication
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			// the administrator cannot be removed whilst Azure Active Directory Only Authentication is enabled
+			aadOnlyFuture, err := aadOnlyClient.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				return fmt.Errorf("disabling Azure Active Directory Only Authentication for %s: %+v", id, err)
+			}
+			if err = aadOnlyFuture.WaitForCompletionRef(ctx, aadOnlyClient.Client); err != nil {
+				return fmt.Errorf("waiting for Azure Active Directory Only Authentication to be disabled for %s: %+v", id, err)
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, managedInstanceId parse.ManagedInstanceId, model MsSqlManagedInstanceActiveDirectoryAdministratorModel) error {
+	client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+	aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+	sid, err := uuid.FromString(model.ObjectId)
+	if err != nil {
+		return fmt.Errorf("parsing `object_id` %q: %+v", model.ObjectId, err)
+	}
+
+	tenantId, err := uuid.FromString(model.TenantId)
+	if err != nil {
+		return fmt.Errorf("parsing `tenant_id` %q: %+v", model.TenantId, err)
+	}
+
+	parameters := sql.ManagedInstanceAdministrator{
+		ManagedInstanceAdministratorProperties: &sql.ManagedInstanceAdministratorProperties{
+			AdministratorType: utils.String(string(sql.AdministratorTypeActiveDirectory)),
+			Login:             utils.String(model.LoginUsername),
+			Sid:               &sid,
+			TenantID:          &tenantId,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, managedInstanceId.ResourceGroup, managedInstanceId.Name, parameters)
+	if err != nil {
+		return err
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	// the administrator has to exist before Azure Active Directory Only Authentication can be enabled
+	aadOnlyParameters := sql.ManagedInstanceAzureADOnlyAuthentication{
+		ManagedInstanceAzureADOnlyAuthProperties: &sql.ManagedInstanceAzureADOnlyAuthProperties{
+			AzureADOnlyAuthentication: utils.Bool(model.AzureADAuthenticationOnly),
+		},
+	}
+
+	aadOnlyFuture, err := aadOnlyClient.CreateOrUpdate(ctx, managedInstanceId.ResourceGroup, managedInstanceId.Name, aadOnlyParameters)
+	if err != nil {
+		return fmt.Errorf("setting Azure Active Directory Only Authentication: %+v", err)
+	}
+	if err = aadOnlyFuture.WaitForCompletionRef(ctx, aadOnlyClient.Client); err != nil {
+		return fmt.Errorf("waiting for Azure Active Directory Only Authentication to be set: %+v", err)
+	}
+
+	return nil
+}
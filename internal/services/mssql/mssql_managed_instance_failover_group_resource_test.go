@@ -0,0 +1,116 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceFailoverGroupResource struct{}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.automaticFailover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("partner_region.0.role").HasValue("Secondary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.manualFailover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlManagedInstanceFailoverGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceFailoverGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstanceFailoverGroupsClient.Get(ctx, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) automaticFailover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  location                    = azurerm_mssql_managed_instance.test.location
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) manualFailover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  location                    = azurerm_mssql_managed_instance.test.location
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  readonly_endpoint_failover_policy_enabled = false
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+// template provisions two Managed Instances sharing a DNS Zone in peered Virtual Networks, which is required
+// for them to be paired within a Failover Group
+func (MsSqlManagedInstanceFailoverGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_peering" "primary_to_secondary" {
+  name                      = "primary-to-secondary"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.test.name
+  remote_virtual_network_id = azurerm_virtual_network.secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "secondary_to_primary" {
+  name                      = "secondary-to-primary"
+  resource_group_name       = azurerm_resource_group.secondary.name
+  virtual_network_name      = azurerm_virtual_network.secondary.name
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+`, MsSqlManagedInstanceResource{}.dnsZonePartner(data))
+}
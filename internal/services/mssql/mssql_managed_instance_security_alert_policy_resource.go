@@ -0,0 +1,309 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/sdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceSecurityAlertPolicyModel struct {
+	ManagedInstanceId       string   `tfschema:"managed_instance_id"`
+	DisabledAlerts          []string `tfschema:"disabled_alerts"`
+	EmailAccountAdmins      bool     `tfschema:"email_account_admins"`
+	EmailAddresses          []string `tfschema:"email_addresses"`
+	Enabled                 bool     `tfschema:"enabled"`
+	RetentionDays           int      `tfschema:"retention_days"`
+	StorageAccountAccessKey string   `tfschema:"storage_account_access_key"`
+	StorageEndpoint         string   `tfschema:"storage_endpoint"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceSecurityAlertPolicyResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceSecurityAlertPolicyResource{}
+
+type MsSqlManagedInstanceSecurityAlertPolicyResource struct{}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_security_alert_policy"
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceSecurityAlertPolicyModel{}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceSecurityAlertPolicyID
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"disabled_alerts": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Set:      pluginsdk.HashString,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Sql_Injection",
+					"Sql_Injection_Vulnerability",
+					"Access_Anomaly",
+					"Data_Exfiltration",
+					"Unsafe_Action",
+				}, false),
+			},
+		},
+
+		"email_account_admins": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"email_addresses": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Set:      pluginsdk.HashString,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"retention_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"storage_account_access_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_endpoint": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlManagedInstanceSecurityAlertPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			// a (disabled) security alert policy is always present on a Managed Instance, so rather than checking
+			// for an existing policy we overwrite it - in the same way as the `azurerm_mssql_server_security_alert_policy`
+			id := parse.NewManagedInstanceSecurityAlertPolicyID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "Default")
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, id, r.expandSecurityAlertPolicy(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceSecurityAlertPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceSecurityAlertPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, *id, r.expandSecurityAlertPolicy(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceServerSecurityAlertPoliciesClient
+
+			id, err := parse.ManagedInstanceSecurityAlertPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the storage account access key isn't returned by the API so we keep the value from the existing state/config
+			var state MsSqlManagedInstanceSecurityAlertPolicyModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := MsSqlManagedInstanceSecurityAlertPolicyModel{
+				ManagedInstanceId:       parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+				StorageAccountAccessKey: state.StorageAccountAccessKey,
+			}
+
+			if props := existing.SecurityAlertsPolicyProperties; props != nil {
+				model.Enabled = props.State == sql.SecurityAlertsPolicyStateEnabled
+
+				if props.DisabledAlerts != nil {
+					for _, v := range *props.DisabledAlerts {
+						if v != "" {
+							model.DisabledAlerts = append(model.DisabledAlerts, v)
+						}
+					}
+				}
+				if props.EmailAccountAdmins != nil {
+					model.EmailAccountAdmins = *props.EmailAccountAdmins
+				}
+				if props.EmailAddresses != nil {
+					for _, v := range *props.EmailAddresses {
+						if v != "" {
+							model.EmailAddresses = append(model.EmailAddresses, v)
+						}
+					}
+				}
+				if props.RetentionDays != nil {
+					model.RetentionDays = int(*props.RetentionDays)
+				}
+				if props.StorageEndpoint != nil {
+					model.StorageEndpoint = *props.StorageEndpoint
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceSecurityAlertPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			// the security alert policy cannot be deleted, so instead we reset it to the disabled default
+			disabledPolicy := sql.ManagedServerSecurityAlertPolicy{
+				SecurityAlertsPolicyProperties: &sql.SecurityAlertsPolicyProperties{
+					State: sql.SecurityAlertsPolicyStateDisabled,
+				},
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, *id, disabledPolicy); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedInstanceSecurityAlertPolicyId, parameters sql.ManagedServerSecurityAlertPolicy) error {
+	client := metadata.Client.MSSQL.ManagedInstanceServerSecurityAlertPoliciesClient
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, parameters)
+	if err != nil {
+		return err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	return nil
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) expandSecurityAlertPolicy(model MsSqlManagedInstanceSecurityAlertPolicyModel) sql.ManagedServerSecurityAlertPolicy {
+	state := sql.SecurityAlertsPolicyStateDisabled
+	if model.Enabled {
+		state = sql.SecurityAlertsPolicyStateEnabled
+	}
+
+	disabledAlerts := make([]string, 0)
+	disabledAlerts = append(disabledAlerts, model.DisabledAlerts...)
+
+	emailAddresses := make([]string, 0)
+	emailAddresses = append(emailAddresses, model.EmailAddresses...)
+
+	props := &sql.SecurityAlertsPolicyProperties{
+		State:              state,
+		DisabledAlerts:     &disabledAlerts,
+		EmailAccountAdmins: utils.Bool(model.EmailAccountAdmins),
+		EmailAddresses:     &emailAddresses,
+		RetentionDays:      utils.Int32(int32(model.RetentionDays)),
+	}
+
+	if model.StorageAccountAccessKey != "" {
+		props.StorageAccountAccessKey = utils.String(model.StorageAccountAccessKey)
+	}
+
+	if model.StorageEndpoint != "" {
+		props.StorageEndpoint = utils.String(model.StorageEndpoint)
+	}
+
+	return sql.ManagedServerSecurityAlertPolicy{
+		SecurityAlertsPolicyProperties: props,
+	}
+}
@@ -0,0 +1,130 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceSecurityAlertPolicyResource struct{}
+
+func TestAccMsSqlManagedInstanceSecurityAlertPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_security_alert_policy", "test")
+	r := MsSqlManagedInstanceSecurityAlertPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_access_key"),
+	})
+}
+
+func TestAccMsSqlManagedInstanceSecurityAlertPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_security_alert_policy", "test")
+	r := MsSqlManagedInstanceSecurityAlertPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_access_key"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_alerts.#").HasValue("2"),
+				check.That(data.ResourceName).Key("email_addresses.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("storage_account_access_key"),
+	})
+}
+
+func (MsSqlManagedInstanceSecurityAlertPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceSecurityAlertPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstanceServerSecurityAlertPoliciesClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the policy can't be deleted, only disabled
+	if props := resp.SecurityAlertsPolicyProperties; props != nil {
+		return utils.Bool(props.State == sql.SecurityAlertsPolicyStateEnabled), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_security_alert_policy" "test" {
+  managed_instance_id        = azurerm_mssql_managed_instance.test.id
+  enabled                    = true
+  storage_endpoint           = azurerm_storage_account.test.primary_blob_endpoint
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+  retention_days             = 20
+}
+`, r.template(data))
+}
+
+func (r MsSqlManagedInstanceSecurityAlertPolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_security_alert_policy" "test" {
+  managed_instance_id        = azurerm_mssql_managed_instance.test.id
+  enabled                    = true
+  storage_endpoint           = azurerm_storage_account.test.primary_blob_endpoint
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+  retention_days             = 30
+  email_account_admins       = true
+
+  disabled_alerts = [
+    "Sql_Injection",
+    "Data_Exfiltration",
+  ]
+
+  email_addresses = [
+    "example@example.com",
+  ]
+}
+`, r.template(data))
+}
+
+func (MsSqlManagedInstanceSecurityAlertPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomString)
+}
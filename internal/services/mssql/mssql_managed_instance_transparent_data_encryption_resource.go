@@ -0,0 +1,258 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/sdk"
+	keyVaultParser "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceTransparentDataEncryptionModel struct {
+	ManagedInstanceId   string `tfschema:"managed_instance_id"`
+	AutoRotationEnabled bool   `tfschema:"auto_rotation_enabled"`
+	KeyVaultKeyId       string `tfschema:"key_vault_key_id"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceTransparentDataEncryptionResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceTransparentDataEncryptionResource{}
+
+type MsSqlManagedInstanceTransparentDataEncryptionResource struct{}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_transparent_data_encryption"
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceTransparentDataEncryptionModel{}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceEncryptionProtectorID
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"auto_rotation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"key_vault_key_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemId,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlManagedInstanceTransparentDataEncryptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			// the encryption protector always exists (using a Service Managed key by default) and always uses
+			// "current" for the name, so rather than checking for an existing one we overwrite it - since the
+			// Managed Instance has to be granted access to the Key Vault before a Customer Managed key can be used
+			id := parse.NewManagedInstanceEncryptionProtectorID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "current")
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceTransparentDataEncryptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			if err := r.createOrUpdate(ctx, metadata, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceEncryptionProtectorClient
+
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceTransparentDataEncryptionModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if props := existing.ManagedInstanceEncryptionProtectorProperties; props != nil {
+				// the key_vault_key_id is only set when using a Customer Managed key
+				if props.ServerKeyType == sql.ServerKeyTypeAzureKeyVault && props.URI != nil {
+					model.KeyVaultKeyId = *props.URI
+				}
+				if props.AutoRotationEnabled != nil {
+					model.AutoRotationEnabled = *props.AutoRotationEnabled
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceEncryptionProtectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			// the encryption protector cannot be deleted, so instead we reset it to a Service Managed key to
+			// prevent the Managed Instance being locked out should the key subsequently be removed from the Key Vault
+			if err := r.createOrUpdate(ctx, metadata, *id, MsSqlManagedInstanceTransparentDataEncryptionModel{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedInstanceEncryptionProtectorId, model MsSqlManagedInstanceTransparentDataEncryptionModel) error {
+	client := metadata.Client.MSSQL.ManagedInstanceEncryptionProtectorClient
+	keysClient := metadata.Client.MSSQL.ManagedInstanceKeysClient
+
+	// Service Managed keys don't require a key name
+	serverKeyName := "ServiceManaged"
+	serverKeyType := sql.ServerKeyTypeServiceManaged
+
+	if keyVaultKeyId := strings.TrimSpace(model.KeyVaultKeyId); keyVaultKeyId != "" {
+		serverKeyType = sql.ServerKeyTypeAzureKeyVault
+
+		keyName, err := r.managedInstanceKeyName(keyVaultKeyId)
+		if err != nil {
+			return err
+		}
+		serverKeyName = keyName
+
+		// the key has to be added to the Managed Instance before it can be used as the encryption protector
+		key := sql.ManagedInstanceKey{
+			ManagedInstanceKeyProperties: &sql.ManagedInstanceKeyProperties{
+				ServerKeyType: serverKeyType,
+				URI:           utils.String(keyVaultKeyId),
+			},
+		}
+
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, serverKeyName, key)
+		if err != nil {
+			return fmt.Errorf("creating/updating Managed Instance Key %q: %+v", serverKeyName, err)
+		}
+		if err = keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of Managed Instance Key %q: %+v", serverKeyName, err)
+		}
+	}
+
+	parameters := sql.ManagedInstanceEncryptionProtector{
+		ManagedInstanceEncryptionProtectorProperties: &sql.ManagedInstanceEncryptionProtectorProperties{
+			ServerKeyType:       serverKeyType,
+			ServerKeyName:       utils.String(serverKeyName),
+			AutoRotationEnabled: utils.Bool(model.AutoRotationEnabled),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, parameters)
+	if err != nil {
+		return err
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	return nil
+}
+
+// managedInstanceKeyName returns the name of the Managed Instance Key for the specified Key Vault Key, which is in
+// the format `{vaultName}_{keyName}_{keyVersion}`
+func (r MsSqlManagedInstanceTransparentDataEncryptionResource) managedInstanceKeyName(keyVaultKeyId string) (string, error) {
+	keyId, err := keyVaultParser.ParseNestedItemID(keyVaultKeyId)
+	if err != nil {
+		return "", fmt.Errorf("parsing `key_vault_key_id` %q: %+v", keyVaultKeyId, err)
+	}
+
+	if keyId.NestedItemType != "keys" {
+		return "", fmt.Errorf("`key_vault_key_id` must be a reference to a key, but got: %s", keyId.NestedItemType)
+	}
+
+	idURL, err := url.ParseRequestURI(keyId.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("parsing Key Vault hostname %q: %+v", keyId.KeyVaultBaseUrl, err)
+	}
+	vaultName := strings.Split(idURL.Host, ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, keyId.Name, keyId.Version), nil
+}
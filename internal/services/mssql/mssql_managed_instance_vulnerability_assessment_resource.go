@@ -0,0 +1,290 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/sdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mssql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MsSqlManagedInstanceVulnerabilityAssessmentModel struct {
+	ManagedInstanceId       string                `tfschema:"managed_instance_id"`
+	RecurringScans          []RecurringScansModel `tfschema:"recurring_scans"`
+	StorageAccountAccessKey string                `tfschema:"storage_account_access_key"`
+	StorageContainerPath    string                `tfschema:"storage_container_path"`
+	StorageContainerSasKey  string                `tfschema:"storage_container_sas_key"`
+}
+
+type RecurringScansModel struct {
+	EmailSubscriptionAdmins bool     `tfschema:"email_subscription_admins"`
+	Emails                  []string `tfschema:"emails"`
+	Enabled                 bool     `tfschema:"enabled"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceVulnerabilityAssessmentResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceVulnerabilityAssessmentResource{}
+
+type MsSqlManagedInstanceVulnerabilityAssessmentResource struct{}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_vulnerability_assessment"
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceVulnerabilityAssessmentModel{}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceVulnerabilityAssessmentID
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"storage_container_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"recurring_scans": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"email_subscription_admins": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"emails": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"storage_account_access_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"storage_container_sas_key"},
+		},
+
+		"storage_container_sas_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"storage_account_access_key"},
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			var model MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceVulnerabilityAssessmentID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "default")
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			// the API returns an empty assessment rather than a 404 when one hasn't been configured
+			if !utils.ResponseWasNotFound(existing.Response) && existing.ManagedInstanceVulnerabilityAssessmentProperties != nil && existing.StorageContainerPath != nil && *existing.StorageContainerPath != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, r.expandVulnerabilityAssessment(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, r.expandVulnerabilityAssessment(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the storage keys aren't returned by the API so we keep the values from the existing state/config
+			var state MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := MsSqlManagedInstanceVulnerabilityAssessmentModel{
+				ManagedInstanceId:       parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+				StorageAccountAccessKey: state.StorageAccountAccessKey,
+				StorageContainerSasKey:  state.StorageContainerSasKey,
+			}
+
+			if props := existing.ManagedInstanceVulnerabilityAssessmentProperties; props != nil {
+				if props.StorageContainerPath != nil {
+					model.StorageContainerPath = *props.StorageContainerPath
+				}
+
+				if scans := props.RecurringScans; scans != nil {
+					recurringScans := RecurringScansModel{}
+					if scans.EmailSubscriptionAdmins != nil {
+						recurringScans.EmailSubscriptionAdmins = *scans.EmailSubscriptionAdmins
+					}
+					if scans.Emails != nil {
+						recurringScans.Emails = *scans.Emails
+					}
+					if scans.IsEnabled != nil {
+						recurringScans.Enabled = *scans.IsEnabled
+					}
+					model.RecurringScans = []RecurringScansModel{recurringScans}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) expandVulnerabilityAssessment(model MsSqlManagedInstanceVulnerabilityAssessmentModel) sql.ManagedInstanceVulnerabilityAssessment {
+	props := &sql.ManagedInstanceVulnerabilityAssessmentProperties{
+		StorageContainerPath: utils.String(model.StorageContainerPath),
+	}
+
+	if model.StorageAccountAccessKey != "" {
+		props.StorageAccountAccessKey = utils.String(model.StorageAccountAccessKey)
+	}
+
+	if model.StorageContainerSasKey != "" {
+		props.StorageContainerSasKey = utils.String(model.StorageContainerSasKey)
+	}
+
+	if len(model.RecurringScans) > 0 {
+		scans := model.RecurringScans[0]
+		emails := make([]string, 0)
+		emails = append(emails, scans.Emails...)
+
+		props.RecurringScans = &sql.VulnerabilityAssessmentRecurringScansProperties{
+			EmailSubscriptionAdmins: utils.Bool(scans.EmailSubscriptionAdmins),
+			Emails:                  &emails,
+			IsEnabled:               utils.Bool(scans.Enabled),
+		}
+	}
+
+	return sql.ManagedInstanceVulnerabilityAssessment{
+		ManagedInstanceVulnerabilityAssessmentProperties: props,
+	}
+}
@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type ManagedDatabaseId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	ManagedInstanceName string
+	DatabaseName        string
+}
+
+func NewManagedDatabaseID(subscriptionId, resourceGroup, managedInstanceName, databaseName string) ManagedDatabaseId {
+	return ManagedDatabaseId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		ManagedInstanceName: managedInstanceName,
+		DatabaseName:        databaseName,
+	}
+}
+
+func (id ManagedDatabaseId) String() string {
+	segments := []string{
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Managed Instance Name %q", id.ManagedInstanceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Database", segmentsStr)
+}
+
+func (id ManagedDatabaseId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/managedInstances/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName)
+}
+
+// ManagedDatabaseID parses a ManagedDatabase ID into an ManagedDatabaseId struct
+func ManagedDatabaseID(input string) (*ManagedDatabaseId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedDatabaseId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedInstanceName, err = id.PopSegment("managedInstances"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ManagedDatabaseIDInsensitively parses an ManagedDatabase ID into an ManagedDatabaseId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ManagedDatabaseID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ManagedDatabaseIDInsensitively(input string) (*ManagedDatabaseId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedDatabaseId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'managedInstances' segment
+	managedInstancesKey := "managedInstances"
+	for key := range id.Path {
+		if strings.EqualFold(key, managedInstancesKey) {
+			managedInstancesKey = key
+			break
+		}
+	}
+	if resourceId.ManagedInstanceName, err = id.PopSegment(managedInstancesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'databases' segment
+	databasesKey := "databases"
+	for key := range id.Path {
+		if strings.EqualFold(key, databasesKey) {
+			databasesKey = key
+			break
+		}
+	}
+	if resourceId.DatabaseName, err = id.PopSegment(databasesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
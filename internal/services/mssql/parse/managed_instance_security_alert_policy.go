@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type ManagedInstanceSecurityAlertPolicyId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	ManagedInstanceName     string
+	SecurityAlertPolicyName string
+}
+
+func NewManagedInstanceSecurityAlertPolicyID(subscriptionId, resourceGroup, managedInstanceName, securityAlertPolicyName string) ManagedInstanceSecurityAlertPolicyId {
+	return ManagedInstanceSecurityAlertPolicyId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		ManagedInstanceName:     managedInstanceName,
+		SecurityAlertPolicyName: securityAlertPolicyName,
+	}
+}
+
+func (id ManagedInstanceSecurityAlertPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Security Alert Policy Name %q", id.SecurityAlertPolicyName),
+		fmt.Sprintf("Managed Instance Name %q", id.ManagedInstanceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Instance Security Alert Policy", segmentsStr)
+}
+
+func (id ManagedInstanceSecurityAlertPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/managedInstances/%s/securityAlertPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.SecurityAlertPolicyName)
+}
+
+// ManagedInstanceSecurityAlertPolicyID parses a ManagedInstanceSecurityAlertPolicy ID into an ManagedInstanceSecurityAlertPolicyId struct
+func ManagedInstanceSecurityAlertPolicyID(input string) (*ManagedInstanceSecurityAlertPolicyId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedInstanceSecurityAlertPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedInstanceName, err = id.PopSegment("managedInstances"); err != nil {
+		return nil, err
+	}
+	if resourceId.SecurityAlertPolicyName, err = id.PopSegment("securityAlertPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -59,6 +59,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		MsSqlDatabaseScriptResource{},
 		MsSqlFailoverGroupResource{},
+		MsSqlManagedDatabaseResource{},
+		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
+		MsSqlManagedInstanceFailoverGroupResource{},
+		MsSqlManagedInstanceResource{},
+		MsSqlManagedInstanceSecurityAlertPolicyResource{},
+		MsSqlManagedInstanceTransparentDataEncryptionResource{},
+		MsSqlManagedInstanceVulnerabilityAssessmentResource{},
 	}
 }
@@ -4,15 +4,20 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/sdk/2021-12-01-preview/azureadadministrators"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/sdk/2021-12-01-preview/servers"
 )
 
 type Client struct {
 	ConfigurationsClient               *mysql.ConfigurationsClient
 	DatabasesClient                    *mysql.DatabasesClient
 	FirewallRulesClient                *mysql.FirewallRulesClient
+	FlexibleDatabasesClient            *mysqlflexibleservers.DatabasesClient
+	FlexibleServerAdministratorsClient *azureadadministrators.AzureADAdministratorsClient
 	FlexibleServerConfigurationsClient *mysqlflexibleservers.ConfigurationsClient
 	FlexibleServerClient               *mysqlflexibleservers.ServersClient
 	FlexibleServerFirewallRulesClient  *mysqlflexibleservers.FirewallRulesClient
+	FlexibleServerPropertiesClient     *servers.ServersClient
 	ServersClient                      *mysql.ServersClient
 	ServerKeysClient                   *mysql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient  *mysql.ServerSecurityAlertPoliciesClient
@@ -30,6 +35,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	FirewallRulesClient := mysql.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleDatabasesClient := mysqlflexibleservers.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&flexibleDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerAdministratorsClient := azureadadministrators.NewAzureADAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
 	flexibleServerClient := mysqlflexibleservers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -39,6 +50,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := mysqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerPropertiesClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerPropertiesClient.Client, o.ResourceManagerAuthorizer)
+
 	ServersClient := mysql.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ServersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -58,9 +72,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		ConfigurationsClient:               &ConfigurationsClient,
 		DatabasesClient:                    &DatabasesClient,
 		FirewallRulesClient:                &FirewallRulesClient,
+		FlexibleDatabasesClient:            &flexibleDatabasesClient,
+		FlexibleServerAdministratorsClient: &flexibleServerAdministratorsClient,
 		FlexibleServerClient:               &flexibleServerClient,
 		FlexibleServerFirewallRulesClient:  &flexibleServerFirewallRulesClient,
 		FlexibleServerConfigurationsClient: &flexibleServerConfigurationsClient,
+		FlexibleServerPropertiesClient:     &flexibleServerPropertiesClient,
 		ServersClient:                      &ServersClient,
 		ServerKeysClient:                   &ServerKeysClient,
 		ServerSecurityAlertPoliciesClient:  &serverSecurityAlertPoliciesClient,
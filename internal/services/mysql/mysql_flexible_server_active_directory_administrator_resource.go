@@ -0,0 +1,171 @@
+package mysql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	msivalidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/sdk/2021-12-01-preview/azureadadministrators"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+// a flexible server has at most one Azure Active Directory administrator, which always has this name
+const flexibleServerActiveDirectoryAdministratorName = "ActiveDirectory"
+
+func resourceMySqlFlexibleServerActiveDirectoryAdministrator() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMySqlFlexibleServerActiveDirectoryAdministratorCreateUpdate,
+		Read:   resourceMySqlFlexibleServerActiveDirectoryAdministratorRead,
+		Update: resourceMySqlFlexibleServerActiveDirectoryAdministratorCreateUpdate,
+		Delete: resourceMySqlFlexibleServerActiveDirectoryAdministratorDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := azureadadministrators.ParseAdministratorID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerID,
+			},
+
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: msivalidate.UserAssignedIdentityID,
+			},
+
+			"login": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"object_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceMySqlFlexibleServerActiveDirectoryAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.FlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := azureadadministrators.NewAdministratorID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, flexibleServerActiveDirectoryAdministratorName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_mysql_flexible_server_active_directory_administrator", id.ID())
+		}
+	}
+
+	administratorType := azureadadministrators.AdministratorTypeActiveDirectory
+	parameters := azureadadministrators.AzureADAdministrator{
+		Properties: &azureadadministrators.AdministratorProperties{
+			AdministratorType:  &administratorType,
+			IdentityResourceId: utils.String(d.Get("identity_id").(string)),
+			Login:              utils.String(d.Get("login").(string)),
+			Sid:                utils.String(d.Get("object_id").(string)),
+			TenantId:           utils.String(d.Get("tenant_id").(string)),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMySqlFlexibleServerActiveDirectoryAdministratorRead(d, meta)
+}
+
+func resourceMySqlFlexibleServerActiveDirectoryAdministratorRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azureadadministrators.ParseAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("server_id", parse.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("identity_id", props.IdentityResourceId)
+			d.Set("login", props.Login)
+			d.Set("object_id", props.Sid)
+			d.Set("tenant_id", props.TenantId)
+		}
+	}
+
+	return nil
+}
+
+func resourceMySqlFlexibleServerActiveDirectoryAdministratorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azureadadministrators.ParseAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package mysql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/sdk/2021-12-01-preview/azureadadministrators"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type MySqlFlexibleServerActiveDirectoryAdministratorResource struct {
+}
+
+func TestAccMySqlFlexibleServerActiveDirectoryAdministrator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMySqlFlexibleServerActiveDirectoryAdministrator_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMySqlFlexibleServerActiveDirectoryAdministrator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updateLogin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("login").HasValue(fmt.Sprintf("acctest-admin-updated-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MySqlFlexibleServerActiveDirectoryAdministratorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azureadadministrators.ParseAdministratorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MySQL.FlexibleServerAdministratorsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MySqlFlexibleServerActiveDirectoryAdministratorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  sku_name               = "B_Standard_B1s"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, MySqlFlexibleServerResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r MySqlFlexibleServerActiveDirectoryAdministratorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "test" {
+  server_id   = azurerm_mysql_flexible_server.test.id
+  identity_id = azurerm_user_assigned_identity.test.id
+  login       = "acctest-admin-%d"
+  object_id   = data.azurerm_client_config.current.object_id
+  tenant_id   = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MySqlFlexibleServerActiveDirectoryAdministratorResource) updateLogin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "test" {
+  server_id   = azurerm_mysql_flexible_server.test.id
+  identity_id = azurerm_user_assigned_identity.test.id
+  login       = "acctest-admin-updated-%d"
+  object_id   = data.azurerm_client_config.current.object_id
+  tenant_id   = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MySqlFlexibleServerActiveDirectoryAdministratorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "import" {
+  server_id   = azurerm_mysql_flexible_server_active_directory_administrator.test.server_id
+  identity_id = azurerm_mysql_flexible_server_active_directory_administrator.test.identity_id
+  login       = azurerm_mysql_flexible_server_active_directory_administrator.test.login
+  object_id   = azurerm_mysql_flexible_server_active_directory_administrator.test.object_id
+  tenant_id   = azurerm_mysql_flexible_server_active_directory_administrator.test.tenant_id
+}
+`, r.basic(data))
+}
@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,8 +12,12 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/identity"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
+	msivalidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/sdk/2021-12-01-preview/servers"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/validate"
 	networkValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
 	privateDnsValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/privatedns/validate"
@@ -28,6 +33,8 @@ const (
 	ServerMaintenanceWindowDisabled = "Disabled"
 )
 
+type mysqlFlexibleServerIdentity = identity.UserAssigned
+
 func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMysqlFlexibleServerCreate,
@@ -47,6 +54,13 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the user assigned identities can be changed, but a server can't go back to having no identity
+			pluginsdk.ForceNewIfChange("identity", func(ctx context.Context, old, new, _ interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -93,6 +107,42 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"customer_managed_key": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"identity"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+
+						"primary_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+						},
+
+						"geo_backup_key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+							RequiredWith: []string{"customer_managed_key.0.geo_backup_user_assigned_identity_id"},
+						},
+
+						"geo_backup_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+							RequiredWith: []string{"customer_managed_key.0.geo_backup_key_vault_key_id"},
+						},
+					},
+				},
+			},
+
 			"delegated_subnet_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -136,6 +186,8 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				},
 			},
 
+			"identity": mysqlFlexibleServerIdentity{}.Schema(),
+
 			"maintenance_window": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -278,6 +330,7 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).MySQL.FlexibleServerClient
+	propertiesClient := meta.(*clients.Client).MySQL.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -396,6 +449,15 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// `identity` and `customer_managed_key` are only available in a newer API version, so are set once the server exists
+	_, hasIdentity := d.GetOk("identity")
+	_, hasCustomerManagedKey := d.GetOk("customer_managed_key")
+	if hasIdentity || hasCustomerManagedKey {
+		if err := updateMysqlFlexibleServerEncryption(ctx, propertiesClient, id, d); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceMysqlFlexibleServerRead(d, meta)
@@ -403,6 +465,7 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 
 func resourceMysqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MySQL.FlexibleServerClient
+	propertiesClient := meta.(*clients.Client).MySQL.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -464,11 +527,32 @@ func resourceMysqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}
 
 	d.Set("sku_name", sku)
 
+	properties, err := propertiesClient.Get(ctx, servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving `identity` and `customer_managed_key` for Mysql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	var serverIdentity *identity.UserAssignedIdentityMap
+	var dataEncryption *servers.DataEncryption
+	if model := properties.Model; model != nil {
+		serverIdentity = model.Identity
+		if model.Properties != nil {
+			dataEncryption = model.Properties.DataEncryption
+		}
+	}
+	if err := d.Set("identity", flattenMysqlFlexibleServerIdentity(serverIdentity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+	if err := d.Set("customer_managed_key", flattenMysqlFlexibleServerDataEncryption(dataEncryption)); err != nil {
+		return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MySQL.FlexibleServerClient
+	propertiesClient := meta.(*clients.Client).MySQL.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -643,6 +727,12 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChanges("identity", "customer_managed_key") {
+		if err := updateMysqlFlexibleServerEncryption(ctx, propertiesClient, *id, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceMysqlFlexibleServerRead(d, meta)
 }
 
@@ -880,3 +970,108 @@ func flattenFlexibleServerHighAvailability(ha *mysqlflexibleservers.HighAvailabi
 		},
 	}
 }
+
+func updateMysqlFlexibleServerEncryption(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId, d *pluginsdk.ResourceData) error {
+	parameters := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			DataEncryption: expandMysqlFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{})),
+		},
+	}
+
+	// an identity can't be removed from the server, so it's only sent when one is configured
+	if v := d.Get("identity").([]interface{}); len(v) > 0 {
+		serverIdentity, err := expandMysqlFlexibleServerIdentity(v)
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = serverIdentity
+	}
+
+	if err := client.UpdateThenPoll(ctx, servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name), parameters); err != nil {
+		return fmt.Errorf("updating `identity` and `customer_managed_key` for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandMysqlFlexibleServerIdentity(input []interface{}) (*identity.UserAssignedIdentityMap, error) {
+	expanded, err := mysqlFlexibleServerIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.UserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenMysqlFlexibleServerIdentity(input *identity.UserAssignedIdentityMap) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return mysqlFlexibleServerIdentity{}.Flatten(&config)
+}
+
+func expandMysqlFlexibleServerDataEncryption(input []interface{}) *servers.DataEncryption {
+	if len(input) == 0 || input[0] == nil {
+		encryptionType := servers.DataEncryptionTypeSystemManaged
+		return &servers.DataEncryption{
+			Type: &encryptionType,
+		}
+	}
+	v := input[0].(map[string]interface{})
+
+	encryptionType := servers.DataEncryptionTypeAzureKeyVault
+	dataEncryption := servers.DataEncryption{
+		Type:                          &encryptionType,
+		PrimaryKeyURI:                 utils.String(v["key_vault_key_id"].(string)),
+		PrimaryUserAssignedIdentityId: utils.String(v["primary_user_assigned_identity_id"].(string)),
+	}
+
+	if geoBackupKeyId := v["geo_backup_key_vault_key_id"].(string); geoBackupKeyId != "" {
+		dataEncryption.GeoBackupKeyURI = utils.String(geoBackupKeyId)
+	}
+
+	if geoBackupIdentityId := v["geo_backup_user_assigned_identity_id"].(string); geoBackupIdentityId != "" {
+		dataEncryption.GeoBackupUserAssignedIdentityId = utils.String(geoBackupIdentityId)
+	}
+
+	return &dataEncryption
+}
+
+func flattenMysqlFlexibleServerDataEncryption(input *servers.DataEncryption) []interface{} {
+	if input == nil || input.Type == nil || *input.Type != servers.DataEncryptionTypeAzureKeyVault {
+		return []interface{}{}
+	}
+
+	keyVaultKeyId := ""
+	if input.PrimaryKeyURI != nil {
+		keyVaultKeyId = *input.PrimaryKeyURI
+	}
+
+	primaryIdentityId := ""
+	if input.PrimaryUserAssignedIdentityId != nil {
+		primaryIdentityId = *input.PrimaryUserAssignedIdentityId
+	}
+
+	geoBackupKeyVaultKeyId := ""
+	if input.GeoBackupKeyURI != nil {
+		geoBackupKeyVaultKeyId = *input.GeoBackupKeyURI
+	}
+
+	geoBackupIdentityId := ""
+	if input.GeoBackupUserAssignedIdentityId != nil {
+		geoBackupIdentityId = *input.GeoBackupUserAssignedIdentityId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                     keyVaultKeyId,
+			"primary_user_assigned_identity_id":    primaryIdentityId,
+			"geo_backup_key_vault_key_id":          geoBackupKeyVaultKeyId,
+			"geo_backup_user_assigned_identity_id": geoBackupIdentityId,
+		},
+	}
+}
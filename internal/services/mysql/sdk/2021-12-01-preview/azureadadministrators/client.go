@@ -0,0 +1,15 @@
+package azureadadministrators
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AzureADAdministratorsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAzureADAdministratorsClientWithBaseURI(endpoint string) AzureADAdministratorsClient {
+	return AzureADAdministratorsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,13 @@
+package azureadadministrators
+
+type AdministratorType string
+
+const (
+	AdministratorTypeActiveDirectory AdministratorType = "ActiveDirectory"
+)
+
+func PossibleValuesForAdministratorType() []string {
+	return []string{
+		string(AdministratorTypeActiveDirectory),
+	}
+}
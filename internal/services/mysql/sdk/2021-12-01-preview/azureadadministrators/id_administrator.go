@@ -0,0 +1,126 @@
+package azureadadministrators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AdministratorId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FlexibleServerName string
+	AdministratorName  string
+}
+
+func NewAdministratorID(subscriptionId, resourceGroup, flexibleServerName, administratorName string) AdministratorId {
+	return AdministratorId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FlexibleServerName: flexibleServerName,
+		AdministratorName:  administratorName,
+	}
+}
+
+func (id AdministratorId) String() string {
+	segments := []string{
+		fmt.Sprintf("Administrator Name %q", id.AdministratorName),
+		fmt.Sprintf("Flexible Server Name %q", id.FlexibleServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Administrator", segmentsStr)
+}
+
+func (id AdministratorId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/flexibleServers/%s/administrators/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.AdministratorName)
+}
+
+// ParseAdministratorID parses a Administrator ID into an AdministratorId struct
+func ParseAdministratorID(input string) (*AdministratorId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AdministratorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+		return nil, err
+	}
+	if resourceId.AdministratorName, err = id.PopSegment("administrators"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseAdministratorIDInsensitively parses an Administrator ID into an AdministratorId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseAdministratorID method should be used instead for validation etc.
+func ParseAdministratorIDInsensitively(input string) (*AdministratorId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AdministratorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'flexibleServers' segment
+	flexibleServersKey := "flexibleServers"
+	for key := range id.Path {
+		if strings.EqualFold(key, flexibleServersKey) {
+			flexibleServersKey = key
+			break
+		}
+	}
+	if resourceId.FlexibleServerName, err = id.PopSegment(flexibleServersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'administrators' segment
+	administratorsKey := "administrators"
+	for key := range id.Path {
+		if strings.EqualFold(key, administratorsKey) {
+			administratorsKey = key
+			break
+		}
+	}
+	if resourceId.AdministratorName, err = id.PopSegment(administratorsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,9 @@
+package azureadadministrators
+
+type AdministratorProperties struct {
+	AdministratorType  *AdministratorType `json:"administratorType,omitempty"`
+	IdentityResourceId *string            `json:"identityResourceId,omitempty"`
+	Login              *string            `json:"login,omitempty"`
+	Sid                *string            `json:"sid,omitempty"`
+	TenantId           *string            `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package azureadadministrators
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/azureadadministrators/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package servers
+
+type DataEncryptionType string
+
+const (
+	DataEncryptionTypeAzureKeyVault DataEncryptionType = "AzureKeyVault"
+	DataEncryptionTypeSystemManaged DataEncryptionType = "SystemManaged"
+)
+
+func PossibleValuesForDataEncryptionType() []string {
+	return []string{
+		string(DataEncryptionTypeAzureKeyVault),
+		string(DataEncryptionTypeSystemManaged),
+	}
+}
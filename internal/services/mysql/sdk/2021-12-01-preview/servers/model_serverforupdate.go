@@ -0,0 +1,11 @@
+package servers
+
+import (
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/identity"
+)
+
+type ServerForUpdate struct {
+	Identity   *identity.UserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *ServerPropertiesForUpdate        `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package servers
+
+type ServerPropertiesForUpdate struct {
+	DataEncryption *DataEncryption `json:"dataEncryption,omitempty"`
+}
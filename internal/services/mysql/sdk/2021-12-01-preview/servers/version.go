@@ -0,0 +1,9 @@
+package servers
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/servers/%s", defaultApiVersion)
+}
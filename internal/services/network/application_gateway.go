@@ -0,0 +1,732 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	networkValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/suppress"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+)
+
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
+// applicationGatewaySubResourceSchema returns the Schema for a standalone Application Gateway sub-resource, which is
+// the Schema of the equivalent block within the `azurerm_application_gateway` resource, linked to the Application Gateway
+func applicationGatewaySubResourceSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.ApplicationGatewayID,
+		},
+	}
+
+	for k, v := range input {
+		// the `id` of the sub-resource is exposed as the ID of the resource
+		if k == "name" || k == "id" {
+			continue
+		}
+		output[k] = v
+	}
+
+	return output
+}
+
+// expandApplicationGatewaySubResource returns the configuration of a standalone Application Gateway sub-resource in
+// the same format as the equivalent block within the `azurerm_application_gateway` resource, so the same expand
+// functions can be used for both
+func expandApplicationGatewaySubResource(d *pluginsdk.ResourceData, schema map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range schema {
+		output[k] = d.Get(k)
+	}
+	return output
+}
+
+func flattenApplicationGatewaySubResource(d *pluginsdk.ResourceData, input map[string]interface{}) error {
+	for k, v := range input {
+		if k == "id" {
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGatewaySubResource retrieves the Application Gateway, applies the update and then updates the
+// Application Gateway - whilst holding the same lock as the `azurerm_application_gateway` resource
+func updateApplicationGatewaySubResource(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, update func(props *network.ApplicationGatewayPropertiesFormat) error) error {
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if err := update(gateway.ApplicationGatewayPropertiesFormat); err != nil {
+		return err
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayBackendHTTPSettingsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"cookie_based_affinity": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayCookieBasedAffinityEnabled),
+				string(network.ApplicationGatewayCookieBasedAffinityDisabled),
+			}, true),
+		},
+
+		"affinity_cookie_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"pick_host_name_from_backend_address": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"request_timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 86400),
+		},
+
+		"authentication_certificate": {
+			Type:       pluginsdk.TypeList,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Optional:   true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"trusted_root_certificate_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"connection_draining": {
+			Type:       pluginsdk.TypeList,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			MaxItems:   1,
+			Optional:   true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"drain_timeout_sec": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 3600),
+					},
+				},
+			},
+		},
+
+		"probe_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"probe_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:       pluginsdk.TypeList,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Optional:   true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ssl_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayProbeSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"host": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"interval": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"unhealthy_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"pick_host_name_from_backend_http_settings": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"minimum_servers": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+
+		//lintignore:XS003
+		"match": {
+			Type:       pluginsdk.TypeList,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Optional:   true,
+			Computed:   true,
+			MaxItems:   1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"body": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"status_code": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewaySslCertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"data": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: base64EncodedStateFunc,
+		},
+
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayURLPathMapSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"default_backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"default_backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"default_redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"default_rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path_rule": {
+			Type:       pluginsdk.TypeList,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Required:   true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"paths": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"backend_address_pool_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"backend_http_settings_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"redirect_configuration_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"rewrite_rule_set_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"backend_address_pool_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backend_http_settings_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"redirect_configuration_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"rewrite_rule_set_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"firewall_policy_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
+					},
+				},
+			},
+		},
+
+		"default_backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceApplicationGatewayBackendAddressPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceApplicationGatewayBackendAddressPoolRead,
+		Update: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceApplicationGatewayBackendAddressPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayBackendAddressPoolSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewayBackendAddressPoolSchema())
+	pool := expandApplicationGatewayBackendAddressPool(config)
+
+	err = updateApplicationGatewaySubResource(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			pools = *props.BackendAddressPools
+		}
+
+		exists := false
+		for i, existing := range pools {
+			if existing.Name == nil || !strings.EqualFold(*existing.Name, id.Name) {
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", id.ID())
+			}
+
+			pools[i] = *pool
+			exists = true
+		}
+
+		if !exists {
+			pools = append(pools, *pool)
+		}
+
+		props.BackendAddressPools = &pools
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var pool *network.ApplicationGatewayBackendAddressPool
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, existing := range *props.BackendAddressPools {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+				existing := existing
+				pool = &existing
+				break
+			}
+		}
+	}
+	if pool == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	output := flattenApplicationGatewayBackendAddressPool(*pool)
+
+	return flattenApplicationGatewaySubResource(d, output)
+}
+
+func resourceApplicationGatewayBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewaySubResource(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			for _, existing := range *props.BackendAddressPools {
+				if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+					continue
+				}
+				pools = append(pools, existing)
+			}
+		}
+
+		props.BackendAddressPools = &pools
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayBackendHTTPSettingsSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewayBackendHTTPSettingsSchema())
+	setting, err := expandApplicationGatewayBackendHTTPSetting(config, gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding Backend HTTP Settings: %+v", err)
+	}
+
+	err = updateApplicationGatewaySubResource(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		if props.BackendHTTPSettingsCollection != nil {
+			settings = *props.BackendHTTPSettingsCollection
+		}
+
+		exists := false
+		for i, existing := range settings {
+			if existing.Name == nil || !strings.EqualFold(*existing.Name, id.BackendHttpSettingsCollectionName) {
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+			}
+
+			settings[i] = *setting
+			exists = true
+		}
+
+		if !exists {
+			settings = append(settings, *setting)
+		}
+
+		props.BackendHTTPSettingsCollection = &settings
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var setting *network.ApplicationGatewayBackendHTTPSettings
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, existing := range *props.BackendHTTPSettingsCollection {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.BackendHttpSettingsCollectionName) {
+				existing := existing
+				setting = &existing
+				break
+			}
+		}
+	}
+	if setting == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	output, err := flattenApplicationGatewayBackendHTTPSetting(*setting)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return flattenApplicationGatewaySubResource(d, output)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewaySubResource(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		settings := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		if props.BackendHTTPSettingsCollection != nil {
+			for _, existing := range *props.BackendHTTPSettingsCollection {
+				if existing.Name != nil && strings.EqualFold(*existing.Name, id.BackendHttpSettingsCollectionName) {
+					continue
+				}
+				settings = append(settings, existing)
+			}
+		}
+
+		props.BackendHTTPSettingsCollection = &settings
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
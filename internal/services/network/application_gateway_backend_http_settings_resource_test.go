@@ -0,0 +1,153 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type ApplicationGatewayBackendHTTPSettingsResource struct {
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("port").HasValue("8081"),
+				check.That(data.ResourceName).Key("connection_draining.0.drain_timeout_sec").HasValue("120"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendHttpSettingsCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.BackendHttpSettingsCollectionName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+  request_timeout        = 30
+}
+`, ApplicationGatewayResource{}.externallyManaged(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "import" {
+  name                   = azurerm_application_gateway_backend_http_settings.test.name
+  application_gateway_id = azurerm_application_gateway_backend_http_settings.test.application_gateway_id
+  cookie_based_affinity  = azurerm_application_gateway_backend_http_settings.test.cookie_based_affinity
+  port                   = azurerm_application_gateway_backend_http_settings.test.port
+  protocol               = azurerm_application_gateway_backend_http_settings.test.protocol
+  request_timeout        = azurerm_application_gateway_backend_http_settings.test.request_timeout
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayBackendHTTPSettingsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Enabled"
+  affinity_cookie_name   = "acctest"
+  path                   = "/app/"
+  port                   = 8081
+  protocol               = "Http"
+  request_timeout        = 60
+
+  connection_draining {
+    enabled           = true
+    drain_timeout_sec = 120
+  }
+}
+`, ApplicationGatewayResource{}.externallyManaged(data), data.RandomInteger)
+}
@@ -0,0 +1,171 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HttpListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayHTTPListenerSchema()),
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHttpListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewayHTTPListenerSchema())
+	listener, err := expandApplicationGatewayHTTPListener(config, gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding HTTP Listener: %+v", err)
+	}
+
+	err = updateApplicationGatewaySubResource(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+		if props.HTTPListeners != nil {
+			listeners = *props.HTTPListeners
+		}
+
+		exists := false
+		for i, existing := range listeners {
+			if existing.Name == nil || !strings.EqualFold(*existing.Name, id.Name) {
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+			}
+
+			listeners[i] = *listener
+			exists = true
+		}
+
+		if !exists {
+			listeners = append(listeners, *listener)
+		}
+
+		props.HTTPListeners = &listeners
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var listener *network.ApplicationGatewayHTTPListener
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, existing := range *props.HTTPListeners {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+				existing := existing
+				listener = &existing
+				break
+			}
+		}
+	}
+	if listener == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	output, err := flattenApplicationGatewayHTTPListener(*listener)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return flattenApplicationGatewaySubResource(d, output)
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewaySubResource(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+		if props.HTTPListeners != nil {
+			for _, existing := range *props.HTTPListeners {
+				if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+					continue
+				}
+				listeners = append(listeners, existing)
+			}
+		}
+
+		props.HTTPListeners = &listeners
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreateUpdate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeCreateUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayProbeSchema()),
+	}
+}
+
+func resourceApplicationGatewayProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewayProbeSchema())
+	probe, err := expandApplicationGatewayProbe(config)
+	if err != nil {
+		return fmt.Errorf("expanding Probe: %+v", err)
+	}
+
+	err = updateApplicationGatewaySubResource(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			probes = *props.Probes
+		}
+
+		exists := false
+		for i, existing := range probes {
+			if existing.Name == nil || !strings.EqualFold(*existing.Name, id.Name) {
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+			}
+
+			probes[i] = *probe
+			exists = true
+		}
+
+		if !exists {
+			probes = append(probes, *probe)
+		}
+
+		props.Probes = &probes
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var probe *network.ApplicationGatewayProbe
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, existing := range *props.Probes {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+				existing := existing
+				probe = &existing
+				break
+			}
+		}
+	}
+	if probe == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	output := flattenApplicationGatewayProbe(*probe)
+
+	return flattenApplicationGatewaySubResource(d, output)
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewaySubResource(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			for _, existing := range *props.Probes {
+				if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+					continue
+				}
+				probes = append(probes, existing)
+			}
+		}
+
+		props.Probes = &probes
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
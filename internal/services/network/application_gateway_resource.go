@@ -12,7 +12,7 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
-	keyVaultValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/keyvault/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
 	msiParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/msi/parse"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
 	networkValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
@@ -167,36 +167,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 			},
 
@@ -205,128 +176,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"cookie_based_affinity": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayCookieBasedAffinityEnabled),
-								string(network.ApplicationGatewayCookieBasedAffinityDisabled),
-							}, true),
-						},
-
-						"affinity_cookie_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"pick_host_name_from_backend_address": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"request_timeout": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 86400),
-						},
-
-						"authentication_certificate": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"trusted_root_certificate_names": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"connection_draining": {
-							Type:     pluginsdk.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"drain_timeout_sec": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntBetween(1, 3600),
-									},
-								},
-							},
-						},
-
-						"probe_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"probe_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendHTTPSettingsSchema(),
 				},
 			},
 
@@ -431,118 +281,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_profile_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-										}, false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
-						},
-
-						"ssl_profile_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 			},
 
@@ -551,94 +290,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
-							}, false),
-						},
-
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 20000),
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
 				},
 			},
 
@@ -794,126 +446,23 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"data": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-							Sensitive:    true,
-						},
-
-						// TODO required soft delete on the keyvault
-						/*"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateKeyVaultChildId,
-						},*/
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
-
-			//lintignore:XS003
-			"ssl_policy": sslProfileSchema(true),
-
-			"enable_http2": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-			},
-
-			"probe": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"host": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"timeout": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"unhealthy_threshold": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"pick_host_name_from_backend_http_settings": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"minimum_servers": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  0,
+						"data": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Sensitive:    true,
 						},
 
-						//lintignore:XS003
-						"match": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Computed: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"body": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"status_code": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
-							},
-						},
+						// TODO required soft delete on the keyvault
+						/*"key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},*/
 
 						"id": {
 							Type:     pluginsdk.TypeString,
@@ -923,6 +472,22 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				},
 			},
 
+			//lintignore:XS003
+			"ssl_policy": sslProfileSchema(true),
+
+			"enable_http2": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"probe": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: applicationGatewayProbeSchema(),
+				},
+			},
+
 			"rewrite_rule_set": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1051,41 +616,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"data": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-							StateFunc: base64EncodedStateFunc,
-						},
-
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"public_cert_data": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewaySslCertificateSchema(),
 				},
 			},
 
@@ -1154,133 +685,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"default_backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"default_backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"default_redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"default_rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"path_rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"paths": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-
-									"backend_address_pool_name": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"backend_http_settings_name": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"redirect_configuration_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"rewrite_rule_set_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"backend_address_pool_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-
-									"backend_http_settings_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-
-									"redirect_configuration_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-
-									"rewrite_rule_set_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-
-									"firewall_policy_id": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
-									},
-								},
-							},
-						},
-
-						"default_backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"default_backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"default_redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"default_rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayURLPathMapSchema(),
 				},
 			},
 
@@ -1443,6 +848,10 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	id := parse.NewApplicationGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1502,6 +911,16 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("expanding `rewrite_rule_set`: %v", err)
 	}
 
+	backendHTTPSettings, err := expandApplicationGatewayBackendHTTPSettings(d, id.ID())
+	if err != nil {
+		return fmt.Errorf("expanding `backend_http_settings`: %+v", err)
+	}
+
+	probes, err := expandApplicationGatewayProbes(d)
+	if err != nil {
+		return fmt.Errorf("expanding `probe`: %+v", err)
+	}
+
 	gateway := network.ApplicationGateway{
 		Location: utils.String(location),
 		Zones:    azure.ExpandZones(d.Get("zones").([]interface{})),
@@ -1513,13 +932,13 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
 			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
-			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
+			BackendHTTPSettingsCollection: backendHTTPSettings,
 			EnableHTTP2:                   utils.Bool(enablehttp2),
 			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
-			Probes:                        expandApplicationGatewayProbes(d),
+			Probes:                        probes,
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
 			Sku:                           expandApplicationGatewaySku(d),
@@ -1537,35 +956,6 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		gateway.Identity = expandAzureRmApplicationGatewayIdentity(d)
 	}
 
-	// validation (todo these should probably be moved into their respective expand functions, which would then return an error?)
-	for _, backendHttpSettings := range *gateway.ApplicationGatewayPropertiesFormat.BackendHTTPSettingsCollection {
-		if props := backendHttpSettings.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
-			if props.HostName == nil || props.PickHostNameFromBackendAddress == nil {
-				continue
-			}
-
-			if *props.HostName != "" && *props.PickHostNameFromBackendAddress {
-				return fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set")
-			}
-		}
-	}
-
-	for _, probe := range *gateway.ApplicationGatewayPropertiesFormat.Probes {
-		if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
-			if props.Host == nil || props.PickHostNameFromBackendHTTPSettings == nil {
-				continue
-			}
-
-			if *props.Host == "" && !*props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
-			}
-
-			if *props.Host != "" && *props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
-			}
-		}
-	}
-
 	if _, ok := d.GetOk("waf_configuration"); ok {
 		gateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
 	}
@@ -1785,6 +1175,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -1981,32 +1374,33 @@ func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]n
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-		backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+		results = append(results, *expandApplicationGatewayBackendAddressPool(raw.(map[string]interface{})))
+	}
 
-		for _, ip := range v["fqdns"].([]interface{}) {
-			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-				Fqdn: utils.String(ip.(string)),
-			})
-		}
-		for _, ip := range v["ip_addresses"].([]interface{}) {
-			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-				IPAddress: utils.String(ip.(string)),
-			})
-		}
+	return &results
+}
 
-		name := v["name"].(string)
-		output := network.ApplicationGatewayBackendAddressPool{
-			Name: utils.String(name),
-			ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
-				BackendAddresses: &backendAddresses,
-			},
-		}
+func expandApplicationGatewayBackendAddressPool(v map[string]interface{}) *network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
 
-		results = append(results, output)
+	for _, ip := range v["fqdns"].([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(ip.(string)),
+		})
+	}
+	for _, ip := range v["ip_addresses"].([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(ip.(string)),
+		})
 	}
 
-	return &results
+	name := v["name"].(string)
+	return &network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
 }
 
 func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
@@ -2016,125 +1410,139 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		ipAddressList := make([]interface{}, 0)
-		fqdnList := make([]interface{}, 0)
-
-		if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
-			if props.BackendAddresses != nil {
-				for _, address := range *props.BackendAddresses {
-					if address.IPAddress != nil {
-						ipAddressList = append(ipAddressList, *address.IPAddress)
-					} else if address.Fqdn != nil {
-						fqdnList = append(fqdnList, *address.Fqdn)
-					}
+		results = append(results, flattenApplicationGatewayBackendAddressPool(config))
+	}
+
+	return results
+}
+
+func flattenApplicationGatewayBackendAddressPool(config network.ApplicationGatewayBackendAddressPool) map[string]interface{} {
+	ipAddressList := make([]interface{}, 0)
+	fqdnList := make([]interface{}, 0)
+
+	if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
+		if props.BackendAddresses != nil {
+			for _, address := range *props.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				} else if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
 				}
 			}
 		}
+	}
 
-		output := map[string]interface{}{
-			"fqdns":        fqdnList,
-			"ip_addresses": ipAddressList,
-		}
-
-		if config.ID != nil {
-			output["id"] = *config.ID
-		}
+	output := map[string]interface{}{
+		"fqdns":        fqdnList,
+		"ip_addresses": ipAddressList,
+	}
 
-		if config.Name != nil {
-			output["name"] = *config.Name
-		}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-		results = append(results, output)
+	if config.Name != nil {
+		output["name"] = *config.Name
 	}
 
-	return results
+	return output
 }
 
-func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
+func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayBackendHTTPSettings, error) {
 	results := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
 	vs := d.Get("backend_http_settings").([]interface{})
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		path := v["path"].(string)
-		port := int32(v["port"].(int))
-		protocol := v["protocol"].(string)
-		cookieBasedAffinity := v["cookie_based_affinity"].(string)
-		pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
-		requestTimeout := int32(v["request_timeout"].(int))
-
-		setting := network.ApplicationGatewayBackendHTTPSettings{
-			Name: &name,
-			ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
-				CookieBasedAffinity:            network.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity),
-				Path:                           utils.String(path),
-				PickHostNameFromBackendAddress: utils.Bool(pickHostNameFromBackendAddress),
-				Port:                           utils.Int32(port),
-				Protocol:                       network.ApplicationGatewayProtocol(protocol),
-				RequestTimeout:                 utils.Int32(requestTimeout),
-				ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
-			},
+		setting, err := expandApplicationGatewayBackendHTTPSetting(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
 		}
+		results = append(results, *setting)
+	}
 
-		hostName := v["host_name"].(string)
-		if hostName != "" {
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.HostName = utils.String(hostName)
-		}
+	return &results, nil
+}
 
-		affinityCookieName := v["affinity_cookie_name"].(string)
-		if affinityCookieName != "" {
-			setting.AffinityCookieName = utils.String(affinityCookieName)
+func expandApplicationGatewayBackendHTTPSetting(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayBackendHTTPSettings, error) {
+	name := v["name"].(string)
+	path := v["path"].(string)
+	port := int32(v["port"].(int))
+	protocol := v["protocol"].(string)
+	cookieBasedAffinity := v["cookie_based_affinity"].(string)
+	pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
+	requestTimeout := int32(v["request_timeout"].(int))
+
+	setting := network.ApplicationGatewayBackendHTTPSettings{
+		Name: &name,
+		ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			CookieBasedAffinity:            network.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity),
+			Path:                           utils.String(path),
+			PickHostNameFromBackendAddress: utils.Bool(pickHostNameFromBackendAddress),
+			Port:                           utils.Int32(port),
+			Protocol:                       network.ApplicationGatewayProtocol(protocol),
+			RequestTimeout:                 utils.Int32(requestTimeout),
+			ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
+		},
+	}
+
+	hostName := v["host_name"].(string)
+	if hostName != "" {
+		if pickHostNameFromBackendAddress {
+			return nil, fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set")
 		}
 
-		if v["authentication_certificate"] != nil {
-			authCerts := v["authentication_certificate"].([]interface{})
-			authCertSubResources := make([]network.SubResource, 0)
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.HostName = utils.String(hostName)
+	}
 
-			for _, rawAuthCert := range authCerts {
-				authCert := rawAuthCert.(map[string]interface{})
-				authCertName := authCert["name"].(string)
-				authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
-				authCertSubResource := network.SubResource{
-					ID: utils.String(authCertID),
-				}
+	affinityCookieName := v["affinity_cookie_name"].(string)
+	if affinityCookieName != "" {
+		setting.AffinityCookieName = utils.String(affinityCookieName)
+	}
+
+	if v["authentication_certificate"] != nil {
+		authCerts := v["authentication_certificate"].([]interface{})
+		authCertSubResources := make([]network.SubResource, 0)
 
-				authCertSubResources = append(authCertSubResources, authCertSubResource)
+		for _, rawAuthCert := range authCerts {
+			authCert := rawAuthCert.(map[string]interface{})
+			authCertName := authCert["name"].(string)
+			authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
+			authCertSubResource := network.SubResource{
+				ID: utils.String(authCertID),
 			}
 
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+			authCertSubResources = append(authCertSubResources, authCertSubResource)
 		}
 
-		if v["trusted_root_certificate_names"] != nil {
-			trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
-			trustedRootCertSubResources := make([]network.SubResource, 0)
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+	}
 
-			for _, rawTrustedRootCertName := range trustedRootCertNames {
-				trustedRootCertName := rawTrustedRootCertName.(string)
-				trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
-				trustedRootCertSubResource := network.SubResource{
-					ID: utils.String(trustedRootCertID),
-				}
+	if v["trusted_root_certificate_names"] != nil {
+		trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
+		trustedRootCertSubResources := make([]network.SubResource, 0)
 
-				trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
+		for _, rawTrustedRootCertName := range trustedRootCertNames {
+			trustedRootCertName := rawTrustedRootCertName.(string)
+			trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
+			trustedRootCertSubResource := network.SubResource{
+				ID: utils.String(trustedRootCertID),
 			}
 
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertSubResources
+			trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
 		}
 
-		probeName := v["probe_name"].(string)
-		if probeName != "" {
-			probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.Probe = &network.SubResource{
-				ID: utils.String(probeID),
-			}
-		}
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertSubResources
+	}
 
-		results = append(results, setting)
+	probeName := v["probe_name"].(string)
+	if probeName != "" {
+		probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
+		setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.Probe = &network.SubResource{
+			ID: utils.String(probeID),
+		}
 	}
 
-	return &results
+	return &setting, nil
 }
 
 func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGatewayBackendHTTPSettings) ([]interface{}, error) {
@@ -2144,101 +1552,109 @@ func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGa
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayBackendHTTPSetting(v)
+		if err != nil {
+			return nil, err
 		}
+		results = append(results, output)
+	}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+	return results, nil
+}
 
-		if props := v.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
-			output["cookie_based_affinity"] = string(props.CookieBasedAffinity)
+func flattenApplicationGatewayBackendHTTPSetting(v network.ApplicationGatewayBackendHTTPSettings) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
 
-			if affinityCookieName := props.AffinityCookieName; affinityCookieName != nil {
-				output["affinity_cookie_name"] = affinityCookieName
-			}
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-			if path := props.Path; path != nil {
-				output["path"] = *path
-			}
-			output["connection_draining"] = flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if port := props.Port; port != nil {
-				output["port"] = int(*port)
-			}
+	if props := v.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
+		output["cookie_based_affinity"] = string(props.CookieBasedAffinity)
 
-			if hostName := props.HostName; hostName != nil {
-				output["host_name"] = *hostName
-			}
+		if affinityCookieName := props.AffinityCookieName; affinityCookieName != nil {
+			output["affinity_cookie_name"] = affinityCookieName
+		}
 
-			if pickHostNameFromBackendAddress := props.PickHostNameFromBackendAddress; pickHostNameFromBackendAddress != nil {
-				output["pick_host_name_from_backend_address"] = *pickHostNameFromBackendAddress
-			}
+		if path := props.Path; path != nil {
+			output["path"] = *path
+		}
+		output["connection_draining"] = flattenApplicationGatewayConnectionDraining(props.ConnectionDraining)
 
-			output["protocol"] = string(props.Protocol)
+		if port := props.Port; port != nil {
+			output["port"] = int(*port)
+		}
 
-			if timeout := props.RequestTimeout; timeout != nil {
-				output["request_timeout"] = int(*timeout)
-			}
+		if hostName := props.HostName; hostName != nil {
+			output["host_name"] = *hostName
+		}
 
-			authenticationCertificates := make([]interface{}, 0)
-			if certs := props.AuthenticationCertificates; certs != nil {
-				for _, cert := range *certs {
-					if cert.ID == nil {
-						continue
-					}
+		if pickHostNameFromBackendAddress := props.PickHostNameFromBackendAddress; pickHostNameFromBackendAddress != nil {
+			output["pick_host_name_from_backend_address"] = *pickHostNameFromBackendAddress
+		}
 
-					certId, err := parse.AuthenticationCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		output["protocol"] = string(props.Protocol)
 
-					certificate := map[string]interface{}{
-						"id":   certId.ID(),
-						"name": certId.Name,
-					}
-					authenticationCertificates = append(authenticationCertificates, certificate)
-				}
-			}
-			output["authentication_certificate"] = authenticationCertificates
+		if timeout := props.RequestTimeout; timeout != nil {
+			output["request_timeout"] = int(*timeout)
+		}
 
-			trustedRootCertificateNames := make([]interface{}, 0)
-			if certs := props.TrustedRootCertificates; certs != nil {
-				for _, cert := range *certs {
-					if cert.ID == nil {
-						continue
-					}
+		authenticationCertificates := make([]interface{}, 0)
+		if certs := props.AuthenticationCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
 
-					certId, err := parse.TrustedRootCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+				certId, err := parse.AuthenticationCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
+				}
 
-					trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Name)
+				certificate := map[string]interface{}{
+					"id":   certId.ID(),
+					"name": certId.Name,
 				}
+				authenticationCertificates = append(authenticationCertificates, certificate)
 			}
-			output["trusted_root_certificate_names"] = trustedRootCertificateNames
+		}
+		output["authentication_certificate"] = authenticationCertificates
 
-			if probe := props.Probe; probe != nil {
-				if probe.ID != nil {
-					id, err := parse.ProbeID(*probe.ID)
-					if err != nil {
-						return results, err
-					}
+		trustedRootCertificateNames := make([]interface{}, 0)
+		if certs := props.TrustedRootCertificates; certs != nil {
+			for _, cert := range *certs {
+				if cert.ID == nil {
+					continue
+				}
 
-					output["probe_name"] = id.Name
-					output["probe_id"] = id.ID()
+				certId, err := parse.TrustedRootCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
+
+				trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Name)
 			}
 		}
+		output["trusted_root_certificate_names"] = trustedRootCertificateNames
 
-		results = append(results, output)
+		if probe := props.Probe; probe != nil {
+			if probe.ID != nil {
+				id, err := parse.ProbeID(*probe.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				output["probe_name"] = id.Name
+				output["probe_id"] = id.ID()
+			}
+		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayConnectionDraining(d map[string]interface{}) *network.ApplicationGatewayConnectionDraining {
@@ -2352,75 +1768,81 @@ func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
-		sslProfileName := v["ssl_profile_name"].(string)
-
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+		listener, err := expandApplicationGatewayHTTPListener(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *listener)
+	}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+	return &results, nil
+}
 
-		listener := network.ApplicationGatewayHTTPListener{
-			Name: utils.String(name),
-			ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &network.SubResource{
-					ID: utils.String(frontendIPConfigID),
-				},
-				FrontendPort: &network.SubResource{
-					ID: utils.String(frontendPortID),
-				},
-				Protocol:                    network.ApplicationGatewayProtocol(protocol),
-				RequireServerNameIndication: utils.Bool(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayHTTPListener, error) {
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+	sslProfileName := v["ssl_profile_name"].(string)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
 			},
-		}
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(protocol),
+			RequireServerNameIndication: utils.Bool(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
-		}
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
 
-		if host != "" {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
-		}
+	if host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+	}
 
-		if len(hosts) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
-		}
+	if len(hosts) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+	}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
-				ID: utils.String(certID),
-			}
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
 		}
+	}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
-				ID: utils.String(firewallPolicyID),
-			}
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
 		}
+	}
 
-		if sslProfileName != "" && len(sslProfileName) > 0 {
-			sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
-				ID: utils.String(sslProfileID),
-			}
+	if sslProfileName != "" && len(sslProfileName) > 0 {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
+			ID: utils.String(sslProfileID),
 		}
-
-		results = append(results, listener)
 	}
 
-	return &results, nil
+	return &listener, nil
 }
 
 func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) ([]interface{}, error) {
@@ -2430,88 +1852,96 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayHTTPListener(v)
+		if err != nil {
+			return nil, err
 		}
+		results = append(results, output)
+	}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+	return results, nil
+}
 
-		if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
-			if port := props.FrontendPort; port != nil {
-				if port.ID != nil {
-					portId, err := parse.FrontendPortID(*port.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_port_name"] = portId.Name
-					output["frontend_port_id"] = portId.ID()
-				}
-			}
+func flattenApplicationGatewayHTTPListener(v network.ApplicationGatewayHTTPListener) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
 
-			if feConfig := props.FrontendIPConfiguration; feConfig != nil {
-				if feConfig.ID != nil {
-					feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_ip_configuration_name"] = feConfigId.Name
-					output["frontend_ip_configuration_id"] = feConfigId.ID()
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		if port := props.FrontendPort; port != nil {
+			if port.ID != nil {
+				portId, err := parse.FrontendPortID(*port.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["frontend_port_name"] = portId.Name
+				output["frontend_port_id"] = portId.ID()
 			}
+		}
 
-			if hostname := props.HostName; hostname != nil {
-				output["host_name"] = *hostname
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil {
+			if feConfig.ID != nil {
+				feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["frontend_ip_configuration_name"] = feConfigId.Name
+				output["frontend_ip_configuration_id"] = feConfigId.ID()
 			}
+		}
 
-			if hostnames := props.HostNames; hostnames != nil {
-				output["host_names"] = utils.FlattenStringSlice(hostnames)
-			}
+		if hostname := props.HostName; hostname != nil {
+			output["host_name"] = *hostname
+		}
 
-			output["protocol"] = string(props.Protocol)
+		if hostnames := props.HostNames; hostnames != nil {
+			output["host_names"] = utils.FlattenStringSlice(hostnames)
+		}
 
-			if cert := props.SslCertificate; cert != nil {
-				if cert.ID != nil {
-					certId, err := parse.SslCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		output["protocol"] = string(props.Protocol)
 
-					output["ssl_certificate_name"] = certId.Name
-					output["ssl_certificate_id"] = certId.ID()
+		if cert := props.SslCertificate; cert != nil {
+			if cert.ID != nil {
+				certId, err := parse.SslCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			if sni := props.RequireServerNameIndication; sni != nil {
-				output["require_sni"] = *sni
+				output["ssl_certificate_name"] = certId.Name
+				output["ssl_certificate_id"] = certId.ID()
 			}
+		}
 
-			if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
-				output["firewall_policy_id"] = *fwp.ID
-			}
+		if sni := props.RequireServerNameIndication; sni != nil {
+			output["require_sni"] = *sni
+		}
 
-			if sslp := props.SslProfile; sslp != nil {
-				if sslp.ID != nil {
-					sslProfileId, err := parse.SslProfileID(*sslp.ID)
-					if err != nil {
-						return nil, err
-					}
+		if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
+			output["firewall_policy_id"] = *fwp.ID
+		}
 
-					output["ssl_profile_name"] = sslProfileId.Name
-					output["ssl_profile_id"] = sslProfileId.ID()
+		if sslp := props.SslProfile; sslp != nil {
+			if sslp.ID != nil {
+				sslProfileId, err := parse.SslProfileID(*sslp.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
+				output["ssl_profile_name"] = sslProfileId.Name
+				output["ssl_profile_id"] = sslProfileId.ID()
+			}
 		}
 
-		results = append(results, output)
+		output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayIPConfigurations(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayIPConfiguration, bool) {
@@ -2728,64 +2158,78 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 	return results
 }
 
-func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayProbe {
+func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayProbe, error) {
 	vs := d.Get("probe").([]interface{})
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		probe, err := expandApplicationGatewayProbe(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *probe)
+	}
 
-		host := v["host"].(string)
-		interval := int32(v["interval"].(int))
-		minServers := int32(v["minimum_servers"].(int))
-		name := v["name"].(string)
-		probePath := v["path"].(string)
-		protocol := v["protocol"].(string)
-		port := int32(v["port"].(int))
-		timeout := int32(v["timeout"].(int))
-		unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
-		pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+	return &results, nil
+}
 
-		output := network.ApplicationGatewayProbe{
-			Name: utils.String(name),
-			ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
-				Host:                                utils.String(host),
-				Interval:                            utils.Int32(interval),
-				MinServers:                          utils.Int32(minServers),
-				Path:                                utils.String(probePath),
-				Protocol:                            network.ApplicationGatewayProtocol(protocol),
-				Timeout:                             utils.Int32(timeout),
-				UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
-				PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
-			},
-		}
+func expandApplicationGatewayProbe(v map[string]interface{}) (*network.ApplicationGatewayProbe, error) {
+	host := v["host"].(string)
+	interval := int32(v["interval"].(int))
+	minServers := int32(v["minimum_servers"].(int))
+	name := v["name"].(string)
+	probePath := v["path"].(string)
+	protocol := v["protocol"].(string)
+	port := int32(v["port"].(int))
+	timeout := int32(v["timeout"].(int))
+	unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
+	pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+
+	if host == "" && !pickHostNameFromBackendHTTPSettings {
+		return nil, fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
+	}
+
+	if host != "" && pickHostNameFromBackendHTTPSettings {
+		return nil, fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+	}
+
+	output := network.ApplicationGatewayProbe{
+		Name: utils.String(name),
+		ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+			Host:                                utils.String(host),
+			Interval:                            utils.Int32(interval),
+			MinServers:                          utils.Int32(minServers),
+			Path:                                utils.String(probePath),
+			Protocol:                            network.ApplicationGatewayProtocol(protocol),
+			Timeout:                             utils.Int32(timeout),
+			UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
+			PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
+		},
+	}
 
-		matchConfigs := v["match"].([]interface{})
-		if len(matchConfigs) > 0 {
-			matchBody := ""
-			outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
-			if matchConfigs[0] != nil {
-				match := matchConfigs[0].(map[string]interface{})
-				matchBody = match["body"].(string)
+	matchConfigs := v["match"].([]interface{})
+	if len(matchConfigs) > 0 {
+		matchBody := ""
+		outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
+		if matchConfigs[0] != nil {
+			match := matchConfigs[0].(map[string]interface{})
+			matchBody = match["body"].(string)
 
-				statusCodes := make([]string, 0)
-				for _, statusCode := range match["status_code"].([]interface{}) {
-					statusCodes = append(statusCodes, statusCode.(string))
-				}
-				outputMatch.StatusCodes = &statusCodes
+			statusCodes := make([]string, 0)
+			for _, statusCode := range match["status_code"].([]interface{}) {
+				statusCodes = append(statusCodes, statusCode.(string))
 			}
-			outputMatch.Body = utils.String(matchBody)
-			output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
-		}
-
-		if port != 0 {
-			output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
+			outputMatch.StatusCodes = &statusCodes
 		}
+		outputMatch.Body = utils.String(matchBody)
+		output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
+	}
 
-		results = append(results, output)
+	if port != 0 {
+		output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
 	}
 
-	return &results
+	return &output, nil
 }
 
 func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) []interface{} {
@@ -2795,76 +2239,80 @@ func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) [
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
+		results = append(results, flattenApplicationGatewayProbe(v))
+	}
 
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
+	return results
+}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+func flattenApplicationGatewayProbe(v network.ApplicationGatewayProbe) map[string]interface{} {
+	output := map[string]interface{}{}
 
-		if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
-			output["protocol"] = string(props.Protocol)
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-			if host := props.Host; host != nil {
-				output["host"] = *host
-			}
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if path := props.Path; path != nil {
-				output["path"] = *path
-			}
+	if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
+		output["protocol"] = string(props.Protocol)
 
-			if interval := props.Interval; interval != nil {
-				output["interval"] = int(*interval)
-			}
+		if host := props.Host; host != nil {
+			output["host"] = *host
+		}
 
-			if timeout := props.Timeout; timeout != nil {
-				output["timeout"] = int(*timeout)
-			}
+		if path := props.Path; path != nil {
+			output["path"] = *path
+		}
 
-			if threshold := props.UnhealthyThreshold; threshold != nil {
-				output["unhealthy_threshold"] = int(*threshold)
-			}
+		if interval := props.Interval; interval != nil {
+			output["interval"] = int(*interval)
+		}
 
-			port := 0
-			if props.Port != nil {
-				port = int(*props.Port)
-			}
-			output["port"] = port
+		if timeout := props.Timeout; timeout != nil {
+			output["timeout"] = int(*timeout)
+		}
 
-			if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
-				output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
-			}
+		if threshold := props.UnhealthyThreshold; threshold != nil {
+			output["unhealthy_threshold"] = int(*threshold)
+		}
 
-			if minServers := props.MinServers; minServers != nil {
-				output["minimum_servers"] = int(*minServers)
-			}
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		output["port"] = port
 
-			matches := make([]interface{}, 0)
-			if match := props.Match; match != nil {
-				matchConfig := map[string]interface{}{}
-				if body := match.Body; body != nil {
-					matchConfig["body"] = *body
-				}
+		if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
+			output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
+		}
 
-				statusCodes := make([]interface{}, 0)
-				if match.StatusCodes != nil {
-					for _, status := range *match.StatusCodes {
-						statusCodes = append(statusCodes, status)
-					}
+		if minServers := props.MinServers; minServers != nil {
+			output["minimum_servers"] = int(*minServers)
+		}
+
+		matches := make([]interface{}, 0)
+		if match := props.Match; match != nil {
+			matchConfig := map[string]interface{}{}
+			if body := match.Body; body != nil {
+				matchConfig["body"] = *body
+			}
+
+			statusCodes := make([]interface{}, 0)
+			if match.StatusCodes != nil {
+				for _, status := range *match.StatusCodes {
+					statusCodes = append(statusCodes, status)
 				}
-				matchConfig["status_code"] = statusCodes
-				matches = append(matches, matchConfig)
 			}
-			output["match"] = matches
+			matchConfig["status_code"] = statusCodes
+			matches = append(matches, matchConfig)
 		}
-
-		results = append(results, output)
+		output["match"] = matches
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
@@ -2873,87 +2321,97 @@ func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gate
 	priorityset := false
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-		priority := int32(v["priority"].(int))
-
-		rule := network.ApplicationGatewayRequestRoutingRule{
-			Name: utils.String(name),
-			ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
-				HTTPListener: &network.SubResource{
-					ID: utils.String(httpListenerID),
-				},
-			},
+		rule, err := expandApplicationGatewayRequestRoutingRule(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
 		}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+		if rule.Priority != nil {
+			priorityset = true
 		}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+		results = append(results, *rule)
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-				ID: utils.String(backendAddressPoolID),
+	if priorityset {
+		for _, rule := range results {
+			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
+				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
 			}
 		}
+	}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-				ID: utils.String(backendHTTPSettingsID),
-			}
-		}
+	return &results, nil
+}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-				ID: utils.String(redirectConfigID),
-			}
-		}
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+	priority := int32(v["priority"].(int))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
-				ID: utils.String(urlPathMapID),
-			}
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
+
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-				ID: utils.String(rewriteRuleSetID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
 		}
+	}
 
-		if priority != 0 {
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
-			priorityset = true
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(redirectConfigID),
 		}
+	}
 
-		results = append(results, rule)
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
 	}
 
-	if priorityset {
-		for _, rule := range results {
-			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
-				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(rewriteRuleSetID),
 		}
 	}
 
-	return &results, nil
+	if priority != 0 {
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
+	}
+
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -2963,95 +2421,106 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
-			output := map[string]interface{}{
-				"rule_type": string(props.RuleType),
-			}
+		if config.ApplicationGatewayRequestRoutingRulePropertiesFormat == nil {
+			continue
+		}
 
-			if config.ID != nil {
-				output["id"] = *config.ID
-			}
+		output, err := flattenApplicationGatewayRequestRoutingRule(config)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, output)
+	}
 
-			if config.Name != nil {
-				output["name"] = *config.Name
-			}
+	return results, nil
+}
 
-			if config.Priority != nil {
-				output["priority"] = *config.Priority
-			}
+func flattenApplicationGatewayRequestRoutingRule(config network.ApplicationGatewayRequestRoutingRule) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-			if pool := props.BackendAddressPool; pool != nil {
-				if pool.ID != nil {
-					poolId, err := parse.BackendAddressPoolID(*pool.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["backend_address_pool_name"] = poolId.Name
-					output["backend_address_pool_id"] = poolId.ID()
-				}
-			}
+	if config.Name != nil {
+		output["name"] = *config.Name
+	}
 
-			if settings := props.BackendHTTPSettings; settings != nil {
-				if settings.ID != nil {
-					settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
-					if err != nil {
-						return nil, err
-					}
+	if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+		output["rule_type"] = string(props.RuleType)
 
-					output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
-					output["backend_http_settings_id"] = *settings.ID
+		if config.Priority != nil {
+			output["priority"] = *config.Priority
+		}
+
+		if pool := props.BackendAddressPool; pool != nil {
+			if pool.ID != nil {
+				poolId, err := parse.BackendAddressPoolID(*pool.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["backend_address_pool_name"] = poolId.Name
+				output["backend_address_pool_id"] = poolId.ID()
 			}
+		}
 
-			if listener := props.HTTPListener; listener != nil {
-				if listener.ID != nil {
-					listenerId, err := parse.HttpListenerID(*listener.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["http_listener_id"] = listenerId.ID()
-					output["http_listener_name"] = listenerId.Name
+		if settings := props.BackendHTTPSettings; settings != nil {
+			if settings.ID != nil {
+				settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
+				if err != nil {
+					return nil, err
 				}
+
+				output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
+				output["backend_http_settings_id"] = *settings.ID
 			}
+		}
 
-			if pathMap := props.URLPathMap; pathMap != nil {
-				if pathMap.ID != nil {
-					pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["url_path_map_name"] = pathMapId.Name
-					output["url_path_map_id"] = pathMapId.ID()
+		if listener := props.HTTPListener; listener != nil {
+			if listener.ID != nil {
+				listenerId, err := parse.HttpListenerID(*listener.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["http_listener_id"] = listenerId.ID()
+				output["http_listener_name"] = listenerId.Name
 			}
+		}
 
-			if redirect := props.RedirectConfiguration; redirect != nil {
-				if redirect.ID != nil {
-					redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
-					output["redirect_configuration_id"] = redirectId.ID()
+		if pathMap := props.URLPathMap; pathMap != nil {
+			if pathMap.ID != nil {
+				pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["url_path_map_name"] = pathMapId.Name
+				output["url_path_map_id"] = pathMapId.ID()
 			}
+		}
 
-			if rewrite := props.RewriteRuleSet; rewrite != nil {
-				if rewrite.ID != nil {
-					rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["rewrite_rule_set_name"] = rewriteId.Name
-					output["rewrite_rule_set_id"] = rewriteId.ID()
+		if redirect := props.RedirectConfiguration; redirect != nil {
+			if redirect.ID != nil {
+				redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
+				output["redirect_configuration_id"] = redirectId.ID()
 			}
+		}
 
-			results = append(results, output)
+		if rewrite := props.RewriteRuleSet; rewrite != nil {
+			if rewrite.ID != nil {
+				rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["rewrite_rule_set_name"] = rewriteId.Name
+				output["rewrite_rule_set_id"] = rewriteId.ID()
+			}
 		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
@@ -3447,43 +2916,49 @@ func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]netw
 	results := make([]network.ApplicationGatewaySslCertificate, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		output, err := expandApplicationGatewaySslCertificate(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *output)
+	}
 
-		name := v["name"].(string)
-		data := v["data"].(string)
-		password := v["password"].(string)
-		kvsid := v["key_vault_secret_id"].(string)
-		cert := v["public_cert_data"].(string)
+	return &results, nil
+}
 
-		output := network.ApplicationGatewaySslCertificate{
-			Name: utils.String(name),
-			ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
-		}
+func expandApplicationGatewaySslCertificate(v map[string]interface{}) (*network.ApplicationGatewaySslCertificate, error) {
+	name := v["name"].(string)
+	data := v["data"].(string)
+	password := v["password"].(string)
+	kvsid := v["key_vault_secret_id"].(string)
+	cert := v["public_cert_data"].(string)
 
-		// nolint gocritic
-		if data != "" && kvsid != "" {
-			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
-		} else if data != "" {
-			// data must be base64 encoded
-			output.ApplicationGatewaySslCertificatePropertiesFormat.Data = utils.String(utils.Base64EncodeIfNot(data))
+	output := network.ApplicationGatewaySslCertificate{
+		Name: utils.String(name),
+		ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
+	}
 
-			output.ApplicationGatewaySslCertificatePropertiesFormat.Password = utils.String(password)
-		} else if kvsid != "" {
-			if password != "" {
-				return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
-			}
+	// nolint gocritic
+	if data != "" && kvsid != "" {
+		return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+	} else if data != "" {
+		// data must be base64 encoded
+		output.ApplicationGatewaySslCertificatePropertiesFormat.Data = utils.String(utils.Base64EncodeIfNot(data))
 
-			output.ApplicationGatewaySslCertificatePropertiesFormat.KeyVaultSecretID = utils.String(kvsid)
-		} else if cert != "" {
-			output.ApplicationGatewaySslCertificatePropertiesFormat.PublicCertData = utils.String(cert)
-		} else {
-			return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+		output.ApplicationGatewaySslCertificatePropertiesFormat.Password = utils.String(password)
+	} else if kvsid != "" {
+		if password != "" {
+			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
 		}
 
-		results = append(results, output)
+		output.ApplicationGatewaySslCertificatePropertiesFormat.KeyVaultSecretID = utils.String(kvsid)
+	} else if cert != "" {
+		output.ApplicationGatewaySslCertificatePropertiesFormat.PublicCertData = utils.String(cert)
+	} else {
+		return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
 	}
 
-	return &results, nil
+	return &output, nil
 }
 
 func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
@@ -3493,28 +2968,12 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
 		if v.Name == nil {
 			continue
 		}
 
 		name := *v.Name
-
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
-
-		output["name"] = name
-
-		if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
-			if data := props.PublicCertData; data != nil {
-				output["public_cert_data"] = *data
-			}
-
-			if kvsid := props.KeyVaultSecretID; kvsid != nil {
-				output["key_vault_secret_id"] = *kvsid
-			}
-		}
+		output := flattenApplicationGatewaySslCertificate(v)
 
 		// since the certificate data isn't returned we have to load it from the same index
 		if existing, ok := d.GetOk("ssl_certificate"); ok && existing != nil {
@@ -3542,6 +3001,32 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	return results
 }
 
+// flattenApplicationGatewaySslCertificate flattens the fields returned by the API - since the certificate data and
+// password aren't returned these have to be loaded from the existing configuration by the caller
+func flattenApplicationGatewaySslCertificate(v network.ApplicationGatewaySslCertificate) map[string]interface{} {
+	output := map[string]interface{}{}
+
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
+		if data := props.PublicCertData; data != nil {
+			output["public_cert_data"] = *data
+		}
+
+		if kvsid := props.KeyVaultSecretID; kvsid != nil {
+			output["key_vault_secret_id"] = *kvsid
+		}
+	}
+
+	return output
+}
+
 func expandApplicationGatewayTrustedClientCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayTrustedClientCertificate, error) {
 	vs := d.Get("trusted_client_certificate").([]interface{})
 	results := make([]network.ApplicationGatewayTrustedClientCertificate, 0)
@@ -3700,128 +3185,134 @@ func expandApplicationGatewayURLPathMaps(d *pluginsdk.ResourceData, gatewayID st
 	results := make([]network.ApplicationGatewayURLPathMap, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		output, err := expandApplicationGatewayURLPathMap(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *output)
+	}
 
-		name := v["name"].(string)
+	return &results, nil
+}
 
-		pathRules := make([]network.ApplicationGatewayPathRule, 0)
-		for _, ruleConfig := range v["path_rule"].([]interface{}) {
-			ruleConfigMap := ruleConfig.(map[string]interface{})
+func expandApplicationGatewayURLPathMap(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayURLPathMap, error) {
+	name := v["name"].(string)
 
-			ruleName := ruleConfigMap["name"].(string)
-			backendAddressPoolName := ruleConfigMap["backend_address_pool_name"].(string)
-			backendHTTPSettingsName := ruleConfigMap["backend_http_settings_name"].(string)
-			redirectConfigurationName := ruleConfigMap["redirect_configuration_name"].(string)
-			firewallPolicyID := ruleConfigMap["firewall_policy_id"].(string)
+	pathRules := make([]network.ApplicationGatewayPathRule, 0)
+	for _, ruleConfig := range v["path_rule"].([]interface{}) {
+		ruleConfigMap := ruleConfig.(map[string]interface{})
 
-			rulePaths := make([]string, 0)
-			for _, rulePath := range ruleConfigMap["paths"].([]interface{}) {
-				rulePaths = append(rulePaths, rulePath.(string))
-			}
+		ruleName := ruleConfigMap["name"].(string)
+		backendAddressPoolName := ruleConfigMap["backend_address_pool_name"].(string)
+		backendHTTPSettingsName := ruleConfigMap["backend_http_settings_name"].(string)
+		redirectConfigurationName := ruleConfigMap["redirect_configuration_name"].(string)
+		firewallPolicyID := ruleConfigMap["firewall_policy_id"].(string)
 
-			rule := network.ApplicationGatewayPathRule{
-				Name: utils.String(ruleName),
-				ApplicationGatewayPathRulePropertiesFormat: &network.ApplicationGatewayPathRulePropertiesFormat{
-					Paths: &rulePaths,
-				},
-			}
+		rulePaths := make([]string, 0)
+		for _, rulePath := range ruleConfigMap["paths"].([]interface{}) {
+			rulePaths = append(rulePaths, rulePath.(string))
+		}
 
-			if backendAddressPoolName != "" && redirectConfigurationName != "" {
-				return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
-			}
+		rule := network.ApplicationGatewayPathRule{
+			Name: utils.String(ruleName),
+			ApplicationGatewayPathRulePropertiesFormat: &network.ApplicationGatewayPathRulePropertiesFormat{
+				Paths: &rulePaths,
+			},
+		}
 
-			if backendHTTPSettingsName != "" && redirectConfigurationName != "" {
-				return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-			}
+		if backendAddressPoolName != "" && redirectConfigurationName != "" {
+			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+		}
 
-			if backendAddressPoolName != "" {
-				backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-				rule.ApplicationGatewayPathRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-					ID: utils.String(backendAddressPoolID),
-				}
-			}
+		if backendHTTPSettingsName != "" && redirectConfigurationName != "" {
+			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+		}
 
-			if backendHTTPSettingsName != "" {
-				backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-				rule.ApplicationGatewayPathRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-					ID: utils.String(backendHTTPSettingsID),
-				}
+		if backendAddressPoolName != "" {
+			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+			rule.ApplicationGatewayPathRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+				ID: utils.String(backendAddressPoolID),
 			}
+		}
 
-			if redirectConfigurationName != "" {
-				redirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigurationName)
-				rule.ApplicationGatewayPathRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-					ID: utils.String(redirectConfigurationID),
-				}
+		if backendHTTPSettingsName != "" {
+			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+			rule.ApplicationGatewayPathRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+				ID: utils.String(backendHTTPSettingsID),
 			}
+		}
 
-			if rewriteRuleSetName := ruleConfigMap["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-				rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-				rule.ApplicationGatewayPathRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-					ID: utils.String(rewriteRuleSetID),
-				}
+		if redirectConfigurationName != "" {
+			redirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigurationName)
+			rule.ApplicationGatewayPathRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+				ID: utils.String(redirectConfigurationID),
 			}
+		}
 
-			if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-				rule.ApplicationGatewayPathRulePropertiesFormat.FirewallPolicy = &network.SubResource{
-					ID: utils.String(firewallPolicyID),
-				}
+		if rewriteRuleSetName := ruleConfigMap["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+			rule.ApplicationGatewayPathRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+				ID: utils.String(rewriteRuleSetID),
 			}
-
-			pathRules = append(pathRules, rule)
 		}
 
-		output := network.ApplicationGatewayURLPathMap{
-			Name: utils.String(name),
-			ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
-				PathRules: &pathRules,
-			},
+		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+			rule.ApplicationGatewayPathRulePropertiesFormat.FirewallPolicy = &network.SubResource{
+				ID: utils.String(firewallPolicyID),
+			}
 		}
 
-		defaultBackendAddressPoolName := v["default_backend_address_pool_name"].(string)
-		defaultBackendHTTPSettingsName := v["default_backend_http_settings_name"].(string)
-		defaultRedirectConfigurationName := v["default_redirect_configuration_name"].(string)
+		pathRules = append(pathRules, rule)
+	}
 
-		if defaultBackendAddressPoolName != "" && defaultRedirectConfigurationName != "" {
-			return nil, fmt.Errorf("Conflict between `default_backend_address_pool_name` and `default_redirect_configuration_name` (back-end pool not applicable when redirection specified)")
-		}
+	output := network.ApplicationGatewayURLPathMap{
+		Name: utils.String(name),
+		ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
+			PathRules: &pathRules,
+		},
+	}
 
-		if defaultBackendHTTPSettingsName != "" && defaultRedirectConfigurationName != "" {
-			return nil, fmt.Errorf("Conflict between `default_backend_http_settings_name` and `default_redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+	defaultBackendAddressPoolName := v["default_backend_address_pool_name"].(string)
+	defaultBackendHTTPSettingsName := v["default_backend_http_settings_name"].(string)
+	defaultRedirectConfigurationName := v["default_redirect_configuration_name"].(string)
 
-		if defaultBackendAddressPoolName != "" {
-			defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
-			output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendAddressPool = &network.SubResource{
-				ID: utils.String(defaultBackendAddressPoolID),
-			}
-		}
+	if defaultBackendAddressPoolName != "" && defaultRedirectConfigurationName != "" {
+		return nil, fmt.Errorf("Conflict between `default_backend_address_pool_name` and `default_redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
 
-		if defaultBackendHTTPSettingsName != "" {
-			defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
-			output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendHTTPSettings = &network.SubResource{
-				ID: utils.String(defaultBackendHTTPSettingsID),
-			}
+	if defaultBackendHTTPSettingsName != "" && defaultRedirectConfigurationName != "" {
+		return nil, fmt.Errorf("Conflict between `default_backend_http_settings_name` and `default_redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if defaultBackendAddressPoolName != "" {
+		defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
+		output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendAddressPool = &network.SubResource{
+			ID: utils.String(defaultBackendAddressPoolID),
 		}
+	}
 
-		if defaultRedirectConfigurationName != "" {
-			defaultRedirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, defaultRedirectConfigurationName)
-			output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRedirectConfiguration = &network.SubResource{
-				ID: utils.String(defaultRedirectConfigurationID),
-			}
+	if defaultBackendHTTPSettingsName != "" {
+		defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
+		output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendHTTPSettings = &network.SubResource{
+			ID: utils.String(defaultBackendHTTPSettingsID),
 		}
+	}
 
-		if defaultRewriteRuleSetName := v["default_rewrite_rule_set_name"].(string); defaultRewriteRuleSetName != "" {
-			defaultRewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, defaultRewriteRuleSetName)
-			output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRewriteRuleSet = &network.SubResource{
-				ID: utils.String(defaultRewriteRuleSetID),
-			}
+	if defaultRedirectConfigurationName != "" {
+		defaultRedirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, defaultRedirectConfigurationName)
+		output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRedirectConfiguration = &network.SubResource{
+			ID: utils.String(defaultRedirectConfigurationID),
 		}
+	}
 
-		results = append(results, output)
+	if defaultRewriteRuleSetName := v["default_rewrite_rule_set_name"].(string); defaultRewriteRuleSetName != "" {
+		defaultRewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, defaultRewriteRuleSetName)
+		output.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRewriteRuleSet = &network.SubResource{
+			ID: utils.String(defaultRewriteRuleSetID),
+		}
 	}
 
-	return &results, nil
+	return &output, nil
 }
 
 func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURLPathMap) ([]interface{}, error) {
@@ -3831,126 +3322,134 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayURLPathMap(v)
+		if err != nil {
+			return nil, err
 		}
+		results = append(results, output)
+	}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+	return results, nil
+}
 
-		if props := v.ApplicationGatewayURLPathMapPropertiesFormat; props != nil {
-			if backendPool := props.DefaultBackendAddressPool; backendPool != nil && backendPool.ID != nil {
-				poolId, err := parse.BackendAddressPoolID(*backendPool.ID)
-				if err != nil {
-					return nil, err
-				}
-				output["default_backend_address_pool_name"] = poolId.Name
-				output["default_backend_address_pool_id"] = poolId.ID()
+func flattenApplicationGatewayURLPathMap(v network.ApplicationGatewayURLPathMap) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
+
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewayURLPathMapPropertiesFormat; props != nil {
+		if backendPool := props.DefaultBackendAddressPool; backendPool != nil && backendPool.ID != nil {
+			poolId, err := parse.BackendAddressPoolID(*backendPool.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["default_backend_address_pool_name"] = poolId.Name
+			output["default_backend_address_pool_id"] = poolId.ID()
+		}
 
-			if settings := props.DefaultBackendHTTPSettings; settings != nil && settings.ID != nil {
-				settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
-				if err != nil {
-					return nil, err
-				}
-				output["default_backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
-				output["default_backend_http_settings_id"] = settingsId.ID()
+		if settings := props.DefaultBackendHTTPSettings; settings != nil && settings.ID != nil {
+			settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["default_backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
+			output["default_backend_http_settings_id"] = settingsId.ID()
+		}
 
-			if redirect := props.DefaultRedirectConfiguration; redirect != nil && redirect.ID != nil {
-				redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
-				if err != nil {
-					return nil, err
-				}
-				output["default_redirect_configuration_name"] = redirectId.RedirectConfigurationName
-				output["default_redirect_configuration_id"] = redirectId.ID()
+		if redirect := props.DefaultRedirectConfiguration; redirect != nil && redirect.ID != nil {
+			redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["default_redirect_configuration_name"] = redirectId.RedirectConfigurationName
+			output["default_redirect_configuration_id"] = redirectId.ID()
+		}
 
-			if rewrite := props.DefaultRewriteRuleSet; rewrite != nil && rewrite.ID != nil {
-				rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
-				if err != nil {
-					return nil, err
-				}
-				output["default_rewrite_rule_set_name"] = rewriteId.Name
-				output["default_rewrite_rule_set_id"] = rewriteId.ID()
+		if rewrite := props.DefaultRewriteRuleSet; rewrite != nil && rewrite.ID != nil {
+			rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["default_rewrite_rule_set_name"] = rewriteId.Name
+			output["default_rewrite_rule_set_id"] = rewriteId.ID()
+		}
 
-			pathRules := make([]interface{}, 0)
-			if rules := props.PathRules; rules != nil {
-				for _, rule := range *rules {
-					ruleOutput := map[string]interface{}{}
+		pathRules := make([]interface{}, 0)
+		if rules := props.PathRules; rules != nil {
+			for _, rule := range *rules {
+				ruleOutput := map[string]interface{}{}
 
-					if rule.ID != nil {
-						ruleOutput["id"] = *rule.ID
-					}
+				if rule.ID != nil {
+					ruleOutput["id"] = *rule.ID
+				}
 
-					if rule.Name != nil {
-						ruleOutput["name"] = *rule.Name
-					}
+				if rule.Name != nil {
+					ruleOutput["name"] = *rule.Name
+				}
 
-					if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil {
-						if pool := ruleProps.BackendAddressPool; pool != nil && pool.ID != nil {
-							poolId, err := parse.BackendAddressPoolID(*pool.ID)
-							if err != nil {
-								return nil, err
-							}
-							ruleOutput["backend_address_pool_name"] = poolId.Name
-							ruleOutput["backend_address_pool_id"] = poolId.ID()
+				if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil {
+					if pool := ruleProps.BackendAddressPool; pool != nil && pool.ID != nil {
+						poolId, err := parse.BackendAddressPoolID(*pool.ID)
+						if err != nil {
+							return nil, err
 						}
+						ruleOutput["backend_address_pool_name"] = poolId.Name
+						ruleOutput["backend_address_pool_id"] = poolId.ID()
+					}
 
-						if backend := ruleProps.BackendHTTPSettings; backend != nil && backend.ID != nil {
-							backendId, err := parse.BackendHttpSettingsCollectionID(*backend.ID)
-							if err != nil {
-								return nil, err
-							}
-							ruleOutput["backend_http_settings_name"] = backendId.BackendHttpSettingsCollectionName
-							ruleOutput["backend_http_settings_id"] = backendId.ID()
+					if backend := ruleProps.BackendHTTPSettings; backend != nil && backend.ID != nil {
+						backendId, err := parse.BackendHttpSettingsCollectionID(*backend.ID)
+						if err != nil {
+							return nil, err
 						}
+						ruleOutput["backend_http_settings_name"] = backendId.BackendHttpSettingsCollectionName
+						ruleOutput["backend_http_settings_id"] = backendId.ID()
+					}
 
-						if redirect := ruleProps.RedirectConfiguration; redirect != nil && redirect.ID != nil {
-							redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
-							if err != nil {
-								return nil, err
-							}
-							ruleOutput["redirect_configuration_name"] = redirectId.RedirectConfigurationName
-							ruleOutput["redirect_configuration_id"] = redirectId.ID()
+					if redirect := ruleProps.RedirectConfiguration; redirect != nil && redirect.ID != nil {
+						redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
+						if err != nil {
+							return nil, err
 						}
+						ruleOutput["redirect_configuration_name"] = redirectId.RedirectConfigurationName
+						ruleOutput["redirect_configuration_id"] = redirectId.ID()
+					}
 
-						if rewrite := ruleProps.RewriteRuleSet; rewrite != nil && rewrite.ID != nil {
-							rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
-							if err != nil {
-								return nil, err
-							}
-							ruleOutput["rewrite_rule_set_name"] = rewriteId.Name
-							ruleOutput["rewrite_rule_set_id"] = rewriteId.ID()
+					if rewrite := ruleProps.RewriteRuleSet; rewrite != nil && rewrite.ID != nil {
+						rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
+						if err != nil {
+							return nil, err
 						}
+						ruleOutput["rewrite_rule_set_name"] = rewriteId.Name
+						ruleOutput["rewrite_rule_set_id"] = rewriteId.ID()
+					}
 
-						if fwp := ruleProps.FirewallPolicy; fwp != nil && fwp.ID != nil {
-							ruleOutput["firewall_policy_id"] = *fwp.ID
-						}
+					if fwp := ruleProps.FirewallPolicy; fwp != nil && fwp.ID != nil {
+						ruleOutput["firewall_policy_id"] = *fwp.ID
+					}
 
-						pathOutputs := make([]interface{}, 0)
-						if paths := ruleProps.Paths; paths != nil {
-							for _, rulePath := range *paths {
-								pathOutputs = append(pathOutputs, rulePath)
-							}
+					pathOutputs := make([]interface{}, 0)
+					if paths := ruleProps.Paths; paths != nil {
+						for _, rulePath := range *paths {
+							pathOutputs = append(pathOutputs, rulePath)
 						}
-						ruleOutput["paths"] = pathOutputs
 					}
-
-					pathRules = append(pathRules, ruleOutput)
+					ruleOutput["paths"] = pathOutputs
 				}
+
+				pathRules = append(pathRules, ruleOutput)
 			}
-			output["path_rule"] = pathRules
 		}
-
-		results = append(results, output)
+		output["path_rule"] = pathRules
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayWafConfig(d *pluginsdk.ResourceData) *network.ApplicationGatewayWebApplicationFirewallConfiguration {
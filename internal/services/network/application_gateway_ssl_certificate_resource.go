@@ -0,0 +1,169 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceApplicationGatewaySslCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewaySslCertificateCreateUpdate,
+		Read:   resourceApplicationGatewaySslCertificateRead,
+		Update: resourceApplicationGatewaySslCertificateCreateUpdate,
+		Delete: resourceApplicationGatewaySslCertificateDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SslCertificateID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewaySslCertificateSchema()),
+	}
+}
+
+func resourceApplicationGatewaySslCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewSslCertificateID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewaySslCertificateSchema())
+	certificate, err := expandApplicationGatewaySslCertificate(config)
+	if err != nil {
+		return fmt.Errorf("expanding SSL Certificate: %+v", err)
+	}
+
+	err = updateApplicationGatewaySubResource(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		certificates := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			certificates = *props.SslCertificates
+		}
+
+		exists := false
+		for i, existing := range certificates {
+			if existing.Name == nil || !strings.EqualFold(*existing.Name, id.Name) {
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_ssl_certificate", id.ID())
+			}
+
+			certificates[i] = *certificate
+			exists = true
+		}
+
+		if !exists {
+			certificates = append(certificates, *certificate)
+		}
+
+		props.SslCertificates = &certificates
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewaySslCertificateRead(d, meta)
+}
+
+func resourceApplicationGatewaySslCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var certificate *network.ApplicationGatewaySslCertificate
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.SslCertificates != nil {
+		for _, existing := range *props.SslCertificates {
+			if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+				existing := existing
+				certificate = &existing
+				break
+			}
+		}
+	}
+	if certificate == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", gatewayId.ID())
+	// the certificate `data` and `password` aren't returned by the API, so are left as configured
+	output := flattenApplicationGatewaySslCertificate(*certificate)
+
+	return flattenApplicationGatewaySubResource(d, output)
+}
+
+func resourceApplicationGatewaySslCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGatewaySubResource(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		certificates := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			for _, existing := range *props.SslCertificates {
+				if existing.Name != nil && strings.EqualFold(*existing.Name, id.Name) {
+					continue
+				}
+				certificates = append(certificates, existing)
+			}
+		}
+
+		props.SslCertificates = &certificates
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
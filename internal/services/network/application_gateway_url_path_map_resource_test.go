@@ -0,0 +1,160 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type ApplicationGatewayURLPathMapResource struct {
+}
+
+func TestAccApplicationGatewayURLPathMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_url_path_map", "test")
+	r := ApplicationGatewayURLPathMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayURLPathMap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_url_path_map", "test")
+	r := ApplicationGatewayURLPathMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayURLPathMap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_url_path_map", "test")
+	r := ApplicationGatewayURLPathMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("path_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApplicationGatewayURLPathMapResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.UrlPathMapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.URLPathMaps != nil {
+		for _, v := range *props.URLPathMaps {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayURLPathMapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_url_path_map" "test" {
+  name                               = "acctest-urlpath-%d"
+  application_gateway_id             = azurerm_application_gateway.test.id
+  default_backend_address_pool_name  = local.backend_address_pool_name
+  default_backend_http_settings_name = local.http_setting_name
+
+  path_rule {
+    name                       = "api"
+    paths                      = ["/api/*"]
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, ApplicationGatewayResource{}.externallyManaged(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayURLPathMapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_url_path_map" "import" {
+  name                               = azurerm_application_gateway_url_path_map.test.name
+  application_gateway_id             = azurerm_application_gateway_url_path_map.test.application_gateway_id
+  default_backend_address_pool_name  = azurerm_application_gateway_url_path_map.test.default_backend_address_pool_name
+  default_backend_http_settings_name = azurerm_application_gateway_url_path_map.test.default_backend_http_settings_name
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewayURLPathMapResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_url_path_map" "test" {
+  name                               = "acctest-urlpath-%d"
+  application_gateway_id             = azurerm_application_gateway.test.id
+  default_backend_address_pool_name  = local.backend_address_pool_name
+  default_backend_http_settings_name = local.http_setting_name
+
+  path_rule {
+    name                       = "api"
+    paths                      = ["/api/*", "/v2/api/*"]
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+
+  path_rule {
+    name                       = "images"
+    paths                      = ["/images/*"]
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, ApplicationGatewayResource{}.externallyManaged(data), data.RandomInteger)
+}
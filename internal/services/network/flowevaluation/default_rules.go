@@ -0,0 +1,74 @@
+package flowevaluation
+
+// defaultRules returns the rules Azure adds to every Network Security Group, these can't be removed
+// but are evaluated after any custom rules
+func defaultRules() []Rule {
+	return []Rule{
+		{
+			Name:                       "AllowVnetInBound",
+			Priority:                   65000,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"VirtualNetwork"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"VirtualNetwork"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowAzureLoadBalancerInBound",
+			Priority:                   65001,
+			Direction:                  DirectionInbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"AzureLoadBalancer"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "DenyAllInBound",
+			Priority:                   65500,
+			Direction:                  DirectionInbound,
+			Access:                     AccessDeny,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowVnetOutBound",
+			Priority:                   65000,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"VirtualNetwork"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"VirtualNetwork"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "AllowInternetOutBound",
+			Priority:                   65001,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessAllow,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"Internet"},
+			DestinationPortRanges:      []string{"*"},
+		},
+		{
+			Name:                       "DenyAllOutBound",
+			Priority:                   65500,
+			Direction:                  DirectionOutbound,
+			Access:                     AccessDeny,
+			Protocol:                   "*",
+			SourceAddressPrefixes:      []string{"*"},
+			SourcePortRanges:           []string{"*"},
+			DestinationAddressPrefixes: []string{"*"},
+			DestinationPortRanges:      []string{"*"},
+		},
+	}
+}
@@ -0,0 +1,189 @@
+// Package flowevaluation evaluates a network flow against the Network Security Groups associated with a
+// Subnet and a Network Interface, in the same order Azure does, without making any API calls.
+package flowevaluation
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Evaluate determines whether the flow is allowed by the Subnet and Network Interface Network Security Groups,
+// either of which may be nil when no Network Security Group is associated.
+//
+// Inbound traffic is evaluated against the Subnet's Network Security Group and then the Network Interface's,
+// Outbound traffic in the reverse order - the flow is only allowed when both allow it.
+func Evaluate(flow Flow, env Environment, subnetSecurityGroup, networkInterfaceSecurityGroup *SecurityGroup) (*Result, error) {
+	if flow.SourceAddress == nil {
+		return nil, fmt.Errorf("a source address must be specified")
+	}
+	if flow.DestinationAddress == nil {
+		return nil, fmt.Errorf("a destination address must be specified")
+	}
+
+	var groups []*SecurityGroup
+	switch flow.Direction {
+	case DirectionInbound:
+		groups = []*SecurityGroup{subnetSecurityGroup, networkInterfaceSecurityGroup}
+	case DirectionOutbound:
+		groups = []*SecurityGroup{networkInterfaceSecurityGroup, subnetSecurityGroup}
+	default:
+		return nil, fmt.Errorf("unsupported direction %q", string(flow.Direction))
+	}
+
+	result := Result{
+		Access: AccessAllow,
+		Trace:  make([]TraceEntry, 0),
+	}
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		rule, trace, err := evaluateSecurityGroup(flow, env, *group)
+		result.Trace = append(result.Trace, trace...)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating Network Security Group %q: %+v", group.ID, err)
+		}
+
+		// the default rules end with a Deny All so there's always a matching rule
+		result.Access = rule.Access
+		result.SecurityGroupId = group.ID
+		result.RuleName = rule.Name
+		result.RulePriority = rule.Priority
+
+		if rule.Access == AccessDeny {
+			break
+		}
+	}
+
+	return &result, nil
+}
+
+func evaluateSecurityGroup(flow Flow, env Environment, group SecurityGroup) (*Rule, []TraceEntry, error) {
+	rules := make([]Rule, 0)
+	for _, rule := range append(append([]Rule{}, group.Rules...), defaultRules()...) {
+		if rule.Direction == flow.Direction {
+			rules = append(rules, rule)
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	trace := make([]TraceEntry, 0)
+	for i := range rules {
+		rule := rules[i]
+
+		reason, err := mismatchReason(flow, env, rule)
+		if err != nil {
+			return nil, trace, fmt.Errorf("evaluating rule %q: %+v", rule.Name, err)
+		}
+
+		entry := TraceEntry{
+			SecurityGroupId: group.ID,
+			RuleName:        rule.Name,
+			Priority:        rule.Priority,
+			Access:          rule.Access,
+			Matched:         reason == "",
+			Reason:          reason,
+		}
+		if entry.Matched {
+			entry.Reason = "matched"
+		}
+		trace = append(trace, entry)
+
+		if entry.Matched {
+			return &rule, trace, nil
+		}
+	}
+
+	return nil, trace, fmt.Errorf("no rule matched the flow")
+}
+
+// mismatchReason returns why the rule doesn't match the flow, or an empty string when it does
+func mismatchReason(flow Flow, env Environment, rule Rule) (string, error) {
+	if !matchesProtocol(rule.Protocol, flow.Protocol) {
+		return fmt.Sprintf("protocol %q does not match %q", flow.Protocol, rule.Protocol), nil
+	}
+
+	if len(rule.SourceApplicationSecurityGroupIds) > 0 {
+		if !matchesApplicationSecurityGroups(rule.SourceApplicationSecurityGroupIds, flow.SourceApplicationSecurityGroupIds) {
+			return "source is not a member of the rule's source Application Security Groups", nil
+		}
+	} else {
+		matched, err := matchesAddressPrefixes(flow.SourceAddress, rule.SourceAddressPrefixes, env)
+		if err != nil {
+			return "", err
+		}
+		if !matched {
+			return fmt.Sprintf("source address %s does not match the rule's source address prefixes", flow.SourceAddress.String()), nil
+		}
+	}
+
+	if len(rule.DestinationApplicationSecurityGroupIds) > 0 {
+		if !matchesApplicationSecurityGroups(rule.DestinationApplicationSecurityGroupIds, flow.DestinationApplicationSecurityGroupIds) {
+			return "destination is not a member of the rule's destination Application Security Groups", nil
+		}
+	} else {
+		matched, err := matchesAddressPrefixes(flow.DestinationAddress, rule.DestinationAddressPrefixes, env)
+		if err != nil {
+			return "", err
+		}
+		if !matched {
+			return fmt.Sprintf("destination address %s does not match the rule's destination address prefixes", flow.DestinationAddress.String()), nil
+		}
+	}
+
+	if !protocolHasPorts(flow.Protocol) {
+		return "", nil
+	}
+
+	matched, err := matchesPortRanges(flow.SourcePort, rule.SourcePortRanges)
+	if err != nil {
+		return "", err
+	}
+	if !matched {
+		return fmt.Sprintf("source port %d does not match the rule's source port ranges", flow.SourcePort), nil
+	}
+
+	matched, err = matchesPortRanges(flow.DestinationPort, rule.DestinationPortRanges)
+	if err != nil {
+		return "", err
+	}
+	if !matched {
+		return fmt.Sprintf("destination port %d does not match the rule's destination port ranges", flow.DestinationPort), nil
+	}
+
+	return "", nil
+}
+
+// ServiceTags returns the names of the Service Tags referenced by the rules in the specified Network Security
+// Groups which need to be looked up, that is excluding those which can be expanded without an API call
+func ServiceTags(groups ...*SecurityGroup) []string {
+	seen := make(map[string]struct{})
+	output := make([]string, 0)
+
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+
+		for _, rule := range group.Rules {
+			for _, prefix := range append(append([]string{}, rule.SourceAddressPrefixes...), rule.DestinationAddressPrefixes...) {
+				if !isServiceTag(prefix) || isBuiltInServiceTag(prefix) {
+					continue
+				}
+				if _, ok := seen[prefix]; ok {
+					continue
+				}
+				seen[prefix] = struct{}{}
+				output = append(output, prefix)
+			}
+		}
+	}
+
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,462 @@
+package flowevaluation
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	env := Environment{
+		VirtualNetworkAddressPrefixes: []string{"10.0.0.0/16"},
+		ServiceTags: map[string][]string{
+			"Storage.WestEurope": {"52.239.140.0/22"},
+		},
+	}
+
+	webAsg := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/web"
+
+	subnetGroup := &SecurityGroup{
+		ID: "subnet-nsg",
+		Rules: []Rule{
+			{
+				Name:                       "deny-ssh",
+				Priority:                   200,
+				Direction:                  DirectionInbound,
+				Access:                     AccessDeny,
+				Protocol:                   "Tcp",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"22"},
+			},
+			{
+				Name:                       "allow-ssh-from-office",
+				Priority:                   100,
+				Direction:                  DirectionInbound,
+				Access:                     AccessAllow,
+				Protocol:                   "Tcp",
+				SourceAddressPrefixes:      []string{"203.0.113.0/24"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"VirtualNetwork"},
+				DestinationPortRanges:      []string{"22"},
+			},
+			{
+				Name:                       "allow-https",
+				Priority:                   300,
+				Direction:                  DirectionInbound,
+				Access:                     AccessAllow,
+				Protocol:                   "*",
+				SourceAddressPrefixes:      []string{"Internet"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"443", "8000-8999"},
+			},
+			{
+				Name:                       "deny-storage",
+				Priority:                   100,
+				Direction:                  DirectionOutbound,
+				Access:                     AccessDeny,
+				Protocol:                   "*",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"Storage.WestEurope"},
+				DestinationPortRanges:      []string{"*"},
+			},
+		},
+	}
+
+	nicGroup := &SecurityGroup{
+		ID: "nic-nsg",
+		Rules: []Rule{
+			{
+				Name:                                   "allow-web-asg",
+				Priority:                               100,
+				Direction:                              DirectionInbound,
+				Access:                                 AccessAllow,
+				Protocol:                               "Tcp",
+				SourceAddressPrefixes:                  []string{"*"},
+				SourcePortRanges:                       []string{"*"},
+				DestinationApplicationSecurityGroupIds: []string{webAsg},
+				DestinationPortRanges:                  []string{"*"},
+			},
+			{
+				Name:                       "deny-icmp-out",
+				Priority:                   100,
+				Direction:                  DirectionOutbound,
+				Access:                     AccessDeny,
+				Protocol:                   "Icmp",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"*"},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name             string
+		Flow             Flow
+		Subnet           *SecurityGroup
+		NetworkInterface *SecurityGroup
+		ExpectedAccess   Access
+		ExpectedGroup    string
+		ExpectedRule     string
+	}{
+		{
+			Name: "no security groups",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("198.51.100.1"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    22,
+			},
+			ExpectedAccess: AccessAllow,
+		},
+		{
+			Name: "lower priority number wins",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("203.0.113.10"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    22,
+			},
+			Subnet:         subnetGroup,
+			ExpectedAccess: AccessAllow,
+			ExpectedGroup:  "subnet-nsg",
+			ExpectedRule:   "allow-ssh-from-office",
+		},
+		{
+			Name: "cidr mismatch falls through to deny",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("198.51.100.1"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    22,
+			},
+			Subnet:         subnetGroup,
+			ExpectedAccess: AccessDeny,
+			ExpectedGroup:  "subnet-nsg",
+			ExpectedRule:   "deny-ssh",
+		},
+		{
+			Name: "port range match",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("198.51.100.1"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    8080,
+			},
+			Subnet:         subnetGroup,
+			ExpectedAccess: AccessAllow,
+			ExpectedGroup:  "subnet-nsg",
+			ExpectedRule:   "allow-https",
+		},
+		{
+			Name: "private source isn't part of the internet tag",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("192.168.1.1"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    443,
+			},
+			Subnet:         subnetGroup,
+			ExpectedAccess: AccessDeny,
+			ExpectedGroup:  "subnet-nsg",
+			ExpectedRule:   "DenyAllInBound",
+		},
+		{
+			Name: "inbound allowed by subnet but denied by network interface",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("198.51.100.1"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    443,
+			},
+			Subnet:           subnetGroup,
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessDeny,
+			ExpectedGroup:    "nic-nsg",
+			ExpectedRule:     "DenyAllInBound",
+		},
+		{
+			Name: "inbound allowed by application security group membership",
+			Flow: Flow{
+				Direction:                              DirectionInbound,
+				Protocol:                               "Tcp",
+				SourceAddress:                          net.ParseIP("198.51.100.1"),
+				SourcePort:                             50000,
+				DestinationAddress:                     net.ParseIP("10.0.1.4"),
+				DestinationPort:                        443,
+				DestinationApplicationSecurityGroupIds: []string{webAsg},
+			},
+			Subnet:           subnetGroup,
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessAllow,
+			ExpectedGroup:    "nic-nsg",
+			ExpectedRule:     "allow-web-asg",
+		},
+		{
+			Name: "virtual network traffic uses the default rule",
+			Flow: Flow{
+				Direction:          DirectionInbound,
+				Protocol:           "Udp",
+				SourceAddress:      net.ParseIP("10.0.2.4"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("10.0.1.4"),
+				DestinationPort:    53,
+			},
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessAllow,
+			ExpectedGroup:    "nic-nsg",
+			ExpectedRule:     "AllowVnetInBound",
+		},
+		{
+			Name: "outbound evaluates the network interface first",
+			Flow: Flow{
+				Direction:          DirectionOutbound,
+				Protocol:           "Icmp",
+				SourceAddress:      net.ParseIP("10.0.1.4"),
+				DestinationAddress: net.ParseIP("52.239.140.10"),
+			},
+			Subnet:           subnetGroup,
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessDeny,
+			ExpectedGroup:    "nic-nsg",
+			ExpectedRule:     "deny-icmp-out",
+		},
+		{
+			Name: "outbound denied by service tag",
+			Flow: Flow{
+				Direction:          DirectionOutbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("10.0.1.4"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("52.239.140.10"),
+				DestinationPort:    443,
+			},
+			Subnet:           subnetGroup,
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessDeny,
+			ExpectedGroup:    "subnet-nsg",
+			ExpectedRule:     "deny-storage",
+		},
+		{
+			Name: "outbound to the internet uses the default rule",
+			Flow: Flow{
+				Direction:          DirectionOutbound,
+				Protocol:           "Tcp",
+				SourceAddress:      net.ParseIP("10.0.1.4"),
+				SourcePort:         50000,
+				DestinationAddress: net.ParseIP("198.51.100.1"),
+				DestinationPort:    443,
+			},
+			Subnet:           subnetGroup,
+			NetworkInterface: nicGroup,
+			ExpectedAccess:   AccessAllow,
+			ExpectedGroup:    "subnet-nsg",
+			ExpectedRule:     "AllowInternetOutBound",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		result, err := Evaluate(v.Flow, env, v.Subnet, v.NetworkInterface)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if result.Access != v.ExpectedAccess {
+			t.Fatalf("expected access %q but got %q", v.ExpectedAccess, result.Access)
+		}
+		if result.SecurityGroupId != v.ExpectedGroup {
+			t.Fatalf("expected security group %q but got %q", v.ExpectedGroup, result.SecurityGroupId)
+		}
+		if result.RuleName != v.ExpectedRule {
+			t.Fatalf("expected rule %q but got %q", v.ExpectedRule, result.RuleName)
+		}
+	}
+}
+
+func TestEvaluateTrace(t *testing.T) {
+	group := &SecurityGroup{
+		ID: "nsg",
+		Rules: []Rule{
+			{
+				Name:                       "allow-http",
+				Priority:                   200,
+				Direction:                  DirectionInbound,
+				Access:                     AccessAllow,
+				Protocol:                   "Tcp",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"80"},
+			},
+			{
+				Name:                       "deny-udp",
+				Priority:                   100,
+				Direction:                  DirectionInbound,
+				Access:                     AccessDeny,
+				Protocol:                   "Udp",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"*"},
+			},
+			{
+				Name:                       "outbound-ignored",
+				Priority:                   100,
+				Direction:                  DirectionOutbound,
+				Access:                     AccessDeny,
+				Protocol:                   "*",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"*"},
+				DestinationPortRanges:      []string{"*"},
+			},
+		},
+	}
+
+	flow := Flow{
+		Direction:          DirectionInbound,
+		Protocol:           "Tcp",
+		SourceAddress:      net.ParseIP("198.51.100.1"),
+		SourcePort:         50000,
+		DestinationAddress: net.ParseIP("10.0.1.4"),
+		DestinationPort:    443,
+	}
+
+	result, err := Evaluate(flow, Environment{VirtualNetworkAddressPrefixes: []string{"10.0.0.0/16"}}, nil, group)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	actual := make([]string, 0)
+	for _, entry := range result.Trace {
+		actual = append(actual, entry.RuleName)
+	}
+
+	expected := []string{"deny-udp", "allow-http", "AllowVnetInBound", "AllowAzureLoadBalancerInBound", "DenyAllInBound"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected trace %+v but got %+v", expected, actual)
+	}
+
+	last := result.Trace[len(result.Trace)-1]
+	if !last.Matched || last.Reason != "matched" {
+		t.Fatalf("expected the last trace entry to be the matching rule but got %+v", last)
+	}
+	for _, entry := range result.Trace[:len(result.Trace)-1] {
+		if entry.Matched || entry.Reason == "" {
+			t.Fatalf("expected %q not to match with a reason but got %+v", entry.RuleName, entry)
+		}
+	}
+}
+
+func TestEvaluateUnknownServiceTag(t *testing.T) {
+	group := &SecurityGroup{
+		ID: "nsg",
+		Rules: []Rule{
+			{
+				Name:                       "allow-sql",
+				Priority:                   100,
+				Direction:                  DirectionOutbound,
+				Access:                     AccessAllow,
+				Protocol:                   "*",
+				SourceAddressPrefixes:      []string{"*"},
+				SourcePortRanges:           []string{"*"},
+				DestinationAddressPrefixes: []string{"Sql"},
+				DestinationPortRanges:      []string{"*"},
+			},
+		},
+	}
+
+	flow := Flow{
+		Direction:          DirectionOutbound,
+		Protocol:           "Tcp",
+		SourceAddress:      net.ParseIP("10.0.1.4"),
+		SourcePort:         50000,
+		DestinationAddress: net.ParseIP("198.51.100.1"),
+		DestinationPort:    1433,
+	}
+
+	if _, err := Evaluate(flow, Environment{}, group, nil); err == nil {
+		t.Fatalf("expected an error for an unknown Service Tag but didn't get one")
+	}
+}
+
+func TestServiceTags(t *testing.T) {
+	groups := []*SecurityGroup{
+		{
+			Rules: []Rule{
+				{
+					SourceAddressPrefixes:      []string{"VirtualNetwork", "10.0.0.0/8", "Storage"},
+					DestinationAddressPrefixes: []string{"*", "Sql.WestEurope"},
+				},
+			},
+		},
+		nil,
+		{
+			Rules: []Rule{
+				{
+					SourceAddressPrefixes:      []string{"Internet", "192.168.1.1"},
+					DestinationAddressPrefixes: []string{"Storage", "AzureLoadBalancer"},
+				},
+			},
+		},
+	}
+
+	expected := []string{"Sql.WestEurope", "Storage"}
+	if actual := ServiceTags(groups...); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestMatchesPortRange(t *testing.T) {
+	testData := []struct {
+		Port     int
+		Range    string
+		Expected bool
+		Error    bool
+	}{
+		{Port: 80, Range: "*", Expected: true},
+		{Port: 80, Range: "80", Expected: true},
+		{Port: 81, Range: "80", Expected: false},
+		{Port: 1024, Range: "1024-65535", Expected: true},
+		{Port: 65535, Range: "1024-65535", Expected: true},
+		{Port: 1023, Range: "1024-65535", Expected: false},
+		{Port: 80, Range: "http", Error: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d against %q", v.Port, v.Range)
+
+		actual, err := matchesPortRange(v.Port, v.Range)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package flowevaluation
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const azureLoadBalancerAddress = "168.63.129.16/32"
+
+// nonInternetAddressPrefixes are excluded from the `Internet` Service Tag in addition to the Virtual Network
+var nonInternetAddressPrefixes = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"224.0.0.0/4",
+	"fc00::/7",
+	"fe80::/10",
+	"::1/128",
+}
+
+func matchesProtocol(ruleProtocol, flowProtocol string) bool {
+	if ruleProtocol == "*" || strings.EqualFold(ruleProtocol, "Any") {
+		return true
+	}
+
+	return strings.EqualFold(ruleProtocol, flowProtocol)
+}
+
+// protocolHasPorts returns whether ports should be taken into account for the specified protocol
+func protocolHasPorts(protocol string) bool {
+	return strings.EqualFold(protocol, "Tcp") || strings.EqualFold(protocol, "Udp")
+}
+
+func matchesAddressPrefixes(address net.IP, prefixes []string, env Environment) (bool, error) {
+	for _, prefix := range prefixes {
+		matched, err := matchesAddressPrefix(address, prefix, env)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func matchesAddressPrefix(address net.IP, prefix string, env Environment) (bool, error) {
+	prefix = strings.TrimSpace(prefix)
+
+	switch {
+	case prefix == "*" || strings.EqualFold(prefix, "Any"):
+		return true, nil
+
+	case strings.Contains(prefix, "/"):
+		_, network, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return false, fmt.Errorf("parsing address prefix %q: %+v", prefix, err)
+		}
+		return network.Contains(address), nil
+
+	case net.ParseIP(prefix) != nil:
+		return net.ParseIP(prefix).Equal(address), nil
+	}
+
+	prefixes, err := expandServiceTag(prefix, env)
+	if err != nil {
+		return false, err
+	}
+
+	if strings.EqualFold(prefix, "Internet") {
+		matched, err := matchesAddressPrefixes(address, prefixes, env)
+		if err != nil {
+			return false, err
+		}
+		return !matched, nil
+	}
+
+	return matchesAddressPrefixes(address, prefixes, env)
+}
+
+func isServiceTag(prefix string) bool {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || prefix == "*" || strings.EqualFold(prefix, "Any") || strings.Contains(prefix, "/") {
+		return false
+	}
+
+	return net.ParseIP(prefix) == nil
+}
+
+// isBuiltInServiceTag returns whether the Service Tag can be expanded without looking it up
+func isBuiltInServiceTag(tag string) bool {
+	return strings.EqualFold(tag, "VirtualNetwork") || strings.EqualFold(tag, "AzureLoadBalancer") || strings.EqualFold(tag, "Internet")
+}
+
+// expandServiceTag returns the address prefixes for the specified Service Tag - with the exception of the
+// `Internet` tag, where the prefixes which are NOT part of the Internet are returned
+func expandServiceTag(tag string, env Environment) ([]string, error) {
+	switch {
+	case strings.EqualFold(tag, "VirtualNetwork"):
+		return env.VirtualNetworkAddressPrefixes, nil
+
+	case strings.EqualFold(tag, "AzureLoadBalancer"):
+		return []string{azureLoadBalancerAddress}, nil
+
+	case strings.EqualFold(tag, "Internet"):
+		return append(append([]string{}, env.VirtualNetworkAddressPrefixes...), nonInternetAddressPrefixes...), nil
+	}
+
+	for name, prefixes := range env.ServiceTags {
+		if strings.EqualFold(name, tag) {
+			return prefixes, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the Service Tag %q could not be expanded", tag)
+}
+
+func matchesPortRanges(port int, ranges []string) (bool, error) {
+	for _, v := range ranges {
+		matched, err := matchesPortRange(port, v)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func matchesPortRange(port int, portRange string) (bool, error) {
+	portRange = strings.TrimSpace(portRange)
+	if portRange == "*" {
+		return true, nil
+	}
+
+	start, end := portRange, portRange
+	if i := strings.Index(portRange, "-"); i > 0 {
+		start, end = portRange[:i], portRange[i+1:]
+	}
+
+	low, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil {
+		return false, fmt.Errorf("parsing port range %q: %+v", portRange, err)
+	}
+
+	high, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil {
+		return false, fmt.Errorf("parsing port range %q: %+v", portRange, err)
+	}
+
+	return port >= low && port <= high, nil
+}
+
+func matchesApplicationSecurityGroups(ruleIds, memberOfIds []string) bool {
+	for _, ruleId := range ruleIds {
+		for _, memberOfId := range memberOfIds {
+			if strings.EqualFold(ruleId, memberOfId) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
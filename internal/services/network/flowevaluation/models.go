@@ -0,0 +1,86 @@
+package flowevaluation
+
+import "net"
+
+type Access string
+
+const (
+	AccessAllow Access = "Allow"
+	AccessDeny  Access = "Deny"
+)
+
+type Direction string
+
+const (
+	DirectionInbound  Direction = "Inbound"
+	DirectionOutbound Direction = "Outbound"
+)
+
+// Flow is the 5-tuple (plus direction) which should be evaluated
+type Flow struct {
+	Direction          Direction
+	Protocol           string
+	SourceAddress      net.IP
+	SourcePort         int
+	DestinationAddress net.IP
+	DestinationPort    int
+
+	// SourceApplicationSecurityGroupIds are the Application Security Groups the source Network Interface is a member of
+	SourceApplicationSecurityGroupIds []string
+
+	// DestinationApplicationSecurityGroupIds are the Application Security Groups the destination Network Interface is a member of
+	DestinationApplicationSecurityGroupIds []string
+}
+
+// Rule is a Network Security Rule, the address prefixes can be CIDRs, IP Addresses, `*` or Service Tags
+type Rule struct {
+	Name      string
+	Priority  int
+	Direction Direction
+	Access    Access
+	Protocol  string
+
+	SourceAddressPrefixes             []string
+	SourcePortRanges                  []string
+	SourceApplicationSecurityGroupIds []string
+
+	DestinationAddressPrefixes             []string
+	DestinationPortRanges                  []string
+	DestinationApplicationSecurityGroupIds []string
+}
+
+// SecurityGroup is a Network Security Group, the default rules are added during evaluation
+type SecurityGroup struct {
+	ID    string
+	Rules []Rule
+}
+
+// Environment contains the information required to expand Service Tags
+type Environment struct {
+	// VirtualNetworkAddressPrefixes is the address space covered by the `VirtualNetwork` Service Tag
+	VirtualNetworkAddressPrefixes []string
+
+	// ServiceTags maps the name of a Service Tag (e.g. `Storage` or `Storage.WestEurope`) to its address prefixes
+	ServiceTags map[string][]string
+}
+
+type TraceEntry struct {
+	SecurityGroupId string
+	RuleName        string
+	Priority        int
+	Access          Access
+	Matched         bool
+	Reason          string
+}
+
+type Result struct {
+	Access Access
+
+	// SecurityGroupId and RuleName identify the rule which decided the outcome, these are empty when no
+	// Network Security Group was evaluated
+	SecurityGroupId string
+	RuleName        string
+	RulePriority    int
+
+	Trace []TraceEntry
+}
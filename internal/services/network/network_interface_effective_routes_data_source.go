@@ -0,0 +1,141 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"disable_bgp_route_propagation": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Effective Routes are only available when the Network Interface is attached to a running Virtual Machine
+	future, err := client.GetEffectiveRouteTable(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Routes for %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Effective Routes for %s: %+v", *id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of Effective Routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("route", flattenNetworkInterfaceEffectiveRoutes(result.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		disableBgpRoutePropagation := false
+		if item.DisableBgpRoutePropagation != nil {
+			disableBgpRoutePropagation = *item.DisableBgpRoutePropagation
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          utils.NormalizeNilableString(item.Name),
+			"source":                        string(item.Source),
+			"state":                         string(item.State),
+			"address_prefixes":              utils.FlattenStringSlice(item.AddressPrefix),
+			"next_hop_type":                 string(item.NextHopType),
+			"next_hop_ip_addresses":         utils.FlattenStringSlice(item.NextHopIPAddress),
+			"disable_bgp_route_propagation": disableBgpRoutePropagation,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,182 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/sdk/2021-05-01-preview/networkmanagers"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceNetworkManagerAdminRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerAdminRuleCollectionCreateUpdate,
+		Read:   resourceNetworkManagerAdminRuleCollectionRead,
+		Update: resourceNetworkManagerAdminRuleCollectionCreateUpdate,
+		Delete: resourceNetworkManagerAdminRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerAdminRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"security_admin_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerSecurityAdminConfigurationID,
+			},
+
+			"network_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NetworkManagerNetworkGroupID,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerAdminRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	configurationId, err := parse.NetworkManagerSecurityAdminConfigurationID(d.Get("security_admin_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerAdminRuleCollectionID(configurationId.SubscriptionId, configurationId.ResourceGroup, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.AdminRuleCollectionsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_admin_rule_collection", id.ID())
+		}
+	}
+
+	appliesToGroups := make([]networkmanagers.ManagerSecurityGroupItem, 0)
+	for _, v := range d.Get("network_group_ids").([]interface{}) {
+		appliesToGroups = append(appliesToGroups, networkmanagers.ManagerSecurityGroupItem{
+			NetworkGroupId: v.(string),
+		})
+	}
+
+	parameters := networkmanagers.AdminRuleCollection{
+		Properties: &networkmanagers.AdminRuleCollectionProperties{
+			AppliesToGroups: &appliesToGroups,
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.AdminRuleCollectionsCreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerAdminRuleCollectionRead(d, meta)
+}
+
+func resourceNetworkManagerAdminRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AdminRuleCollectionsGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleCollectionName)
+	d.Set("security_admin_configuration_id", parse.NewNetworkManagerSecurityAdminConfigurationID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.SecurityAdminConfigurationName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+
+			networkGroupIds := make([]interface{}, 0)
+			if props.AppliesToGroups != nil {
+				for _, item := range *props.AppliesToGroups {
+					networkGroupId, err := parse.NetworkManagerNetworkGroupIDInsensitively(item.NetworkGroupId)
+					if err != nil {
+						return err
+					}
+					networkGroupIds = append(networkGroupIds, networkGroupId.ID())
+				}
+			}
+			d.Set("network_group_ids", networkGroupIds)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerAdminRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.AdminRuleCollectionsDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
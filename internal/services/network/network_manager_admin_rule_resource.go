@@ -0,0 +1,289 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/sdk/2021-05-01-preview/networkmanagers"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceNetworkManagerAdminRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerAdminRuleCreateUpdate,
+		Read:   resourceNetworkManagerAdminRuleRead,
+		Update: resourceNetworkManagerAdminRuleCreateUpdate,
+		Delete: resourceNetworkManagerAdminRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerAdminRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"admin_rule_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerAdminRuleCollectionID,
+			},
+
+			"action": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+			},
+
+			"direction": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+
+			"protocol": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+			},
+
+			"source": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"destination": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"source_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"destination_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func networkManagerAdminRuleAddressPrefixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerAdminRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := parse.NetworkManagerAdminRuleCollectionID(d.Get("admin_rule_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerAdminRuleID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.AdminRulesGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_admin_rule", id.ID())
+		}
+	}
+
+	parameters := networkmanagers.AdminRule{
+		Kind: networkmanagers.AdminRuleKindCustom,
+		Properties: &networkmanagers.AdminRuleProperties{
+			Access:                networkmanagers.SecurityConfigurationRuleAccess(d.Get("action").(string)),
+			DestinationPortRanges: utils.ExpandStringSlice(d.Get("destination_port_ranges").([]interface{})),
+			Destinations:          expandNetworkManagerAdminRuleAddressPrefixItems(d.Get("destination").([]interface{})),
+			Direction:             networkmanagers.SecurityConfigurationRuleDirection(d.Get("direction").(string)),
+			Priority:              utils.Int64(int64(d.Get("priority").(int))),
+			Protocol:              networkmanagers.SecurityConfigurationRuleProtocol(d.Get("protocol").(string)),
+			SourcePortRanges:      utils.ExpandStringSlice(d.Get("source_port_ranges").([]interface{})),
+			Sources:               expandNetworkManagerAdminRuleAddressPrefixItems(d.Get("source").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.AdminRulesCreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerAdminRuleRead(d, meta)
+}
+
+func resourceNetworkManagerAdminRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AdminRulesGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("admin_rule_collection_id", parse.NewNetworkManagerAdminRuleCollectionID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("action", string(props.Access))
+			d.Set("direction", string(props.Direction))
+			d.Set("protocol", string(props.Protocol))
+			d.Set("description", props.Description)
+			d.Set("source_port_ranges", utils.FlattenStringSlice(props.SourcePortRanges))
+			d.Set("destination_port_ranges", utils.FlattenStringSlice(props.DestinationPortRanges))
+
+			priority := 0
+			if props.Priority != nil {
+				priority = int(*props.Priority)
+			}
+			d.Set("priority", priority)
+
+			if err := d.Set("source", flattenNetworkManagerAdminRuleAddressPrefixItems(props.Sources)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+
+			if err := d.Set("destination", flattenNetworkManagerAdminRuleAddressPrefixItems(props.Destinations)); err != nil {
+				return fmt.Errorf("setting `destination`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerAdminRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerAdminRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.AdminRulesDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerAdminRuleAddressPrefixItems(input []interface{}) *[]networkmanagers.AddressPrefixItem {
+	output := make([]networkmanagers.AddressPrefixItem, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		addressPrefixType := networkmanagers.AddressPrefixType(v["address_prefix_type"].(string))
+		output = append(output, networkmanagers.AddressPrefixItem{
+			AddressPrefix:     utils.String(v["address_prefix"].(string)),
+			AddressPrefixType: &addressPrefixType,
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerAdminRuleAddressPrefixItems(input *[]networkmanagers.AddressPrefixItem) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		addressPrefix := ""
+		if item.AddressPrefix != nil {
+			addressPrefix = *item.AddressPrefix
+		}
+
+		addressPrefixType := ""
+		if item.AddressPrefixType != nil {
+			addressPrefixType = string(*item.AddressPrefixType)
+		}
+
+		output = append(output, map[string]interface{}{
+			"address_prefix":      addressPrefix,
+			"address_prefix_type": addressPrefixType,
+		})
+	}
+
+	return output
+}
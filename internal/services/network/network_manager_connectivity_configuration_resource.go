@@ -0,0 +1,352 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/sdk/2021-05-01-preview/networkmanagers"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceNetworkManagerConnectivityConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Read:   resourceNetworkManagerConnectivityConfigurationRead,
+		Update: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Delete: resourceNetworkManagerConnectivityConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerConnectivityConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerID,
+			},
+
+			"connectivity_topology": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConnectivityTopology(), false),
+			},
+
+			"applies_to_group": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NetworkManagerNetworkGroupID,
+						},
+
+						"group_connectivity": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForGroupConnectivity(), false),
+						},
+
+						"global_mesh_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"use_hub_gateway": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"hub": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.VirtualNetworkID,
+						},
+
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Microsoft.Network/virtualNetworks",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"delete_existing_peering_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"global_mesh_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerConnectivityConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := parse.NetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.ConnectivityConfigurationsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_connectivity_configuration", id.ID())
+		}
+	}
+
+	topology := networkmanagers.ConnectivityTopology(d.Get("connectivity_topology").(string))
+	hubs := expandNetworkManagerConnectivityConfigurationHubs(d.Get("hub").([]interface{}))
+	if topology == networkmanagers.ConnectivityTopologyHubAndSpoke && len(*hubs) == 0 {
+		return fmt.Errorf("a `hub` block must be specified when `connectivity_topology` is `%s`", networkmanagers.ConnectivityTopologyHubAndSpoke)
+	}
+
+	deleteExistingPeering := networkmanagers.DeleteExistingPeeringFalse
+	if d.Get("delete_existing_peering_enabled").(bool) {
+		deleteExistingPeering = networkmanagers.DeleteExistingPeeringTrue
+	}
+
+	parameters := networkmanagers.ConnectivityConfiguration{
+		Properties: &networkmanagers.ConnectivityConfigurationProperties{
+			AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(d.Get("applies_to_group").([]interface{})),
+			ConnectivityTopology:  topology,
+			DeleteExistingPeering: &deleteExistingPeering,
+			Hubs:                  hubs,
+			IsGlobal:              expandNetworkManagerIsGlobal(d.Get("global_mesh_enabled").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.ConnectivityConfigurationsCreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerConnectivityConfigurationRead(d, meta)
+}
+
+func resourceNetworkManagerConnectivityConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ConnectivityConfigurationsGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ConnectivityConfigurationName)
+	d.Set("network_manager_id", parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("connectivity_topology", string(props.ConnectivityTopology))
+			d.Set("description", props.Description)
+			d.Set("delete_existing_peering_enabled", props.DeleteExistingPeering != nil && *props.DeleteExistingPeering == networkmanagers.DeleteExistingPeeringTrue)
+			d.Set("global_mesh_enabled", flattenNetworkManagerIsGlobal(props.IsGlobal))
+
+			appliesToGroups, err := flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("applies_to_group", appliesToGroups); err != nil {
+				return fmt.Errorf("setting `applies_to_group`: %+v", err)
+			}
+
+			if err := d.Set("hub", flattenNetworkManagerConnectivityConfigurationHubs(props.Hubs)); err != nil {
+				return fmt.Errorf("setting `hub`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerConnectivityConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.ConnectivityConfigurationsDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []interface{}) []networkmanagers.ConnectivityGroupItem {
+	output := make([]networkmanagers.ConnectivityGroupItem, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		useHubGateway := networkmanagers.UseHubGatewayFalse
+		if v["use_hub_gateway"].(bool) {
+			useHubGateway = networkmanagers.UseHubGatewayTrue
+		}
+
+		output = append(output, networkmanagers.ConnectivityGroupItem{
+			GroupConnectivity: networkmanagers.GroupConnectivity(v["group_connectivity"].(string)),
+			IsGlobal:          expandNetworkManagerIsGlobal(v["global_mesh_enabled"].(bool)),
+			NetworkGroupId:    v["network_group_id"].(string),
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+
+	return output
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input []networkmanagers.ConnectivityGroupItem) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		networkGroupId, err := parse.NetworkManagerNetworkGroupIDInsensitively(item.NetworkGroupId)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, map[string]interface{}{
+			"network_group_id":    networkGroupId.ID(),
+			"group_connectivity":  string(item.GroupConnectivity),
+			"global_mesh_enabled": flattenNetworkManagerIsGlobal(item.IsGlobal),
+			"use_hub_gateway":     item.UseHubGateway != nil && *item.UseHubGateway == networkmanagers.UseHubGatewayTrue,
+		})
+	}
+
+	return output, nil
+}
+
+func expandNetworkManagerConnectivityConfigurationHubs(input []interface{}) *[]networkmanagers.Hub {
+	output := make([]networkmanagers.Hub, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		output = append(output, networkmanagers.Hub{
+			ResourceId:   utils.String(v["resource_id"].(string)),
+			ResourceType: utils.String(v["resource_type"].(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerConnectivityConfigurationHubs(input *[]networkmanagers.Hub) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		resourceId := ""
+		if item.ResourceId != nil {
+			resourceId = *item.ResourceId
+			if vnetId, err := parse.VirtualNetworkIDInsensitively(resourceId); err == nil {
+				resourceId = vnetId.ID()
+			}
+		}
+
+		resourceType := ""
+		if item.ResourceType != nil {
+			resourceType = *item.ResourceType
+			// the API returns the resource type in lower case
+			if strings.EqualFold(resourceType, "Microsoft.Network/virtualNetworks") {
+				resourceType = "Microsoft.Network/virtualNetworks"
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":   resourceId,
+			"resource_type": resourceType,
+		})
+	}
+
+	return output
+}
+
+func expandNetworkManagerIsGlobal(input bool) *networkmanagers.IsGlobal {
+	output := networkmanagers.IsGlobalFalse
+	if input {
+		output = networkmanagers.IsGlobalTrue
+	}
+	return &output
+}
+
+func flattenNetworkManagerIsGlobal(input *networkmanagers.IsGlobal) bool {
+	return input != nil && *input == networkmanagers.IsGlobalTrue
+}
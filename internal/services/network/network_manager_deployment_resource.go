@@ -0,0 +1,255 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/sdk/2021-05-01-preview/networkmanagers"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+const networkManagerResourceName = "azurerm_network_manager"
+
+func resourceNetworkManagerDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerDeploymentCreateUpdate,
+		Read:   resourceNetworkManagerDeploymentRead,
+		Update: resourceNetworkManagerDeploymentCreateUpdate,
+		Delete: resourceNetworkManagerDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"scope_access": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+			},
+
+			"configuration_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			// changing a Configuration doesn't change its ID, so this allows the Deployment to be re-committed
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerDeploymentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := parse.NetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, location.Normalize(d.Get("location").(string)), d.Get("scope_access").(string))
+
+	// commits against the same Network Manager conflict with one another
+	locks.ByName(networkManagerId.Name, networkManagerResourceName)
+	defer locks.UnlockByName(networkManagerId.Name, networkManagerResourceName)
+
+	if d.IsNewResource() {
+		existing, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+
+		if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+			return tf.ImportAsExistsError("azurerm_network_manager_deployment", id.ID())
+		}
+	}
+
+	configurationIds := utils.ExpandStringSlice(d.Get("configuration_ids").([]interface{}))
+	if err := commitNetworkManagerDeployment(ctx, client, id, configurationIds); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerDeploymentRead(d, meta)
+}
+
+func resourceNetworkManagerDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	status, err := getNetworkManagerDeploymentStatus(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if status == nil || status.ConfigurationIds == nil || len(*status.ConfigurationIds) == 0 {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_manager_id", parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID())
+	d.Set("location", location.Normalize(id.LocationName))
+	d.Set("scope_access", id.DeploymentName)
+	d.Set("configuration_ids", utils.FlattenStringSlice(status.ConfigurationIds))
+
+	return nil
+}
+
+func resourceNetworkManagerDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkManagerName, networkManagerResourceName)
+	defer locks.UnlockByName(id.NetworkManagerName, networkManagerResourceName)
+
+	// committing an empty set of Configurations removes the Deployment from the region
+	if err := commitNetworkManagerDeployment(ctx, client, *id, &[]string{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds *[]string) error {
+	networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagers.NetworkManagerCommit{
+		CommitType:       networkmanagers.ConfigurationType(id.DeploymentName),
+		ConfigurationIds: configurationIds,
+		TargetLocations:  []string{id.LocationName},
+	}
+
+	if err := client.CommitThenPoll(ctx, networkManagerId, input); err != nil {
+		return fmt.Errorf("committing %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(networkmanagers.DeploymentStatusNotStarted),
+			string(networkmanagers.DeploymentStatusDeploying),
+		},
+		Target:     []string{string(networkmanagers.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentStatusRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deployed: %+v", id, err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentStatusRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		if status == nil || status.DeploymentStatus == nil {
+			return status, string(networkmanagers.DeploymentStatusNotStarted), nil
+		}
+
+		if *status.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+			errorMessage := ""
+			if status.ErrorMessage != nil {
+				errorMessage = *status.ErrorMessage
+			}
+			return status, string(*status.DeploymentStatus), fmt.Errorf("deployment of %s failed: %s", id, errorMessage)
+		}
+
+		return status, string(*status.DeploymentStatus), nil
+	}
+}
+
+func getNetworkManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	networkManagerId := parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(id.DeploymentName)},
+		Regions:         &[]string{id.LocationName},
+	}
+
+	resp, err := client.ListDeploymentStatus(ctx, networkManagerId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, item := range *resp.Model.Value {
+		if item.Region == nil || !strings.EqualFold(location.Normalize(*item.Region), location.Normalize(id.LocationName)) {
+			continue
+		}
+		if item.DeploymentType == nil || !strings.EqualFold(string(*item.DeploymentType), id.DeploymentName) {
+			continue
+		}
+
+		return &item, nil
+	}
+
+	return nil, nil
+}
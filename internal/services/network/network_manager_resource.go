@@ -0,0 +1,262 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	managementGroupValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/managementgroup/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/sdk/2021-05-01-preview/networkmanagers"
+	subscriptionValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/subscription/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourceNetworkManager() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerCreateUpdate,
+		Read:   resourceNetworkManagerRead,
+		Update: resourceNetworkManagerCreateUpdate,
+		Delete: resourceNetworkManagerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"scope": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"management_group_ids": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: managementGroupValidate.ManagementGroupID,
+							},
+						},
+
+						"subscription_ids": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: subscriptionValidate.SubscriptionID,
+							},
+						},
+					},
+				},
+			},
+
+			"scope_accesses": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(networkmanagers.ConfigurationScopeAccessConnectivity),
+						string(networkmanagers.ConfigurationScopeAccessSecurityAdmin),
+					}, false),
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkManagerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkManagerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager", id.ID())
+		}
+	}
+
+	scopeAccesses := make([]networkmanagers.ConfigurationScopeAccess, 0)
+	for _, v := range d.Get("scope_accesses").([]interface{}) {
+		scopeAccesses = append(scopeAccesses, networkmanagers.ConfigurationScopeAccess(v.(string)))
+	}
+
+	parameters := networkmanagers.NetworkManager{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &networkmanagers.NetworkManagerProperties{
+			NetworkManagerScopeAccesses: scopeAccesses,
+			NetworkManagerScopes:        expandNetworkManagerScope(d.Get("scope").([]interface{})),
+		},
+		Tags: expandNetworkManagerTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerRead(d, meta)
+}
+
+func resourceNetworkManagerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+
+			scopeAccesses := make([]interface{}, 0)
+			for _, v := range props.NetworkManagerScopeAccesses {
+				scopeAccesses = append(scopeAccesses, string(v))
+			}
+			d.Set("scope_accesses", scopeAccesses)
+
+			if err := d.Set("scope", flattenNetworkManagerScope(props.NetworkManagerScopes)); err != nil {
+				return fmt.Errorf("setting `scope`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenNetworkManagerTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerScope(input []interface{}) networkmanagers.NetworkManagerPropertiesScopes {
+	if len(input) == 0 || input[0] == nil {
+		return networkmanagers.NetworkManagerPropertiesScopes{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return networkmanagers.NetworkManagerPropertiesScopes{
+		ManagementGroups: utils.ExpandStringSlice(raw["management_group_ids"].([]interface{})),
+		Subscriptions:    utils.ExpandStringSlice(raw["subscription_ids"].([]interface{})),
+	}
+}
+
+func flattenNetworkManagerScope(input networkmanagers.NetworkManagerPropertiesScopes) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"management_group_ids": utils.FlattenStringSlice(input.ManagementGroups),
+			"subscription_ids":     utils.FlattenStringSlice(input.Subscriptions),
+		},
+	}
+}
+
+func flattenNetworkManagerTags(input *map[string]string) map[string]*string {
+	output := make(map[string]*string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
+
+func expandNetworkManagerTags(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return &output
+}
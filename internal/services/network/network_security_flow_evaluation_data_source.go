@@ -0,0 +1,387 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/flowevaluation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceNetworkSecurityFlowEvaluation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkSecurityFlowEvaluationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"subnet_network_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+				AtLeastOneOf: []string{"subnet_network_security_group_id", "network_interface_network_security_group_id"},
+			},
+
+			"network_interface_network_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+				AtLeastOneOf: []string{"subnet_network_security_group_id", "network_interface_network_security_group_id"},
+			},
+
+			// used to expand the `VirtualNetwork` Service Tag
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+			},
+
+			// used to expand the other Service Tags, defaults to the location of the Network Security Group
+			"location": location.SchemaOptional(),
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(flowevaluation.DirectionInbound),
+					string(flowevaluation.DirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleProtocolAh),
+					string(network.SecurityRuleProtocolEsp),
+					string(network.SecurityRuleProtocolIcmp),
+					string(network.SecurityRuleProtocolTCP),
+					string(network.SecurityRuleProtocolUDP),
+				}, false),
+			},
+
+			"source_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"source_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"source_application_security_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
+			"destination_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"destination_application_security_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"network_security_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rule_priority": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"trace": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_security_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rule_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"access": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"matched": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"reason": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSecurityFlowEvaluationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	subnetGroup, subnetGroupLocation, err := retrieveNetworkSecurityGroupForFlowEvaluation(ctx, client.SecurityGroupClient, d.Get("subnet_network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	nicGroup, nicGroupLocation, err := retrieveNetworkSecurityGroupForFlowEvaluation(ctx, client.SecurityGroupClient, d.Get("network_interface_network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	env := flowevaluation.Environment{
+		VirtualNetworkAddressPrefixes: make([]string, 0),
+		ServiceTags:                   make(map[string][]string),
+	}
+
+	if v := d.Get("virtual_network_id").(string); v != "" {
+		prefixes, err := retrieveVirtualNetworkAddressPrefixesIncludingPeerings(ctx, client.VnetClient, v)
+		if err != nil {
+			return err
+		}
+		env.VirtualNetworkAddressPrefixes = prefixes
+	}
+
+	if serviceTags := flowevaluation.ServiceTags(subnetGroup, nicGroup); len(serviceTags) > 0 {
+		serviceTagsLocation := location.Normalize(d.Get("location").(string))
+		if serviceTagsLocation == "" {
+			serviceTagsLocation = subnetGroupLocation
+		}
+		if serviceTagsLocation == "" {
+			serviceTagsLocation = nicGroupLocation
+		}
+
+		resp, err := client.ServiceTagsClient.List(ctx, serviceTagsLocation)
+		if err != nil {
+			return fmt.Errorf("listing Network Service Tags (Location %q): %+v", serviceTagsLocation, err)
+		}
+
+		if resp.Values != nil {
+			for _, item := range *resp.Values {
+				if item.Name == nil || item.Properties == nil || item.Properties.AddressPrefixes == nil {
+					continue
+				}
+				env.ServiceTags[*item.Name] = *item.Properties.AddressPrefixes
+			}
+		}
+	}
+
+	flow := flowevaluation.Flow{
+		Direction:                              flowevaluation.Direction(d.Get("direction").(string)),
+		Protocol:                               d.Get("protocol").(string),
+		SourceAddress:                          net.ParseIP(d.Get("source_address").(string)),
+		SourcePort:                             d.Get("source_port").(int),
+		SourceApplicationSecurityGroupIds:      *utils.ExpandStringSlice(d.Get("source_application_security_group_ids").([]interface{})),
+		DestinationAddress:                     net.ParseIP(d.Get("destination_address").(string)),
+		DestinationPort:                        d.Get("destination_port").(int),
+		DestinationApplicationSecurityGroupIds: *utils.ExpandStringSlice(d.Get("destination_application_security_group_ids").([]interface{})),
+	}
+
+	result, err := flowevaluation.Evaluate(flow, env, subnetGroup, nicGroup)
+	if err != nil {
+		return fmt.Errorf("evaluating flow: %+v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("access", string(result.Access))
+	d.Set("network_security_group_id", result.SecurityGroupId)
+	d.Set("rule_name", result.RuleName)
+	d.Set("rule_priority", result.RulePriority)
+
+	if err := d.Set("trace", flattenNetworkSecurityFlowEvaluationTrace(result.Trace)); err != nil {
+		return fmt.Errorf("setting `trace`: %+v", err)
+	}
+
+	return nil
+}
+
+func retrieveNetworkSecurityGroupForFlowEvaluation(ctx context.Context, client *network.SecurityGroupsClient, input string) (*flowevaluation.SecurityGroup, string, error) {
+	if input == "" {
+		return nil, "", nil
+	}
+
+	id, err := parse.NetworkSecurityGroupID(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, "", fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	group := flowevaluation.SecurityGroup{
+		ID:    id.ID(),
+		Rules: make([]flowevaluation.Rule, 0),
+	}
+
+	if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			group.Rules = append(group.Rules, expandNetworkSecurityFlowEvaluationRule(rule))
+		}
+	}
+
+	return &group, location.NormalizeNilable(resp.Location), nil
+}
+
+func retrieveVirtualNetworkAddressPrefixesIncludingPeerings(ctx context.Context, client *network.VirtualNetworksClient, input string) ([]string, error) {
+	id, err := parse.VirtualNetworkID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the `VirtualNetwork` Service Tag also covers the address space of any peered Virtual Networks
+	prefixes := make([]string, 0)
+	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			prefixes = append(prefixes, *props.AddressSpace.AddressPrefixes...)
+		}
+
+		if props.VirtualNetworkPeerings != nil {
+			for _, peering := range *props.VirtualNetworkPeerings {
+				if peering.VirtualNetworkPeeringPropertiesFormat == nil || peering.RemoteAddressSpace == nil || peering.RemoteAddressSpace.AddressPrefixes == nil {
+					continue
+				}
+				prefixes = append(prefixes, *peering.RemoteAddressSpace.AddressPrefixes...)
+			}
+		}
+	}
+
+	return prefixes, nil
+}
+
+func expandNetworkSecurityFlowEvaluationRule(input network.SecurityRule) flowevaluation.Rule {
+	output := flowevaluation.Rule{
+		Name: utils.NormalizeNilableString(input.Name),
+	}
+
+	props := input.SecurityRulePropertiesFormat
+	if props == nil {
+		return output
+	}
+
+	output.Access = flowevaluation.Access(props.Access)
+	output.Direction = flowevaluation.Direction(props.Direction)
+	output.Protocol = string(props.Protocol)
+	if props.Priority != nil {
+		output.Priority = int(*props.Priority)
+	}
+
+	output.SourceAddressPrefixes = combineNetworkSecurityFlowEvaluationValues(props.SourceAddressPrefix, props.SourceAddressPrefixes)
+	output.SourcePortRanges = combineNetworkSecurityFlowEvaluationValues(props.SourcePortRange, props.SourcePortRanges)
+	output.DestinationAddressPrefixes = combineNetworkSecurityFlowEvaluationValues(props.DestinationAddressPrefix, props.DestinationAddressPrefixes)
+	output.DestinationPortRanges = combineNetworkSecurityFlowEvaluationValues(props.DestinationPortRange, props.DestinationPortRanges)
+
+	if props.SourceApplicationSecurityGroups != nil {
+		for _, asg := range *props.SourceApplicationSecurityGroups {
+			if asg.ID != nil {
+				output.SourceApplicationSecurityGroupIds = append(output.SourceApplicationSecurityGroupIds, *asg.ID)
+			}
+		}
+	}
+
+	if props.DestinationApplicationSecurityGroups != nil {
+		for _, asg := range *props.DestinationApplicationSecurityGroups {
+			if asg.ID != nil {
+				output.DestinationApplicationSecurityGroupIds = append(output.DestinationApplicationSecurityGroupIds, *asg.ID)
+			}
+		}
+	}
+
+	return output
+}
+
+// combineNetworkSecurityFlowEvaluationValues merges the singular and plural forms of a Security Rule field,
+// only one of which is set by the API
+func combineNetworkSecurityFlowEvaluationValues(single *string, multiple *[]string) []string {
+	output := make([]string, 0)
+	if single != nil && *single != "" {
+		output = append(output, *single)
+	}
+	if multiple != nil {
+		output = append(output, *multiple...)
+	}
+	return output
+}
+
+func flattenNetworkSecurityFlowEvaluationTrace(input []flowevaluation.TraceEntry) []interface{} {
+	output := make([]interface{}, 0)
+	for _, entry := range input {
+		output = append(output, map[string]interface{}{
+			"network_security_group_id": entry.SecurityGroupId,
+			"rule_name":                 entry.RuleName,
+			"priority":                  entry.Priority,
+			"access":                    string(entry.Access),
+			"matched":                   entry.Matched,
+			"reason":                    entry.Reason,
+		})
+	}
+	return output
+}
@@ -0,0 +1,181 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type NetworkSecurityFlowEvaluationDataSource struct {
+}
+
+func TestAccDataSourceNetworkSecurityFlowEvaluation_inbound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_evaluation", "test")
+	r := NetworkSecurityFlowEvaluationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.inbound(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("deny-ssh"),
+				check.That(data.ResourceName).Key("rule_priority").HasValue("200"),
+				check.That(data.ResourceName).Key("trace.#").HasValue("1"),
+				check.That("data.azurerm_network_security_flow_evaluation.allowed").Key("access").HasValue("Allow"),
+				check.That("data.azurerm_network_security_flow_evaluation.allowed").Key("rule_name").HasValue("allow-web-asg"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkSecurityFlowEvaluation_outboundServiceTag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_flow_evaluation", "test")
+	r := NetworkSecurityFlowEvaluationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.outboundServiceTag(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("AllowVnetOutBound"),
+			),
+		},
+	})
+}
+
+func (NetworkSecurityFlowEvaluationDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_application_security_group" "test" {
+  name                = "acctest-asg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_group" "subnet" {
+  name                = "acctestnsg-subnet-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "deny-ssh"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-https"
+    priority                   = 300
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "Internet"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "deny-storage"
+    priority                   = 100
+    direction                  = "Outbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "Storage"
+  }
+}
+
+resource "azurerm_network_security_group" "nic" {
+  name                = "acctestnsg-nic-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                                       = "allow-web-asg"
+    priority                                   = 100
+    direction                                  = "Inbound"
+    access                                     = "Allow"
+    protocol                                   = "Tcp"
+    source_port_range                          = "*"
+    destination_port_range                     = "443"
+    source_address_prefix                      = "Internet"
+    destination_application_security_group_ids = [azurerm_application_security_group.test.id]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r NetworkSecurityFlowEvaluationDataSource) inbound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_flow_evaluation" "test" {
+  subnet_network_security_group_id            = azurerm_network_security_group.subnet.id
+  network_interface_network_security_group_id = azurerm_network_security_group.nic.id
+  virtual_network_id                          = azurerm_virtual_network.test.id
+  direction                                   = "Inbound"
+  protocol                                    = "Tcp"
+  source_address                              = "203.0.113.10"
+  source_port                                 = 50000
+  destination_address                         = "10.0.1.4"
+  destination_port                            = 22
+}
+
+data "azurerm_network_security_flow_evaluation" "allowed" {
+  subnet_network_security_group_id            = azurerm_network_security_group.subnet.id
+  network_interface_network_security_group_id = azurerm_network_security_group.nic.id
+  virtual_network_id                          = azurerm_virtual_network.test.id
+  direction                                   = "Inbound"
+  protocol                                    = "Tcp"
+  source_address                              = "203.0.113.10"
+  source_port                                 = 50000
+  destination_address                         = "10.0.1.4"
+  destination_port                            = 443
+  destination_application_security_group_ids  = [azurerm_application_security_group.test.id]
+}
+`, r.template(data))
+}
+
+func (r NetworkSecurityFlowEvaluationDataSource) outboundServiceTag(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_flow_evaluation" "test" {
+  subnet_network_security_group_id            = azurerm_network_security_group.subnet.id
+  network_interface_network_security_group_id = azurerm_network_security_group.nic.id
+  virtual_network_id                          = azurerm_virtual_network.test.id
+  direction                                   = "Outbound"
+  protocol                                    = "Tcp"
+  source_address                              = "10.0.1.4"
+  source_port                                 = 50000
+  destination_address                         = "10.0.2.4"
+  destination_port                            = 443
+}
+`, r.template(data))
+}
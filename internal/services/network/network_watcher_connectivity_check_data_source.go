@@ -0,0 +1,477 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceNetworkWatcherConnectivityCheck() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherConnectivityCheckRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			// checking connectivity is a long running operation which sends multiple probes
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"destination": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+					string(network.ProtocolIcmp),
+					string(network.ProtocolTCP),
+				}, false),
+			},
+
+			"preferred_ip_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPVersionIPv4),
+					string(network.IPVersionIPv6),
+				}, false),
+			},
+
+			"http_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"method": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(network.HTTPMethodGet),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.HTTPMethodGet),
+							}, false),
+						},
+
+						"header": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"value": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"valid_status_codes": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeInt,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+						},
+					},
+				},
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"avg_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"min_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"max_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_sent": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_failed": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"hop": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"link": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"next_hop_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"link_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"resource_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"round_trip_time_min": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"round_trip_time_avg": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"round_trip_time_max": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"issue": connectivityIssueSchema(),
+								},
+							},
+						},
+
+						"issue": connectivityIssueSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func connectivityIssueSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"severity": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"context": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeMap,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherConnectivityCheckRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.ConnectivityParameters{
+		Source:                expandNetworkWatcherConnectivitySource(d.Get("source").([]interface{})),
+		Destination:           expandNetworkWatcherConnectivityDestination(d.Get("destination").([]interface{})),
+		Protocol:              network.Protocol(d.Get("protocol").(string)),
+		PreferredIPVersion:    network.IPVersion(d.Get("preferred_ip_version").(string)),
+		ProtocolConfiguration: expandNetworkWatcherConnectivityProtocolConfiguration(d.Get("http_configuration").([]interface{})),
+	}
+
+	future, err := client.CheckConnectivity(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("checking connectivity using %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for connectivity check using %s: %+v", *id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of connectivity check using %s: %+v", *id, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("network_watcher_id", id.ID())
+	d.Set("connection_status", string(result.ConnectionStatus))
+	d.Set("avg_latency_in_ms", int(utils.NormaliseNilableInt32(result.AvgLatencyInMs)))
+	d.Set("min_latency_in_ms", int(utils.NormaliseNilableInt32(result.MinLatencyInMs)))
+	d.Set("max_latency_in_ms", int(utils.NormaliseNilableInt32(result.MaxLatencyInMs)))
+	d.Set("probes_sent", int(utils.NormaliseNilableInt32(result.ProbesSent)))
+	d.Set("probes_failed", int(utils.NormaliseNilableInt32(result.ProbesFailed)))
+
+	if err := d.Set("hop", flattenNetworkWatcherConnectivityHops(result.Hops)); err != nil {
+		return fmt.Errorf("setting `hop`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandNetworkWatcherConnectivitySource(input []interface{}) *network.ConnectivitySource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := network.ConnectivitySource{
+		ResourceID: utils.String(v["resource_id"].(string)),
+	}
+	if port := v["port"].(int); port != 0 {
+		output.Port = utils.Int32(int32(port))
+	}
+
+	return &output
+}
+
+func expandNetworkWatcherConnectivityDestination(input []interface{}) *network.ConnectivityDestination {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := network.ConnectivityDestination{}
+	if resourceId := v["resource_id"].(string); resourceId != "" {
+		output.ResourceID = utils.String(resourceId)
+	}
+	if address := v["address"].(string); address != "" {
+		output.Address = utils.String(address)
+	}
+	if port := v["port"].(int); port != 0 {
+		output.Port = utils.Int32(int32(port))
+	}
+
+	return &output
+}
+
+func expandNetworkWatcherConnectivityProtocolConfiguration(input []interface{}) *network.ProtocolConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	headers := make([]network.HTTPHeader, 0)
+	for _, item := range v["header"].([]interface{}) {
+		header := item.(map[string]interface{})
+		headers = append(headers, network.HTTPHeader{
+			Name:  utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+
+	return &network.ProtocolConfiguration{
+		HTTPConfiguration: &network.HTTPConfiguration{
+			Method:           network.HTTPMethod(v["method"].(string)),
+			Headers:          &headers,
+			ValidStatusCodes: utils.ExpandInt32Slice(v["valid_status_codes"].([]interface{})),
+		},
+	}
+}
+
+func flattenNetworkWatcherConnectivityHops(input *[]network.ConnectivityHop) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		links := make([]interface{}, 0)
+		if item.Links != nil {
+			for _, link := range *item.Links {
+				roundTripTimeMin, roundTripTimeAvg, roundTripTimeMax := 0, 0, 0
+				if props := link.HopLinkProperties; props != nil {
+					if props.RoundTripTimeMin != nil {
+						roundTripTimeMin = int(*props.RoundTripTimeMin)
+					}
+					if props.RoundTripTimeAvg != nil {
+						roundTripTimeAvg = int(*props.RoundTripTimeAvg)
+					}
+					if props.RoundTripTimeMax != nil {
+						roundTripTimeMax = int(*props.RoundTripTimeMax)
+					}
+				}
+
+				links = append(links, map[string]interface{}{
+					"next_hop_id":         utils.NormalizeNilableString(link.NextHopID),
+					"link_type":           utils.NormalizeNilableString(link.LinkType),
+					"resource_id":         utils.NormalizeNilableString(link.ResourceID),
+					"round_trip_time_min": roundTripTimeMin,
+					"round_trip_time_avg": roundTripTimeAvg,
+					"round_trip_time_max": roundTripTimeMax,
+					"issue":               flattenNetworkWatcherConnectivityIssues(link.Issues),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":           utils.NormalizeNilableString(item.ID),
+			"type":         utils.NormalizeNilableString(item.Type),
+			"address":      utils.NormalizeNilableString(item.Address),
+			"resource_id":  utils.NormalizeNilableString(item.ResourceID),
+			"next_hop_ids": utils.FlattenStringSlice(item.NextHopIds),
+			"link":         links,
+			"issue":        flattenNetworkWatcherConnectivityIssues(item.Issues),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkWatcherConnectivityIssues(input *[]network.ConnectivityIssue) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		contexts := make([]interface{}, 0)
+		if item.Context != nil {
+			for _, issueContext := range *item.Context {
+				contexts = append(contexts, utils.FlattenMapStringPtrString(issueContext))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"origin":   string(item.Origin),
+			"severity": string(item.Severity),
+			"type":     string(item.Type),
+			"context":  contexts,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,86 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_address(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.address(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("probes_sent").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_virtualMachine(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.virtualMachine(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").HasValue("Reachable"),
+				check.That(data.ResourceName).Key("probes_failed").HasValue("0"),
+				check.That(data.ResourceName).Key("hop.0.resource_id").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) address(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  protocol           = "Tcp"
+
+  source {
+    resource_id = azurerm_virtual_machine.test.id
+  }
+
+  destination {
+    address = "www.bing.com"
+    port    = 443
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) virtualMachine(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  protocol           = "Icmp"
+
+  source {
+    resource_id = azurerm_virtual_machine.test.id
+  }
+
+  destination {
+    resource_id = azurerm_virtual_machine.test.id
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
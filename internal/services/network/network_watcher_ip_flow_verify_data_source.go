@@ -0,0 +1,144 @@
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceNetworkWatcherIPFlowVerify() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherIPFlowVerifyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// only required when the Virtual Machine has multiple Network Interfaces
+			"target_network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.DirectionInbound),
+					string(network.DirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPFlowProtocolTCP),
+					string(network.IPFlowProtocolUDP),
+				}, false),
+			},
+
+			"local_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_port": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"remote_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"remote_port": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherIPFlowVerifyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VerificationIPFlowParameters{
+		TargetResourceID: utils.String(d.Get("target_resource_id").(string)),
+		Direction:        network.Direction(d.Get("direction").(string)),
+		Protocol:         network.IPFlowProtocol(d.Get("protocol").(string)),
+		LocalIPAddress:   utils.String(d.Get("local_ip_address").(string)),
+		LocalPort:        utils.String(strconv.Itoa(d.Get("local_port").(int))),
+		RemoteIPAddress:  utils.String(d.Get("remote_ip_address").(string)),
+		RemotePort:       utils.String(strconv.Itoa(d.Get("remote_port").(int))),
+	}
+	if v := d.Get("target_network_interface_id").(string); v != "" {
+		parameters.TargetNicResourceID = utils.String(v)
+	}
+
+	future, err := client.VerifyIPFlow(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("verifying IP Flow using %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for IP Flow verification using %s: %+v", *id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of IP Flow verification using %s: %+v", *id, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("network_watcher_id", id.ID())
+	d.Set("access", string(result.Access))
+	d.Set("rule_name", utils.NormalizeNilableString(result.RuleName))
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Outbound", 443),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+			),
+		},
+		{
+			Config: r.basic(data, "Inbound", 22),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").Exists(),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) basic(data acceptance.TestData, direction string, port int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.test.id
+  direction          = "%s"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.test.private_ip_address
+  local_port         = %d
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = 443
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data), direction, port)
+}
@@ -0,0 +1,62 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type NetworkWatcherNextHopDataSource struct{}
+
+func testAccDataSourceNetworkWatcherNextHop_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("VnetLocal"),
+			),
+		},
+		{
+			Config: r.internet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("Internet"),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherNextHopDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id     = azurerm_network_watcher.test.id
+  target_resource_id     = azurerm_virtual_machine.test.id
+  source_ip_address      = azurerm_network_interface.test.private_ip_address
+  destination_ip_address = "10.0.2.10"
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
+
+func (NetworkWatcherNextHopDataSource) internet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id          = azurerm_network_watcher.test.id
+  target_resource_id          = azurerm_virtual_machine.test.id
+  target_network_interface_id = azurerm_network_interface.test.id
+  source_ip_address           = azurerm_network_interface.test.private_ip_address
+  destination_ip_address      = "13.107.21.200"
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
@@ -67,6 +67,16 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"withFilters":                testAccNetworkPacketCapture_withFilters,
 			"requiresImport":             testAccNetworkPacketCapture_requiresImport,
 		},
+		"ConnectivityCheck": {
+			"address":        testAccDataSourceNetworkWatcherConnectivityCheck_address,
+			"virtualMachine": testAccDataSourceNetworkWatcherConnectivityCheck_virtualMachine,
+		},
+		"NextHop": {
+			"basic": testAccDataSourceNetworkWatcherNextHop_basic,
+		},
+		"IPFlowVerify": {
+			"basic": testAccDataSourceNetworkWatcherIPFlowVerify_basic,
+		},
 		"FlowLog": {
 			"basic":                 testAccNetworkWatcherFlowLog_basic,
 			"disabled":              testAccNetworkWatcherFlowLog_disabled,
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+)
+
+type NetworkManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	LocationName       string
+	DeploymentName     string
+}
+
+func NewNetworkManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, locationName, deploymentName string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		LocationName:       locationName,
+		DeploymentName:     deploymentName,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Deployment Name %q", id.DeploymentName),
+		fmt.Sprintf("Location Name %q", id.LocationName),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/locations/%s/deployments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.LocationName, id.DeploymentName)
+}
+
+// NetworkManagerDeploymentID parses a NetworkManagerDeployment ID into an NetworkManagerDeploymentId struct
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkManagerDeploymentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.LocationName, err = id.PopSegment("locations"); err != nil {
+		return nil, err
+	}
+	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,121 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+)
+
+func dataSourcePrivateEndpointDnsZoneNames() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateEndpointDnsZoneNamesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subresource_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"environment": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_dns_zone_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"mapping": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subresource_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_dns_zone_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateEndpointDnsZoneNamesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	environmentName := meta.(*clients.Client).Account.Environment.Name
+	_, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceType := d.Get("resource_type").(string)
+	subresourceName := d.Get("subresource_name").(string)
+
+	mappings, err := privateEndpointDnsZoneMappings(environmentName, resourceType, subresourceName)
+	if err != nil {
+		return err
+	}
+
+	zoneNames := make([]string, 0)
+	seen := make(map[string]struct{})
+	flattened := make([]interface{}, 0)
+	for _, mapping := range mappings {
+		for _, zoneName := range mapping.ZoneNames {
+			if _, ok := seen[zoneName]; !ok {
+				seen[zoneName] = struct{}{}
+				zoneNames = append(zoneNames, zoneName)
+			}
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"resource_type":          mapping.ResourceType,
+			"subresource_name":       mapping.SubresourceName,
+			"private_dns_zone_names": mapping.ZoneNames,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("privateEndpointDnsZoneNames/%s/%s/%s", environmentName, strings.ToLower(resourceType), strings.ToLower(subresourceName)))
+
+	d.Set("environment", environmentName)
+
+	if err := d.Set("private_dns_zone_names", zoneNames); err != nil {
+		return fmt.Errorf("setting `private_dns_zone_names`: %+v", err)
+	}
+
+	if err := d.Set("mapping", flattened); err != nil {
+		return fmt.Errorf("setting `mapping`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsZoneNamesDataSource struct{}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.all(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("environment").HasValue("AzurePublicCloud"),
+				check.That(data.ResourceName).Key("mapping.#").Exists(),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("mapping.#").HasValue("1"),
+				check.That(data.ResourceName).Key("mapping.0.resource_type").HasValue("Microsoft.Storage/storageAccounts"),
+				check.That(data.ResourceName).Key("mapping.0.subresource_name").HasValue("blob"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.0").HasValue("privatelink.blob.core.windows.net"),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) all() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {}
+`
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) filtered() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  resource_type    = "Microsoft.Storage/storageAccounts"
+  subresource_name = "blob"
+}
+`
+}
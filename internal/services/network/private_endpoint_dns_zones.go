@@ -0,0 +1,229 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// privateEndpointDnsZoneNames maps the Resource Type and Subresource Name of a Private Endpoint connection to the
+// Private DNS Zones which should be used for it, for each of the supported Cloud Environments.
+//
+// The keys are in the format `{Resource Provider}/{Resource Type}/{Subresource Name}` - and are compared case-insensitively
+var privateEndpointDnsZoneNames = map[string]map[string][]string{
+	azure.PublicCloud.Name: {
+		"Microsoft.AppConfiguration/configurationStores/configurationStores": {"privatelink.azconfig.io"},
+		"Microsoft.Automation/automationAccounts/DSCAndHybridWorker":         {"privatelink.azure-automation.net"},
+		"Microsoft.Automation/automationAccounts/Webhook":                    {"privatelink.azure-automation.net"},
+		"Microsoft.Cache/redis/redisCache":                                   {"privatelink.redis.cache.windows.net"},
+		"Microsoft.CognitiveServices/accounts/account":                       {"privatelink.cognitiveservices.azure.com"},
+		"Microsoft.ContainerRegistry/registries/registry":                    {"privatelink.azurecr.io"},
+		"Microsoft.DataFactory/factories/dataFactory":                        {"privatelink.datafactory.azure.net"},
+		"Microsoft.DataFactory/factories/portal":                             {"privatelink.adf.azure.com"},
+		"Microsoft.DBforMariaDB/servers/mariadbServer":                       {"privatelink.mariadb.database.azure.com"},
+		"Microsoft.DBforMySQL/servers/mysqlServer":                           {"privatelink.mysql.database.azure.com"},
+		"Microsoft.DBforPostgreSQL/servers/postgresqlServer":                 {"privatelink.postgres.database.azure.com"},
+		"Microsoft.DocumentDB/databaseAccounts/Cassandra":                    {"privatelink.cassandra.cosmos.azure.com"},
+		"Microsoft.DocumentDB/databaseAccounts/Gremlin":                      {"privatelink.gremlin.cosmos.azure.com"},
+		"Microsoft.DocumentDB/databaseAccounts/MongoDB":                      {"privatelink.mongo.cosmos.azure.com"},
+		"Microsoft.DocumentDB/databaseAccounts/Sql":                          {"privatelink.documents.azure.com"},
+		"Microsoft.DocumentDB/databaseAccounts/Table":                        {"privatelink.table.cosmos.azure.com"},
+		"Microsoft.EventGrid/domains/domain":                                 {"privatelink.eventgrid.azure.net"},
+		"Microsoft.EventGrid/topics/topic":                                   {"privatelink.eventgrid.azure.net"},
+		"Microsoft.EventHub/namespaces/namespace":                            {"privatelink.servicebus.windows.net"},
+		"Microsoft.KeyVault/vaults/vault":                                    {"privatelink.vaultcore.azure.net"},
+		"Microsoft.MachineLearningServices/workspaces/amlworkspace":          {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+		"Microsoft.Search/searchServices/searchService":                      {"privatelink.search.windows.net"},
+		"Microsoft.ServiceBus/namespaces/namespace":                          {"privatelink.servicebus.windows.net"},
+		"Microsoft.SignalRService/signalR/signalr":                           {"privatelink.service.signalr.net"},
+		"Microsoft.Sql/servers/sqlServer":                                    {"privatelink.database.windows.net"},
+		"Microsoft.Storage/storageAccounts/blob":                             {"privatelink.blob.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/blob_secondary":                   {"privatelink.blob.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/dfs":                              {"privatelink.dfs.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/dfs_secondary":                    {"privatelink.dfs.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/file":                             {"privatelink.file.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/queue":                            {"privatelink.queue.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/queue_secondary":                  {"privatelink.queue.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/table":                            {"privatelink.table.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/table_secondary":                  {"privatelink.table.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/web":                              {"privatelink.web.core.windows.net"},
+		"Microsoft.Storage/storageAccounts/web_secondary":                    {"privatelink.web.core.windows.net"},
+		"Microsoft.Synapse/workspaces/Dev":                                   {"privatelink.dev.azuresynapse.net"},
+		"Microsoft.Synapse/workspaces/Sql":                                   {"privatelink.sql.azuresynapse.net"},
+		"Microsoft.Synapse/workspaces/SqlOnDemand":                           {"privatelink.sql.azuresynapse.net"},
+		"Microsoft.Web/sites/sites":                                          {"privatelink.azurewebsites.net"},
+	},
+	azure.ChinaCloud.Name: {
+		"Microsoft.AppConfiguration/configurationStores/configurationStores": {"privatelink.azconfig.azure.cn"},
+		"Microsoft.Automation/automationAccounts/DSCAndHybridWorker":         {"privatelink.azure-automation.cn"},
+		"Microsoft.Automation/automationAccounts/Webhook":                    {"privatelink.azure-automation.cn"},
+		"Microsoft.Cache/redis/redisCache":                                   {"privatelink.redis.cache.chinacloudapi.cn"},
+		"Microsoft.CognitiveServices/accounts/account":                       {"privatelink.cognitiveservices.azure.cn"},
+		"Microsoft.ContainerRegistry/registries/registry":                    {"privatelink.azurecr.cn"},
+		"Microsoft.DataFactory/factories/dataFactory":                        {"privatelink.datafactory.azure.cn"},
+		"Microsoft.DataFactory/factories/portal":                             {"privatelink.adf.azure.cn"},
+		"Microsoft.DBforMariaDB/servers/mariadbServer":                       {"privatelink.mariadb.database.chinacloudapi.cn"},
+		"Microsoft.DBforMySQL/servers/mysqlServer":                           {"privatelink.mysql.database.chinacloudapi.cn"},
+		"Microsoft.DBforPostgreSQL/servers/postgresqlServer":                 {"privatelink.postgres.database.chinacloudapi.cn"},
+		"Microsoft.DocumentDB/databaseAccounts/Cassandra":                    {"privatelink.cassandra.cosmos.azure.cn"},
+		"Microsoft.DocumentDB/databaseAccounts/Gremlin":                      {"privatelink.gremlin.cosmos.azure.cn"},
+		"Microsoft.DocumentDB/databaseAccounts/MongoDB":                      {"privatelink.mongo.cosmos.azure.cn"},
+		"Microsoft.DocumentDB/databaseAccounts/Sql":                          {"privatelink.documents.azure.cn"},
+		"Microsoft.DocumentDB/databaseAccounts/Table":                        {"privatelink.table.cosmos.azure.cn"},
+		"Microsoft.EventGrid/domains/domain":                                 {"privatelink.eventgrid.azure.cn"},
+		"Microsoft.EventGrid/topics/topic":                                   {"privatelink.eventgrid.azure.cn"},
+		"Microsoft.EventHub/namespaces/namespace":                            {"privatelink.servicebus.chinacloudapi.cn"},
+		"Microsoft.KeyVault/vaults/vault":                                    {"privatelink.vaultcore.azure.cn"},
+		"Microsoft.MachineLearningServices/workspaces/amlworkspace":          {"privatelink.api.ml.azure.cn", "privatelink.notebooks.chinacloudapi.cn"},
+		"Microsoft.Search/searchServices/searchService":                      {"privatelink.search.azure.cn"},
+		"Microsoft.ServiceBus/namespaces/namespace":                          {"privatelink.servicebus.chinacloudapi.cn"},
+		"Microsoft.SignalRService/signalR/signalr":                           {"privatelink.signalr.azure.cn"},
+		"Microsoft.Sql/servers/sqlServer":                                    {"privatelink.database.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/blob":                             {"privatelink.blob.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/blob_secondary":                   {"privatelink.blob.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/dfs":                              {"privatelink.dfs.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/dfs_secondary":                    {"privatelink.dfs.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/file":                             {"privatelink.file.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/queue":                            {"privatelink.queue.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/queue_secondary":                  {"privatelink.queue.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/table":                            {"privatelink.table.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/table_secondary":                  {"privatelink.table.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/web":                              {"privatelink.web.core.chinacloudapi.cn"},
+		"Microsoft.Storage/storageAccounts/web_secondary":                    {"privatelink.web.core.chinacloudapi.cn"},
+		"Microsoft.Synapse/workspaces/Dev":                                   {"privatelink.dev.azuresynapse.azure.cn"},
+		"Microsoft.Synapse/workspaces/Sql":                                   {"privatelink.sql.azuresynapse.azure.cn"},
+		"Microsoft.Synapse/workspaces/SqlOnDemand":                           {"privatelink.sql.azuresynapse.azure.cn"},
+		"Microsoft.Web/sites/sites":                                          {"privatelink.chinacloudsites.cn"},
+	},
+	azure.USGovernmentCloud.Name: {
+		"Microsoft.AppConfiguration/configurationStores/configurationStores": {"privatelink.azconfig.azure.us"},
+		"Microsoft.Automation/automationAccounts/DSCAndHybridWorker":         {"privatelink.azure-automation.us"},
+		"Microsoft.Automation/automationAccounts/Webhook":                    {"privatelink.azure-automation.us"},
+		"Microsoft.Cache/redis/redisCache":                                   {"privatelink.redis.cache.usgovcloudapi.net"},
+		"Microsoft.CognitiveServices/accounts/account":                       {"privatelink.cognitiveservices.azure.us"},
+		"Microsoft.ContainerRegistry/registries/registry":                    {"privatelink.azurecr.us"},
+		"Microsoft.DataFactory/factories/dataFactory":                        {"privatelink.datafactory.azure.us"},
+		"Microsoft.DataFactory/factories/portal":                             {"privatelink.adf.azure.us"},
+		"Microsoft.DBforMariaDB/servers/mariadbServer":                       {"privatelink.mariadb.database.usgovcloudapi.net"},
+		"Microsoft.DBforMySQL/servers/mysqlServer":                           {"privatelink.mysql.database.usgovcloudapi.net"},
+		"Microsoft.DBforPostgreSQL/servers/postgresqlServer":                 {"privatelink.postgres.database.usgovcloudapi.net"},
+		"Microsoft.DocumentDB/databaseAccounts/MongoDB":                      {"privatelink.mongo.cosmos.azure.us"},
+		"Microsoft.DocumentDB/databaseAccounts/Sql":                          {"privatelink.documents.azure.us"},
+		"Microsoft.EventGrid/domains/domain":                                 {"privatelink.eventgrid.azure.us"},
+		"Microsoft.EventGrid/topics/topic":                                   {"privatelink.eventgrid.azure.us"},
+		"Microsoft.EventHub/namespaces/namespace":                            {"privatelink.servicebus.usgovcloudapi.net"},
+		"Microsoft.KeyVault/vaults/vault":                                    {"privatelink.vaultcore.usgovcloudapi.net"},
+		"Microsoft.MachineLearningServices/workspaces/amlworkspace":          {"privatelink.api.ml.azure.us", "privatelink.notebooks.usgovcloudapi.net"},
+		"Microsoft.Search/searchServices/searchService":                      {"privatelink.search.windows.us"},
+		"Microsoft.ServiceBus/namespaces/namespace":                          {"privatelink.servicebus.usgovcloudapi.net"},
+		"Microsoft.SignalRService/signalR/signalr":                           {"privatelink.signalr.azure.us"},
+		"Microsoft.Sql/servers/sqlServer":                                    {"privatelink.database.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/blob":                             {"privatelink.blob.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/blob_secondary":                   {"privatelink.blob.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/dfs":                              {"privatelink.dfs.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/dfs_secondary":                    {"privatelink.dfs.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/file":                             {"privatelink.file.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/queue":                            {"privatelink.queue.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/queue_secondary":                  {"privatelink.queue.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/table":                            {"privatelink.table.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/table_secondary":                  {"privatelink.table.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/web":                              {"privatelink.web.core.usgovcloudapi.net"},
+		"Microsoft.Storage/storageAccounts/web_secondary":                    {"privatelink.web.core.usgovcloudapi.net"},
+		"Microsoft.Synapse/workspaces/Dev":                                   {"privatelink.dev.azuresynapse.usgovcloudapi.net"},
+		"Microsoft.Synapse/workspaces/Sql":                                   {"privatelink.sql.azuresynapse.usgovcloudapi.net"},
+		"Microsoft.Synapse/workspaces/SqlOnDemand":                           {"privatelink.sql.azuresynapse.usgovcloudapi.net"},
+		"Microsoft.Web/sites/sites":                                          {"privatelink.azurewebsites.us"},
+	},
+}
+
+type privateEndpointDnsZoneMapping struct {
+	ResourceType    string
+	SubresourceName string
+	ZoneNames       []string
+}
+
+// privateEndpointDnsZoneMappings returns the Private DNS Zone mappings available in the specified Cloud Environment,
+// optionally filtered (case-insensitively) to a Resource Type and/or Subresource Name, sorted by key
+func privateEndpointDnsZoneMappings(environmentName, resourceType, subresourceName string) ([]privateEndpointDnsZoneMapping, error) {
+	mappings, ok := privateEndpointDnsZoneNames[environmentName]
+	if !ok {
+		return nil, fmt.Errorf("Private DNS Zone mappings are not available for the Cloud Environment %q", environmentName)
+	}
+
+	results := make([]privateEndpointDnsZoneMapping, 0)
+	for key, zoneNames := range mappings {
+		split := strings.LastIndex(key, "/")
+		mapping := privateEndpointDnsZoneMapping{
+			ResourceType:    key[:split],
+			SubresourceName: key[split+1:],
+			ZoneNames:       zoneNames,
+		}
+
+		if resourceType != "" && !strings.EqualFold(mapping.ResourceType, resourceType) {
+			continue
+		}
+		if subresourceName != "" && !strings.EqualFold(mapping.SubresourceName, subresourceName) {
+			continue
+		}
+
+		results = append(results, mapping)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return strings.ToLower(results[i].ResourceType+"/"+results[i].SubresourceName) < strings.ToLower(results[j].ResourceType+"/"+results[j].SubresourceName)
+	})
+
+	return results, nil
+}
+
+// privateDnsZoneNamesForPrivateEndpoint returns the distinct Private DNS Zones which should be used for a Private Endpoint
+// connected to the specified resource and subresources, in the order they're required
+func privateDnsZoneNamesForPrivateEndpoint(environmentName, privateConnectionResourceId string, subresourceNames []string) ([]string, error) {
+	resourceType, err := privateEndpointConnectionResourceType(privateConnectionResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subresourceNames) == 0 {
+		return nil, fmt.Errorf("at least one `subresource_names` must be specified to determine the Private DNS Zones for %q", resourceType)
+	}
+
+	results := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, subresourceName := range subresourceNames {
+		mappings, err := privateEndpointDnsZoneMappings(environmentName, resourceType, subresourceName)
+		if err != nil {
+			return nil, err
+		}
+		if len(mappings) == 0 {
+			return nil, fmt.Errorf("no Private DNS Zone is known for the Subresource %q of %q in the Cloud Environment %q - use `private_dns_zone_group` instead", subresourceName, resourceType, environmentName)
+		}
+
+		for _, mapping := range mappings {
+			for _, zoneName := range mapping.ZoneNames {
+				if _, ok := seen[zoneName]; ok {
+					continue
+				}
+				seen[zoneName] = struct{}{}
+				results = append(results, zoneName)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// privateEndpointConnectionResourceType returns the top-level Resource Type (e.g. `Microsoft.Storage/storageAccounts`)
+// for the Resource ID the Private Endpoint is connected to
+func privateEndpointConnectionResourceType(input string) (string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+2 < len(segments) {
+			return fmt.Sprintf("%s/%s", segments[i+1], segments[i+2]), nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to determine the Resource Type from the Resource ID %q", input)
+}
@@ -0,0 +1,126 @@
+package network
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestPrivateDnsZoneNamesForPrivateEndpoint(t *testing.T) {
+	storageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	testData := []struct {
+		Name         string
+		Environment  string
+		ResourceId   string
+		Subresources []string
+		Expected     []string
+		Error        bool
+	}{
+		{
+			Name:         "Storage Blob in Public",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{"blob"},
+			Expected:     []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name:         "Storage Blob in China",
+			Environment:  azure.ChinaCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{"blob"},
+			Expected:     []string{"privatelink.blob.core.chinacloudapi.cn"},
+		},
+		{
+			Name:         "Storage Blob and Secondary in US Government share a Zone",
+			Environment:  azure.USGovernmentCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{"blob", "blob_secondary"},
+			Expected:     []string{"privatelink.blob.core.usgovcloudapi.net"},
+		},
+		{
+			Name:         "Resource Type and Subresource are case-insensitive",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.sql/SERVERS/server1",
+			Subresources: []string{"SQLSERVER"},
+			Expected:     []string{"privatelink.database.windows.net"},
+		},
+		{
+			Name:         "Subresource requiring multiple Zones",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.MachineLearningServices/workspaces/workspace1",
+			Subresources: []string{"amlworkspace"},
+			Expected:     []string{"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+		},
+		{
+			Name:         "Same Subresource Name for a different Resource Type",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Synapse/workspaces/workspace1",
+			Subresources: []string{"Sql"},
+			Expected:     []string{"privatelink.sql.azuresynapse.net"},
+		},
+		{
+			Name:         "Unknown Subresource",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{"vault"},
+			Error:        true,
+		},
+		{
+			Name:         "No Subresources",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{},
+			Error:        true,
+		},
+		{
+			Name:         "Unsupported Environment",
+			Environment:  azure.GermanCloud.Name,
+			ResourceId:   storageAccountId,
+			Subresources: []string{"blob"},
+			Error:        true,
+		},
+		{
+			Name:         "Not a Resource ID",
+			Environment:  azure.PublicCloud.Name,
+			ResourceId:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Subresources: []string{"blob"},
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := privateDnsZoneNamesForPrivateEndpoint(v.Environment, v.ResourceId, v.Subresources)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointDnsZoneMappingsAreConsistent(t *testing.T) {
+	for environmentName, mappings := range privateEndpointDnsZoneNames {
+		for key, zoneNames := range mappings {
+			if strings.Count(key, "/") != 2 {
+				t.Fatalf("Environment %q: key %q is not in the format `{Resource Provider}/{Resource Type}/{Subresource Name}`", environmentName, key)
+			}
+			if len(zoneNames) == 0 {
+				t.Fatalf("Environment %q: key %q has no Private DNS Zones", environmentName, key)
+			}
+		}
+	}
+}
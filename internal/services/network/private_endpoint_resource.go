@@ -3,16 +3,19 @@ package network
 import (
 	"context"
 	"fmt"
+	"hash/crc32"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
 	mariaDBParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mariadb/parse"
 	mysqlParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/mysql/parse"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
@@ -20,6 +23,8 @@ import (
 	postgresqlParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/parse"
 	privateDnsParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/privatedns/parse"
 	privateDnsValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/privatedns/validate"
+	resourceParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/resource/parse"
+	resourceValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/resource/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
@@ -27,6 +32,9 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
+// privateDnsZoneResourceName is used to lock the Private DNS Zones created for an `auto_dns_zone_group`
+var privateDnsZoneResourceName = "azurerm_private_dns_zone"
+
 func resourcePrivateEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePrivateEndpointCreate,
@@ -82,9 +90,10 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 			},
 
 			"private_dns_zone_group": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_dns_zone_group"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -108,6 +117,39 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"auto_dns_zone_group": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"private_dns_zone_group"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"zone_resource_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: resourceValidate.ResourceGroupID,
+						},
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "default",
+							ValidateFunc: validate.PrivateLinkName,
+						},
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"private_dns_zone_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"private_service_connection": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
@@ -262,6 +304,12 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_private_endpoint", id.ID())
 	}
 
+	// resolve the Private DNS Zones up-front so that an unsupported Subresource fails before anything's provisioned
+	autoDnsZoneNames, err := privateDnsZoneNamesForAutoDnsZoneGroup(d, meta)
+	if err != nil {
+		return fmt.Errorf("determining the Private DNS Zones for the Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
@@ -293,6 +341,13 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(id.ID())
 
+	if len(autoDnsZoneNames) > 0 {
+		privateDnsZoneGroup, err = ensurePrivateDnsZonesForAutoDnsZoneGroup(ctx, d, meta, autoDnsZoneNames)
+		if err != nil {
+			return err
+		}
+	}
+
 	// 1 Private Endpoint can have 1 Private DNS Zone Group
 	// since this is a new resource, there shouldn't be an existing one - so there's no need to delete it
 	if len(privateDnsZoneGroup) > 0 {
@@ -352,13 +407,24 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	// 1 Private Endpoint can have 1 Private DNS Zone Group - so to update we need to Delete & Recreate
-	if d.HasChange("private_dns_zone_group") {
+	if d.HasChange("private_dns_zone_group") || d.HasChange("auto_dns_zone_group") {
+		autoDnsZoneNames, err := privateDnsZoneNamesForAutoDnsZoneGroup(d, meta)
+		if err != nil {
+			return fmt.Errorf("determining the Private DNS Zones for the Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		if len(autoDnsZoneNames) > 0 {
+			privateDnsZoneGroup, err = ensurePrivateDnsZonesForAutoDnsZoneGroup(ctx, d, meta, autoDnsZoneNames)
+			if err != nil {
+				return err
+			}
+		}
+
 		existingDnsZoneGroups, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, dnsClient, *id)
 		if err != nil {
 			return err
 		}
 
-		newDnsZoneGroups := d.Get("private_dns_zone_group").([]interface{})
+		newDnsZoneGroups := privateDnsZoneGroup
 		newDnsZoneName := ""
 		if len(newDnsZoneGroups) > 0 {
 			groupRaw := newDnsZoneGroups[0].(map[string]interface{})
@@ -483,6 +549,15 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if err := d.Set("private_dns_zone_configs", privateDnsZoneConfigs); err != nil {
 		return fmt.Errorf("setting `private_dns_zone_configs`: %+v", err)
 	}
+
+	// when the Private DNS Zone Group is managed automatically it's exposed within `auto_dns_zone_group` instead, since
+	// the two blocks conflict - however an imported Private Endpoint has no way of knowing this, so defaults to the former
+	if autoDnsZoneGroup := d.Get("auto_dns_zone_group").([]interface{}); len(autoDnsZoneGroup) > 0 && autoDnsZoneGroup[0] != nil {
+		if err := d.Set("auto_dns_zone_group", flattenPrivateEndpointAutoDnsZoneGroup(autoDnsZoneGroup[0].(map[string]interface{}), privateDnsZoneGroups)); err != nil {
+			return fmt.Errorf("setting `auto_dns_zone_group`: %+v", err)
+		}
+		privateDnsZoneGroups = make([]interface{}, 0)
+	}
 	if err := d.Set("private_dns_zone_group", privateDnsZoneGroups); err != nil {
 		return fmt.Errorf("setting `private_dns_zone_group`: %+v", err)
 	}
@@ -732,6 +807,179 @@ func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *ne
 	return nil
 }
 
+// privateDnsZoneNamesForAutoDnsZoneGroup returns the names of the Private DNS Zones required for the `private_service_connection`
+// when `auto_dns_zone_group` is specified - or nil when it isn't
+func privateDnsZoneNamesForAutoDnsZoneGroup(d *pluginsdk.ResourceData, meta interface{}) ([]string, error) {
+	autoDnsZoneGroup := d.Get("auto_dns_zone_group").([]interface{})
+	if len(autoDnsZoneGroup) == 0 || autoDnsZoneGroup[0] == nil {
+		return nil, nil
+	}
+
+	environmentName := meta.(*clients.Client).Account.Environment.Name
+
+	zoneNames := make([]string, 0)
+	for _, item := range d.Get("private_service_connection").([]interface{}) {
+		v := item.(map[string]interface{})
+
+		privateConnectionResourceId := v["private_connection_resource_id"].(string)
+		if privateConnectionResourceId == "" {
+			return nil, fmt.Errorf("`auto_dns_zone_group` requires that `private_connection_resource_id` is specified, since the Private DNS Zones can't be determined from a `private_connection_resource_alias`")
+		}
+
+		names, err := privateDnsZoneNamesForPrivateEndpoint(environmentName, privateConnectionResourceId, *utils.ExpandStringSlice(v["subresource_names"].([]interface{})))
+		if err != nil {
+			return nil, err
+		}
+		zoneNames = append(zoneNames, names...)
+	}
+
+	return zoneNames, nil
+}
+
+// ensurePrivateDnsZonesForAutoDnsZoneGroup creates any of the Private DNS Zones which don't already exist within the
+// `zone_resource_group_id` - and returns the Private DNS Zone Group in the same format as `private_dns_zone_group`.
+// Private DNS Zones are commonly shared between Private Endpoints, so these are intentionally never deleted.
+func ensurePrivateDnsZonesForAutoDnsZoneGroup(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, zoneNames []string) ([]interface{}, error) {
+	autoDnsZoneGroup := d.Get("auto_dns_zone_group").([]interface{})[0].(map[string]interface{})
+
+	resourceGroupId, err := resourceParse.ResourceGroupID(autoDnsZoneGroup["zone_resource_group_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	subnetId, err := parse.SubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return nil, err
+	}
+	virtualNetworkId := parse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName)
+
+	// the Private DNS Zones are commonly centralised in a different Subscription to the Private Endpoint
+	client := *meta.(*clients.Client).PrivateDns.PrivateZonesClient
+	client.SubscriptionID = resourceGroupId.SubscriptionId
+	linksClient := *meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	linksClient.SubscriptionID = resourceGroupId.SubscriptionId
+
+	privateDnsZoneIds := make([]interface{}, 0)
+	for _, zoneName := range zoneNames {
+		zoneId := privateDnsParse.NewPrivateDnsZoneID(resourceGroupId.SubscriptionId, resourceGroupId.ResourceGroup, zoneName)
+		if err := ensurePrivateDnsZone(ctx, &client, &linksClient, zoneId, virtualNetworkId); err != nil {
+			return nil, err
+		}
+		privateDnsZoneIds = append(privateDnsZoneIds, zoneId.ID())
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                 autoDnsZoneGroup["name"].(string),
+			"private_dns_zone_ids": privateDnsZoneIds,
+		},
+	}, nil
+}
+
+func ensurePrivateDnsZone(ctx context.Context, client *privatedns.PrivateZonesClient, linksClient *privatedns.VirtualNetworkLinksClient, id privateDnsParse.PrivateDnsZoneId, virtualNetworkId parse.VirtualNetworkId) error {
+	// other Private Endpoints may be trying to create the same Private DNS Zone (or link) at the same time
+	locks.ByName(id.Name, privateDnsZoneResourceName)
+	defer locks.UnlockByName(id.Name, privateDnsZoneResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Creating %s..", id)
+		parameters := privatedns.PrivateZone{
+			Location: utils.String("global"),
+		}
+		// an If-None-Match of `*` ensures we never overwrite a Private DNS Zone which was created in the interim
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters, "", "*")
+		if err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+		log.Printf("[DEBUG] Created %s.", id)
+	} else {
+		log.Printf("[DEBUG] Using the existing %s", id)
+	}
+
+	return ensurePrivateDnsZoneVirtualNetworkLink(ctx, linksClient, id, virtualNetworkId)
+}
+
+// ensurePrivateDnsZoneVirtualNetworkLink links the Private DNS Zone to the Virtual Network so that names resolve
+// through the zone from within the Virtual Network, unless the Virtual Network is already linked to the zone
+func ensurePrivateDnsZoneVirtualNetworkLink(ctx context.Context, client *privatedns.VirtualNetworkLinksClient, id privateDnsParse.PrivateDnsZoneId, virtualNetworkId parse.VirtualNetworkId) error {
+	links, err := client.ListComplete(ctx, id.ResourceGroup, id.Name, nil)
+	if err != nil {
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+	}
+	for links.NotDone() {
+		link := links.Value()
+		if props := link.VirtualNetworkLinkProperties; props != nil && props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
+			if strings.EqualFold(*props.VirtualNetwork.ID, virtualNetworkId.ID()) {
+				log.Printf("[DEBUG] %s is already linked to %s", id, virtualNetworkId)
+				return nil
+			}
+		}
+
+		if err := links.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+		}
+	}
+
+	linkName := privateDnsZoneVirtualNetworkLinkName(virtualNetworkId)
+	log.Printf("[DEBUG] Creating Virtual Network Link %q for %s..", linkName, id)
+	parameters := privatedns.VirtualNetworkLink{
+		Location: utils.String("global"),
+		VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
+			VirtualNetwork: &privatedns.SubResource{
+				ID: utils.String(virtualNetworkId.ID()),
+			},
+			RegistrationEnabled: utils.Bool(false),
+		},
+	}
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, linkName, parameters, "", "*")
+	if err != nil {
+		return fmt.Errorf("creating Virtual Network Link %q for %s: %+v", linkName, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of Virtual Network Link %q for %s: %+v", linkName, id, err)
+	}
+	log.Printf("[DEBUG] Created Virtual Network Link %q for %s.", linkName, id)
+
+	return nil
+}
+
+// privateDnsZoneVirtualNetworkLinkName returns the name of the Virtual Network Link created for an
+// `auto_dns_zone_group`, which is derived from the Virtual Network ID so that Private Endpoints within the same
+// Virtual Network share a link, whilst Virtual Networks with the same name in different Resource Groups don't clash
+func privateDnsZoneVirtualNetworkLinkName(virtualNetworkId parse.VirtualNetworkId) string {
+	return fmt.Sprintf("%s-%08x", virtualNetworkId.Name, crc32.ChecksumIEEE([]byte(strings.ToLower(virtualNetworkId.ID()))))
+}
+
+func flattenPrivateEndpointAutoDnsZoneGroup(input map[string]interface{}, privateDnsZoneGroups []interface{}) []interface{} {
+	// if the Private DNS Zone Group has been removed outside of Terraform, an empty name ensures that it's recreated
+	id := ""
+	name := ""
+	privateDnsZoneIds := make([]string, 0)
+	if len(privateDnsZoneGroups) > 0 {
+		group := privateDnsZoneGroups[0].(map[string]interface{})
+		id = group["id"].(string)
+		name = group["name"].(string)
+		privateDnsZoneIds = group["private_dns_zone_ids"].([]string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":                     id,
+			"name":                   name,
+			"private_dns_zone_ids":   privateDnsZoneIds,
+			"zone_resource_group_id": input["zone_resource_group_id"],
+		},
+	}
+}
+
 func deletePrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) error {
 	// lookup and delete the (should be, Single) Private DNS Zone Group associated with this Private Endpoint
 	privateDnsZoneIds, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, client, id)
@@ -3,12 +3,14 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	privateDnsParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/privatedns/parse"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
@@ -229,6 +231,73 @@ func TestAccPrivateEndpoint_privateConnectionAlias(t *testing.T) {
 	})
 }
 
+func TestAccPrivateEndpoint_autoDnsZoneGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoDnsZoneGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_dns_zone_group.0.name").HasValue("default"),
+				check.That(data.ResourceName).Key("auto_dns_zone_group.0.private_dns_zone_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_configs.0.name").HasValue("privatelink.blob.core.windows.net"),
+				check.That(data.ResourceName).Key("private_dns_zone_group.#").HasValue("0"),
+				data.CheckWithClient(r.autoDnsZoneLinkedToVirtualNetwork),
+			),
+		},
+		data.ImportStep("auto_dns_zone_group", "private_dns_zone_configs", "private_dns_zone_group"),
+	})
+}
+
+func TestAccPrivateEndpoint_autoDnsZoneGroupExistingZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoDnsZoneGroupExistingZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_dns_zone_group.0.private_dns_zone_ids.0").MatchesOtherKey(
+					check.That("azurerm_private_dns_zone.test").Key("id"),
+				),
+			),
+		},
+		data.ImportStep("auto_dns_zone_group", "private_dns_zone_configs", "private_dns_zone_group"),
+	})
+}
+
+func (PrivateEndpointResource) autoDnsZoneLinkedToVirtualNetwork(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	zoneId, err := privateDnsParse.PrivateDnsZoneID(state.Attributes["auto_dns_zone_group.0.private_dns_zone_ids.0"])
+	if err != nil {
+		return err
+	}
+	subnetId, err := parse.SubnetID(state.Attributes["subnet_id"])
+	if err != nil {
+		return err
+	}
+	virtualNetworkId := parse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName)
+
+	links, err := clients.PrivateDns.VirtualNetworkLinksClient.ListComplete(ctx, zoneId.ResourceGroup, zoneId.Name, nil)
+	if err != nil {
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", zoneId, err)
+	}
+	for links.NotDone() {
+		if props := links.Value().VirtualNetworkLinkProperties; props != nil && props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
+			if strings.EqualFold(*props.VirtualNetwork.ID, virtualNetworkId.ID()) {
+				return nil
+			}
+		}
+		if err := links.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Virtual Network Links for %s: %+v", zoneId, err)
+		}
+	}
+
+	return fmt.Errorf("%s was not linked to %s", zoneId, virtualNetworkId)
+}
+
 func (t PrivateEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.PrivateEndpointID(state.ID)
 	if err != nil {
@@ -749,3 +818,103 @@ resource "azurerm_private_endpoint" "test" {
 }
 `, r.template(data, r.serviceAutoApprove(data)), data.RandomInteger)
 }
+
+func (PrivateEndpointResource) autoDnsZoneGroupTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privatelink-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString)
+}
+
+func (r PrivateEndpointResource) autoDnsZoneGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  auto_dns_zone_group {
+    zone_resource_group_id = azurerm_resource_group.test.id
+  }
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    is_manual_connection           = false
+  }
+}
+`, r.autoDnsZoneGroupTemplate(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r PrivateEndpointResource) autoDnsZoneGroupExistingZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "privatelink.blob.core.windows.net"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "test" {
+  name                  = "acctest-link-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  virtual_network_id    = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  auto_dns_zone_group {
+    name                   = "acctest-dzg-%d"
+    zone_resource_group_id = azurerm_resource_group.test.id
+  }
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    is_manual_connection           = false
+  }
+
+  depends_on = [azurerm_private_dns_zone_virtual_network_link.test]
+}
+`, r.autoDnsZoneGroupTemplate(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
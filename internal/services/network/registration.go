@@ -28,9 +28,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_nat_gateway":                               dataSourceNatGateway(),
 		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                         dataSourceNetworkInterface(),
+		"azurerm_network_interface_effective_routes":        dataSourceNetworkInterfaceEffectiveRoutes(),
 		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
+		"azurerm_network_security_flow_evaluation":          dataSourceNetworkSecurityFlowEvaluation(),
 		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
+		"azurerm_network_watcher_connectivity_check":        dataSourceNetworkWatcherConnectivityCheck(),
+		"azurerm_network_watcher_ip_flow_verify":            dataSourceNetworkWatcherIPFlowVerify(),
+		"azurerm_network_watcher_next_hop":                  dataSourceNetworkWatcherNextHop(),
 		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
+		"azurerm_private_endpoint_dns_zone_names":           dataSourcePrivateEndpointDnsZoneNames(),
 		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
 		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
 		"azurerm_public_ip":                                 dataSourcePublicIP(),
@@ -43,7 +49,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network_gateway_bgp_peer_status":   dataSourceVirtualNetworkGatewayBgpPeerStatus(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
+		"azurerm_virtual_network_available_prefixes":        dataSourceVirtualNetworkAvailablePrefixes(),
 		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
 		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
 		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
@@ -54,7 +62,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
+		"azurerm_application_gateway":                       resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":  resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_backend_http_settings": resourceApplicationGatewayBackendHTTPSettings(),
+		"azurerm_application_gateway_http_listener":         resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                 resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":  resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":       resourceApplicationGatewaySslCertificate(),
+		"azurerm_application_gateway_url_path_map":          resourceApplicationGatewayURLPathMap(),
+
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
@@ -79,6 +95,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
 
+		"azurerm_network_manager":                              resourceNetworkManager(),
+		"azurerm_network_manager_admin_rule":                   resourceNetworkManagerAdminRule(),
+		"azurerm_network_manager_admin_rule_collection":        resourceNetworkManagerAdminRuleCollection(),
+		"azurerm_network_manager_connectivity_configuration":   resourceNetworkManagerConnectivityConfiguration(),
+		"azurerm_network_manager_deployment":                   resourceNetworkManagerDeployment(),
+		"azurerm_network_manager_network_group":                resourceNetworkManagerNetworkGroup(),
+		"azurerm_network_manager_security_admin_configuration": resourceNetworkManagerSecurityAdminConfiguration(),
+		"azurerm_network_manager_static_member":                resourceNetworkManagerStaticMember(),
+
 		"azurerm_network_packet_capture":                    resourceNetworkPacketCapture(),
 		"azurerm_network_profile":                           resourceNetworkProfile(),
 		"azurerm_packet_capture":                            resourcePacketCapture(),
@@ -109,11 +134,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_nat_rule":          resourceVirtualNetworkGatewayNatRule(),
 		"azurerm_virtual_network_peering":                   resourceVirtualNetworkPeering(),
 		"azurerm_virtual_network":                           resourceVirtualNetwork(),
 		"azurerm_virtual_wan":                               resourceVirtualWan(),
 		"azurerm_vpn_gateway":                               resourceVPNGateway(),
 		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
+		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
 		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
@@ -20,16 +20,18 @@ import (
 
 func resourceRoute() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceRouteCreateUpdate,
-		Read:   resourceRouteRead,
-		Update: resourceRouteCreateUpdate,
-		Delete: resourceRouteDelete,
+		CreateContext: routeTableWarningsWrapper(resourceRouteCreateUpdate, resourceRouteWarnings),
+		Read:          resourceRouteRead,
+		UpdateContext: routeTableWarningsWrapper(resourceRouteCreateUpdate, resourceRouteWarnings),
+		Delete:        resourceRouteDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.RouteID(id)
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceRouteCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -24,16 +24,18 @@ var routeTableResourceName = "azurerm_route_table"
 
 func resourceRouteTable() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceRouteTableCreateUpdate,
-		Read:   resourceRouteTableRead,
-		Update: resourceRouteTableCreateUpdate,
-		Delete: resourceRouteTableDelete,
+		CreateContext: routeTableWarningsWrapper(resourceRouteTableCreateUpdate, resourceRouteTableWarnings),
+		Read:          resourceRouteTableRead,
+		UpdateContext: routeTableWarningsWrapper(resourceRouteTableCreateUpdate, resourceRouteTableWarnings),
+		Delete:        resourceRouteTableDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.RouteTableID(id)
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceRouteTableCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
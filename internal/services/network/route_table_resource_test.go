@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
@@ -237,6 +238,35 @@ func (t RouteTableResource) Exists(ctx context.Context, clients *clients.Client,
 	return utils.Bool(resp.ID != nil), nil
 }
 
+func TestAccRouteTable_duplicateAddressPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_table", "test")
+	r := RouteTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateAddressPrefix(data),
+			ExpectError: regexp.MustCompile("multiple Routes have the same Address Prefix"),
+		},
+	})
+}
+
+func TestAccRouteTable_shadowedRoute(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_route_table", "test")
+	r := RouteTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Route covered by more specific Routes is only a warning
+			Config: r.shadowedRoute(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("route.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (RouteTableResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RouteTableID(state.ID)
 	if err != nil {
@@ -493,3 +523,71 @@ resource "azurerm_route_table" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (RouteTableResource) duplicateAddressPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  route {
+    name           = "route1"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "VnetLocal"
+  }
+
+  route {
+    name           = "route2"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "Internet"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (RouteTableResource) shadowedRoute(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  route {
+    name           = "default"
+    address_prefix = "0.0.0.0/0"
+    next_hop_type  = "Internet"
+  }
+
+  route {
+    name           = "lower"
+    address_prefix = "0.0.0.0/1"
+    next_hop_type  = "None"
+  }
+
+  route {
+    name           = "upper"
+    address_prefix = "128.0.0.0/1"
+    next_hop_type  = "None"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,323 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type routeTableRoute struct {
+	Name               string
+	AddressPrefix      string
+	NextHopInIPAddress string
+}
+
+func resourceRouteTableCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateRouteTableRoutes(expandRouteTableRoutesForValidation(d.Get("route").([]interface{})))
+}
+
+func resourceRouteCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	resourceGroup := d.Get("resource_group_name").(string)
+	routeTableName := d.Get("route_table_name").(string)
+	name := d.Get("name").(string)
+	if resourceGroup == "" || routeTableName == "" || name == "" {
+		// these aren't known until apply, e.g. when the Route Table is being created
+		return nil
+	}
+
+	client := meta.(*clients.Client).Network.RouteTablesClient
+	routeTable, err := client.Get(ctx, resourceGroup, routeTableName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(routeTable.Response) {
+			return nil
+		}
+		// this is only validation, so any issues retrieving the Route Table will be surfaced during the apply
+		log.Printf("[DEBUG] Unable to retrieve Route Table %q (Resource Group %q) to validate Route %q: %+v", routeTableName, resourceGroup, name, err)
+		return nil
+	}
+
+	routes := []routeTableRoute{
+		{
+			Name:               name,
+			AddressPrefix:      d.Get("address_prefix").(string),
+			NextHopInIPAddress: d.Get("next_hop_in_ip_address").(string),
+		},
+	}
+	for _, route := range flattenRouteTableRoutesForValidation(routeTable) {
+		if !strings.EqualFold(route.Name, name) {
+			routes = append(routes, route)
+		}
+	}
+
+	return validateRouteTableRoutes(routes)
+}
+
+// resourceRouteTableWarnings returns a warning for each Route within the Route Table which will never be used, and
+// for each Next Hop IP Address which is outside of the Virtual Networks of the associated Subnets
+func resourceRouteTableWarnings(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]string, error) {
+	routes := expandRouteTableRoutesForValidation(d.Get("route").([]interface{}))
+
+	warnings, err := routeTableRouteWarnings(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Subnets are only known once the Route Table has been associated with them
+	subnetIds := make([]string, 0)
+	for _, v := range d.Get("subnets").(*pluginsdk.Set).List() {
+		subnetIds = append(subnetIds, v.(string))
+	}
+	prefixes := retrieveRouteTableVirtualNetworkAddressPrefixes(ctx, meta.(*clients.Client).Network.VnetClient, subnetIds)
+
+	return append(warnings, routeTableRouteNextHopWarnings(routes, prefixes)...), nil
+}
+
+// resourceRouteWarnings returns a warning for each Route within the Route Table containing this Route which will
+// never be used - since these can only be determined once the other Routes within the Route Table are known - and
+// when the Next Hop IP Address of this Route is outside of the Virtual Networks of the associated Subnets
+func resourceRouteWarnings(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]string, error) {
+	client := meta.(*clients.Client).Network.RouteTablesClient
+
+	id, err := parse.RouteID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	routeTable, err := client.Get(ctx, id.ResourceGroup, id.RouteTableName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Route Table %q (Resource Group %q): %+v", id.RouteTableName, id.ResourceGroup, err)
+	}
+
+	warnings, err := routeTableRouteWarnings(flattenRouteTableRoutesForValidation(routeTable))
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIds := make([]string, 0)
+	if props := routeTable.RouteTablePropertiesFormat; props != nil && props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			if subnet.ID != nil {
+				subnetIds = append(subnetIds, *subnet.ID)
+			}
+		}
+	}
+	prefixes := retrieveRouteTableVirtualNetworkAddressPrefixes(ctx, meta.(*clients.Client).Network.VnetClient, subnetIds)
+
+	route := routeTableRoute{
+		Name:               id.Name,
+		AddressPrefix:      d.Get("address_prefix").(string),
+		NextHopInIPAddress: d.Get("next_hop_in_ip_address").(string),
+	}
+
+	return append(warnings, routeTableRouteNextHopWarnings([]routeTableRoute{route}, prefixes)...), nil
+}
+
+// retrieveRouteTableVirtualNetworkAddressPrefixes returns the Address Prefixes of the Virtual Networks containing the
+// Subnets (including the Virtual Networks peered with them) keyed by the Virtual Network ID. Any Virtual Networks
+// which can't be retrieved are skipped, since these are only used for warnings.
+func retrieveRouteTableVirtualNetworkAddressPrefixes(ctx context.Context, client *network.VirtualNetworksClient, subnetIds []string) map[string][]string {
+	output := make(map[string][]string)
+	for _, v := range subnetIds {
+		subnetId, err := parse.SubnetID(v)
+		if err != nil {
+			continue
+		}
+		virtualNetworkId := parse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName).ID()
+		if _, ok := output[virtualNetworkId]; ok {
+			continue
+		}
+
+		prefixes, err := retrieveVirtualNetworkAddressPrefixesIncludingPeerings(ctx, client, virtualNetworkId)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve the Address Space of %q to validate the Next Hops: %+v", virtualNetworkId, err)
+			continue
+		}
+		output[virtualNetworkId] = prefixes
+	}
+	return output
+}
+
+// routeTableWarningsWrapper returns a Create/Update function which returns the warnings for the Routes as
+// diagnostics once the Create/Update has completed, since warnings can't be returned from a CustomizeDiff
+func routeTableWarningsWrapper(f func(d *pluginsdk.ResourceData, meta interface{}) error, warnings func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]string, error)) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := f(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		messages, err := warnings(ctx, d, meta)
+		if err != nil {
+			// these are only warnings, so shouldn't fail the apply
+			log.Printf("[DEBUG] Unable to determine the warnings for the Routes of %q: %+v", d.Id(), err)
+			return nil
+		}
+
+		diags := make(diag.Diagnostics, 0)
+		for _, message := range messages {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  message,
+			})
+		}
+		return diags
+	}
+}
+
+func expandRouteTableRoutesForValidation(input []interface{}) []routeTableRoute {
+	routes := make([]routeTableRoute, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		routes = append(routes, routeTableRoute{
+			Name:               v["name"].(string),
+			AddressPrefix:      v["address_prefix"].(string),
+			NextHopInIPAddress: v["next_hop_in_ip_address"].(string),
+		})
+	}
+	return routes
+}
+
+func flattenRouteTableRoutesForValidation(routeTable network.RouteTable) []routeTableRoute {
+	routes := make([]routeTableRoute, 0)
+	if props := routeTable.RouteTablePropertiesFormat; props != nil && props.Routes != nil {
+		for _, route := range *props.Routes {
+			if route.Name == nil || route.RoutePropertiesFormat == nil {
+				continue
+			}
+
+			routes = append(routes, routeTableRoute{
+				Name:               *route.Name,
+				AddressPrefix:      utils.NormalizeNilableString(route.RoutePropertiesFormat.AddressPrefix),
+				NextHopInIPAddress: utils.NormalizeNilableString(route.RoutePropertiesFormat.NextHopIPAddress),
+			})
+		}
+	}
+	return routes
+}
+
+// validateRouteTableRoutes returns an error when multiple Routes within a Route Table have the same Address Prefix
+func validateRouteTableRoutes(routes []routeTableRoute) error {
+	namesByPrefix, _ := routeTableRouteNamesByPrefix(routes)
+
+	duplicates := make([]string, 0)
+	for prefix, names := range namesByPrefix {
+		if len(names) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%q (Routes %s)", prefix, quoteAndJoin(names)))
+		}
+	}
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		return fmt.Errorf("multiple Routes have the same Address Prefix: %s", strings.Join(duplicates, ", "))
+	}
+
+	return nil
+}
+
+// routeTableRouteWarnings returns a warning for each Route which can never be matched, since the more specific
+// Routes cover its entire Address Prefix. This is only a warning since it's intentional in some cases, e.g. when
+// `0.0.0.0/1` and `128.0.0.0/1` override the default Route whilst a `0.0.0.0/0` Route is kept as a fallback.
+func routeTableRouteWarnings(routes []routeTableRoute) ([]string, error) {
+	namesByPrefix, cidrPrefixes := routeTableRouteNamesByPrefix(routes)
+
+	warnings := make([]string, 0)
+	for _, prefix := range cidrPrefixes {
+		covered, err := utils.PrefixCoveredBy(prefix, cidrPrefixes)
+		if err != nil {
+			return nil, err
+		}
+
+		if covered {
+			warnings = append(warnings, fmt.Sprintf("the Route %q with the Address Prefix %q will never be used, since more specific Routes cover the entire Address Prefix", namesByPrefix[prefix][0], prefix))
+		}
+	}
+
+	return warnings, nil
+}
+
+// routeTableRouteNextHopWarnings returns a warning for each Route with a Next Hop IP Address which isn't within one of
+// the Virtual Networks (or the Virtual Networks peered with them), since traffic for this Route may be dropped. This is
+// only a warning since the Next Hop may be reachable in other ways, e.g. via a Virtual Hub or an ExpressRoute Circuit.
+func routeTableRouteNextHopWarnings(routes []routeTableRoute, prefixesByVirtualNetwork map[string][]string) []string {
+	virtualNetworkIds := make([]string, 0)
+	for k := range prefixesByVirtualNetwork {
+		virtualNetworkIds = append(virtualNetworkIds, k)
+	}
+	sort.Strings(virtualNetworkIds)
+
+	warnings := make([]string, 0)
+	for _, route := range routes {
+		ip := net.ParseIP(route.NextHopInIPAddress)
+		if ip == nil {
+			continue
+		}
+
+		for _, virtualNetworkId := range virtualNetworkIds {
+			if !ipAddressWithinPrefixes(ip, prefixesByVirtualNetwork[virtualNetworkId]) {
+				warnings = append(warnings, fmt.Sprintf("the Next Hop IP Address %q of the Route %q isn't within the Address Space of the Virtual Network %q or the Virtual Networks peered with it - traffic for this Route may be dropped", route.NextHopInIPAddress, route.Name, virtualNetworkId))
+			}
+		}
+	}
+
+	return warnings
+}
+
+func ipAddressWithinPrefixes(ip net.IP, prefixes []string) bool {
+	for _, v := range prefixes {
+		if _, prefix, err := net.ParseCIDR(v); err == nil && prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeTableRouteNamesByPrefix returns the names of the Routes keyed by their normalized Address Prefix, along with
+// the sorted Address Prefixes which are CIDRs
+func routeTableRouteNamesByPrefix(routes []routeTableRoute) (map[string][]string, []string) {
+	namesByPrefix := make(map[string][]string)
+	cidrPrefixes := make([]string, 0)
+	for _, route := range routes {
+		// the Address Prefix isn't known until apply when it's interpolated
+		if route.AddressPrefix == "" {
+			continue
+		}
+
+		// Service Tags are compared as-is, since these can't be expanded at plan time
+		key := strings.ToLower(route.AddressPrefix)
+		if _, ipNet, err := net.ParseCIDR(route.AddressPrefix); err == nil {
+			key = ipNet.String()
+			if _, ok := namesByPrefix[key]; !ok {
+				cidrPrefixes = append(cidrPrefixes, key)
+			}
+		}
+
+		namesByPrefix[key] = append(namesByPrefix[key], route.Name)
+	}
+
+	sort.Strings(cidrPrefixes)
+	return namesByPrefix, cidrPrefixes
+}
+
+func quoteAndJoin(input []string) string {
+	quoted := make([]string, 0)
+	for _, v := range input {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(quoted, " and ")
+}
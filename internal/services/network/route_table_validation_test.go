@@ -0,0 +1,230 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateRouteTableRoutes(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Routes []routeTableRoute
+		Error  bool
+	}{
+		{
+			Name:   "No Routes",
+			Routes: []routeTableRoute{},
+		},
+		{
+			Name: "Distinct Routes",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.1.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.2.0.0/16"},
+			},
+		},
+		{
+			Name: "Duplicate Address Prefix",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.1.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.1.0.0/16"},
+			},
+			Error: true,
+		},
+		{
+			Name: "Duplicate Address Prefix once normalized",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.1.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.1.2.3/16"},
+			},
+			Error: true,
+		},
+		{
+			Name: "Duplicate Service Tag with a different casing",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "AzureCloud"},
+				{Name: "route2", AddressPrefix: "azurecloud"},
+			},
+			Error: true,
+		},
+		{
+			Name: "Partially covered by a more specific Route",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.0.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.0.1.0/24"},
+			},
+		},
+		{
+			Name: "Entirely covered by more specific Routes",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.0.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.0.0.0/17"},
+				{Name: "route3", AddressPrefix: "10.0.128.0/17"},
+			},
+		},
+		{
+			Name: "Unknown Address Prefixes are ignored",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: ""},
+				{Name: "route2", AddressPrefix: ""},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateRouteTableRoutes(v.Routes)
+		if err != nil && !v.Error {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if err == nil && v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestRouteTableRouteWarnings(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Routes   []routeTableRoute
+		Expected []string
+	}{
+		{
+			Name:   "No Routes",
+			Routes: []routeTableRoute{},
+		},
+		{
+			Name: "Partially covered by a more specific Route",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.0.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.0.1.0/24"},
+			},
+		},
+		{
+			Name: "Entirely covered by more specific Routes",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "10.0.0.0/16"},
+				{Name: "route2", AddressPrefix: "10.0.0.0/17"},
+				{Name: "route3", AddressPrefix: "10.0.128.0/17"},
+			},
+			Expected: []string{"route1"},
+		},
+		{
+			Name: "Split Default Route",
+			Routes: []routeTableRoute{
+				{Name: "default", AddressPrefix: "0.0.0.0/0"},
+				{Name: "lower", AddressPrefix: "0.0.0.0/1"},
+				{Name: "upper", AddressPrefix: "128.0.0.0/1"},
+			},
+			Expected: []string{"default"},
+		},
+		{
+			Name: "Service Tags are ignored",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "AzureCloud"},
+				{Name: "route2", AddressPrefix: "10.0.0.0/16"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		warnings, err := routeTableRouteWarnings(v.Routes)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if len(warnings) != len(v.Expected) {
+			t.Fatalf("Expected %d warnings but got %d: %+v", len(v.Expected), len(warnings), warnings)
+		}
+		for i, name := range v.Expected {
+			if !strings.Contains(warnings[i], fmt.Sprintf("%q", name)) {
+				t.Fatalf("Expected warning %d to be for the Route %q but got %q", i, name, warnings[i])
+			}
+		}
+	}
+}
+
+func TestRouteTableRouteNextHopWarnings(t *testing.T) {
+	virtualNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	peeredVirtualNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2"
+
+	testData := []struct {
+		Name     string
+		Routes   []routeTableRoute
+		Prefixes map[string][]string
+		Expected []string
+	}{
+		{
+			Name: "No associated Virtual Networks",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0", NextHopInIPAddress: "192.168.0.4"},
+			},
+		},
+		{
+			Name: "Next Hop within the Virtual Network",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0", NextHopInIPAddress: "10.0.1.4"},
+			},
+			Prefixes: map[string][]string{
+				virtualNetworkId: {"10.0.0.0/16"},
+			},
+		},
+		{
+			Name: "Next Hop within a peered Virtual Network",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0", NextHopInIPAddress: "10.1.1.4"},
+			},
+			Prefixes: map[string][]string{
+				virtualNetworkId: {"10.0.0.0/16", "10.1.0.0/16"},
+			},
+		},
+		{
+			Name: "Next Hop outside the Virtual Network",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0", NextHopInIPAddress: "192.168.0.4"},
+				{Name: "route2", AddressPrefix: "10.2.0.0/16", NextHopInIPAddress: "10.0.1.4"},
+			},
+			Prefixes: map[string][]string{
+				virtualNetworkId: {"10.0.0.0/16"},
+			},
+			Expected: []string{"route1"},
+		},
+		{
+			Name: "Next Hop outside one of the Virtual Networks",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0", NextHopInIPAddress: "10.0.1.4"},
+			},
+			Prefixes: map[string][]string{
+				virtualNetworkId:       {"10.0.0.0/16"},
+				peeredVirtualNetworkId: {"10.1.0.0/16"},
+			},
+			Expected: []string{"route1"},
+		},
+		{
+			Name: "Routes without a Next Hop IP Address are ignored",
+			Routes: []routeTableRoute{
+				{Name: "route1", AddressPrefix: "0.0.0.0/0"},
+			},
+			Prefixes: map[string][]string{
+				virtualNetworkId: {"10.0.0.0/16"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		warnings := routeTableRouteNextHopWarnings(v.Routes, v.Prefixes)
+		if len(warnings) != len(v.Expected) {
+			t.Fatalf("Expected %d warnings but got %d: %+v", len(v.Expected), len(warnings), warnings)
+		}
+		for i, name := range v.Expected {
+			if !strings.Contains(warnings[i], fmt.Sprintf("%q", name)) {
+				t.Fatalf("Expected warning %d to be for the Route %q but got %q", i, name, warnings[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package networkmanagers
+
+type AddressPrefixType string
+
+const (
+	AddressPrefixTypeIPPrefix   AddressPrefixType = "IPPrefix"
+	AddressPrefixTypeServiceTag AddressPrefixType = "ServiceTag"
+)
+
+func PossibleValuesForAddressPrefixType() []string {
+	return []string{
+		string(AddressPrefixTypeIPPrefix),
+		string(AddressPrefixTypeServiceTag),
+	}
+}
+
+type AdminRuleKind string
+
+const (
+	AdminRuleKindCustom  AdminRuleKind = "Custom"
+	AdminRuleKindDefault AdminRuleKind = "Default"
+)
+
+type ConfigurationType string
+
+const (
+	ConfigurationTypeConnectivity  ConfigurationType = "Connectivity"
+	ConfigurationTypeSecurityAdmin ConfigurationType = "SecurityAdmin"
+)
+
+func PossibleValuesForConfigurationType() []string {
+	return []string{
+		string(ConfigurationTypeConnectivity),
+		string(ConfigurationTypeSecurityAdmin),
+	}
+}
+
+type ConfigurationScopeAccess string
+
+const (
+	ConfigurationScopeAccessConnectivity  ConfigurationScopeAccess = "Connectivity"
+	ConfigurationScopeAccessSecurityAdmin ConfigurationScopeAccess = "SecurityAdmin"
+	ConfigurationScopeAccessSecurityUser  ConfigurationScopeAccess = "SecurityUser"
+)
+
+func PossibleValuesForConfigurationScopeAccess() []string {
+	return []string{
+		string(ConfigurationScopeAccessConnectivity),
+		string(ConfigurationScopeAccessSecurityAdmin),
+		string(ConfigurationScopeAccessSecurityUser),
+	}
+}
+
+type ConnectivityTopology string
+
+const (
+	ConnectivityTopologyHubAndSpoke ConnectivityTopology = "HubAndSpoke"
+	ConnectivityTopologyMesh        ConnectivityTopology = "Mesh"
+)
+
+func PossibleValuesForConnectivityTopology() []string {
+	return []string{
+		string(ConnectivityTopologyHubAndSpoke),
+		string(ConnectivityTopologyMesh),
+	}
+}
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusDeployed   DeploymentStatus = "Deployed"
+	DeploymentStatusDeploying  DeploymentStatus = "Deploying"
+	DeploymentStatusFailed     DeploymentStatus = "Failed"
+	DeploymentStatusNotStarted DeploymentStatus = "NotStarted"
+)
+
+type GroupConnectivity string
+
+const (
+	GroupConnectivityDirectlyConnected GroupConnectivity = "DirectlyConnected"
+	GroupConnectivityNone              GroupConnectivity = "None"
+)
+
+func PossibleValuesForGroupConnectivity() []string {
+	return []string{
+		string(GroupConnectivityDirectlyConnected),
+		string(GroupConnectivityNone),
+	}
+}
+
+// IsGlobal, UseHubGateway, DeleteExistingPeering and DeleteExistingNSGs are booleans serialized as strings
+type IsGlobal string
+
+const (
+	IsGlobalFalse IsGlobal = "False"
+	IsGlobalTrue  IsGlobal = "True"
+)
+
+type UseHubGateway string
+
+const (
+	UseHubGatewayFalse UseHubGateway = "False"
+	UseHubGatewayTrue  UseHubGateway = "True"
+)
+
+type DeleteExistingPeering string
+
+const (
+	DeleteExistingPeeringFalse DeleteExistingPeering = "False"
+	DeleteExistingPeeringTrue  DeleteExistingPeering = "True"
+)
+
+type DeleteExistingNSGs string
+
+const (
+	DeleteExistingNSGsFalse DeleteExistingNSGs = "False"
+	DeleteExistingNSGsTrue  DeleteExistingNSGs = "True"
+)
+
+type SecurityConfigurationRuleAccess string
+
+const (
+	SecurityConfigurationRuleAccessAllow       SecurityConfigurationRuleAccess = "Allow"
+	SecurityConfigurationRuleAccessAlwaysAllow SecurityConfigurationRuleAccess = "AlwaysAllow"
+	SecurityConfigurationRuleAccessDeny        SecurityConfigurationRuleAccess = "Deny"
+)
+
+func PossibleValuesForSecurityConfigurationRuleAccess() []string {
+	return []string{
+		string(SecurityConfigurationRuleAccessAllow),
+		string(SecurityConfigurationRuleAccessAlwaysAllow),
+		string(SecurityConfigurationRuleAccessDeny),
+	}
+}
+
+type SecurityConfigurationRuleDirection string
+
+const (
+	SecurityConfigurationRuleDirectionInbound  SecurityConfigurationRuleDirection = "Inbound"
+	SecurityConfigurationRuleDirectionOutbound SecurityConfigurationRuleDirection = "Outbound"
+)
+
+func PossibleValuesForSecurityConfigurationRuleDirection() []string {
+	return []string{
+		string(SecurityConfigurationRuleDirectionInbound),
+		string(SecurityConfigurationRuleDirectionOutbound),
+	}
+}
+
+type SecurityConfigurationRuleProtocol string
+
+const (
+	SecurityConfigurationRuleProtocolAh   SecurityConfigurationRuleProtocol = "Ah"
+	SecurityConfigurationRuleProtocolAny  SecurityConfigurationRuleProtocol = "Any"
+	SecurityConfigurationRuleProtocolEsp  SecurityConfigurationRuleProtocol = "Esp"
+	SecurityConfigurationRuleProtocolIcmp SecurityConfigurationRuleProtocol = "Icmp"
+	SecurityConfigurationRuleProtocolTcp  SecurityConfigurationRuleProtocol = "Tcp"
+	SecurityConfigurationRuleProtocolUdp  SecurityConfigurationRuleProtocol = "Udp"
+)
+
+func PossibleValuesForSecurityConfigurationRuleProtocol() []string {
+	return []string{
+		string(SecurityConfigurationRuleProtocolAh),
+		string(SecurityConfigurationRuleProtocolAny),
+		string(SecurityConfigurationRuleProtocolEsp),
+		string(SecurityConfigurationRuleProtocolIcmp),
+		string(SecurityConfigurationRuleProtocolTcp),
+		string(SecurityConfigurationRuleProtocolUdp),
+	}
+}
+
+type SecurityType string
+
+const (
+	SecurityTypeAdminPolicy SecurityType = "AdminPolicy"
+	SecurityTypeUserPolicy  SecurityType = "UserPolicy"
+)
@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type AdminRuleCollectionsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRuleCollection
+}
+
+// AdminRuleCollectionsCreateOrUpdate ...
+func (c NetworkManagersClient) AdminRuleCollectionsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input AdminRuleCollection) (result AdminRuleCollectionsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForAdminRuleCollectionsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAdminRuleCollectionsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRuleCollectionsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAdminRuleCollectionsCreateOrUpdate prepares the AdminRuleCollectionsCreateOrUpdate request.
+func (c NetworkManagersClient) preparerForAdminRuleCollectionsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input AdminRuleCollection) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAdminRuleCollectionsCreateOrUpdate handles the response to the AdminRuleCollectionsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForAdminRuleCollectionsCreateOrUpdate(resp *http.Response) (result AdminRuleCollectionsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type AdminRulesCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRule
+}
+
+// AdminRulesCreateOrUpdate ...
+func (c NetworkManagersClient) AdminRulesCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input AdminRule) (result AdminRulesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForAdminRulesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAdminRulesCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAdminRulesCreateOrUpdate prepares the AdminRulesCreateOrUpdate request.
+func (c NetworkManagersClient) preparerForAdminRulesCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input AdminRule) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAdminRulesCreateOrUpdate handles the response to the AdminRulesCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForAdminRulesCreateOrUpdate(resp *http.Response) (result AdminRulesCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type AdminRulesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *AdminRule
+}
+
+// AdminRulesGet ...
+func (c NetworkManagersClient) AdminRulesGet(ctx context.Context, id resourceid.Formatter) (result AdminRulesGetResponse, err error) {
+	req, err := c.preparerForAdminRulesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAdminRulesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "AdminRulesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAdminRulesGet prepares the AdminRulesGet request.
+func (c NetworkManagersClient) preparerForAdminRulesGet(ctx context.Context, id resourceid.Formatter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAdminRulesGet handles the response to the AdminRulesGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForAdminRulesGet(resp *http.Response) (result AdminRulesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
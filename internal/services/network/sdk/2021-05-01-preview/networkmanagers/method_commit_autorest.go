@@ -0,0 +1,77 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type CommitResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Commit ...
+func (c NetworkManagersClient) Commit(ctx context.Context, id resourceid.Formatter, input NetworkManagerCommit) (result CommitResponse, err error) {
+	req, err := c.preparerForCommit(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "Commit", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCommit(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "Commit", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CommitThenPoll performs Commit then polls until it's completed
+func (c NetworkManagersClient) CommitThenPoll(ctx context.Context, id resourceid.Formatter, input NetworkManagerCommit) error {
+	result, err := c.Commit(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Commit: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Commit: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCommit prepares the Commit request.
+func (c NetworkManagersClient) preparerForCommit(ctx context.Context, id resourceid.Formatter, input NetworkManagerCommit) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/commit", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCommit sends the Commit request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForCommit(ctx context.Context, req *http.Request) (future CommitResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.HttpResponse = resp
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type ConnectivityConfigurationsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *ConnectivityConfiguration
+}
+
+// ConnectivityConfigurationsCreateOrUpdate ...
+func (c NetworkManagersClient) ConnectivityConfigurationsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input ConnectivityConfiguration) (result ConnectivityConfigurationsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForConnectivityConfigurationsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForConnectivityConfigurationsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForConnectivityConfigurationsCreateOrUpdate prepares the ConnectivityConfigurationsCreateOrUpdate request.
+func (c NetworkManagersClient) preparerForConnectivityConfigurationsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input ConnectivityConfiguration) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForConnectivityConfigurationsCreateOrUpdate handles the response to the ConnectivityConfigurationsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForConnectivityConfigurationsCreateOrUpdate(resp *http.Response) (result ConnectivityConfigurationsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type ConnectivityConfigurationsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ConnectivityConfigurationsDelete ...
+func (c NetworkManagersClient) ConnectivityConfigurationsDelete(ctx context.Context, id resourceid.Formatter) (result ConnectivityConfigurationsDeleteResponse, err error) {
+	req, err := c.preparerForConnectivityConfigurationsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForConnectivityConfigurationsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ConnectivityConfigurationsDeleteThenPoll performs ConnectivityConfigurationsDelete then polls until it's completed
+func (c NetworkManagersClient) ConnectivityConfigurationsDeleteThenPoll(ctx context.Context, id resourceid.Formatter) error {
+	result, err := c.ConnectivityConfigurationsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing ConnectivityConfigurationsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ConnectivityConfigurationsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForConnectivityConfigurationsDelete prepares the ConnectivityConfigurationsDelete request.
+func (c NetworkManagersClient) preparerForConnectivityConfigurationsDelete(ctx context.Context, id resourceid.Formatter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForConnectivityConfigurationsDelete sends the ConnectivityConfigurationsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForConnectivityConfigurationsDelete(ctx context.Context, req *http.Request) (future ConnectivityConfigurationsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
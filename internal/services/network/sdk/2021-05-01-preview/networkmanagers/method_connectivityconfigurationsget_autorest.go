@@ -0,0 +1,65 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type ConnectivityConfigurationsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ConnectivityConfiguration
+}
+
+// ConnectivityConfigurationsGet ...
+func (c NetworkManagersClient) ConnectivityConfigurationsGet(ctx context.Context, id resourceid.Formatter) (result ConnectivityConfigurationsGetResponse, err error) {
+	req, err := c.preparerForConnectivityConfigurationsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForConnectivityConfigurationsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ConnectivityConfigurationsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForConnectivityConfigurationsGet prepares the ConnectivityConfigurationsGet request.
+func (c NetworkManagersClient) preparerForConnectivityConfigurationsGet(ctx context.Context, id resourceid.Formatter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForConnectivityConfigurationsGet handles the response to the ConnectivityConfigurationsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForConnectivityConfigurationsGet(resp *http.Response) (result ConnectivityConfigurationsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type NetworkGroupsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkGroup
+}
+
+// NetworkGroupsCreateOrUpdate ...
+func (c NetworkManagersClient) NetworkGroupsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input NetworkGroup) (result NetworkGroupsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForNetworkGroupsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForNetworkGroupsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForNetworkGroupsCreateOrUpdate prepares the NetworkGroupsCreateOrUpdate request.
+func (c NetworkManagersClient) preparerForNetworkGroupsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input NetworkGroup) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForNetworkGroupsCreateOrUpdate handles the response to the NetworkGroupsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForNetworkGroupsCreateOrUpdate(resp *http.Response) (result NetworkGroupsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type NetworkGroupsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// NetworkGroupsDelete ...
+func (c NetworkManagersClient) NetworkGroupsDelete(ctx context.Context, id resourceid.Formatter) (result NetworkGroupsDeleteResponse, err error) {
+	req, err := c.preparerForNetworkGroupsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForNetworkGroupsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "NetworkGroupsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// NetworkGroupsDeleteThenPoll performs NetworkGroupsDelete then polls until it's completed
+func (c NetworkManagersClient) NetworkGroupsDeleteThenPoll(ctx context.Context, id resourceid.Formatter) error {
+	result, err := c.NetworkGroupsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing NetworkGroupsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after NetworkGroupsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForNetworkGroupsDelete prepares the NetworkGroupsDelete request.
+func (c NetworkManagersClient) preparerForNetworkGroupsDelete(ctx context.Context, id resourceid.Formatter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForNetworkGroupsDelete sends the NetworkGroupsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForNetworkGroupsDelete(ctx context.Context, req *http.Request) (future NetworkGroupsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type SecurityAdminConfigurationsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *SecurityAdminConfiguration
+}
+
+// SecurityAdminConfigurationsCreateOrUpdate ...
+func (c NetworkManagersClient) SecurityAdminConfigurationsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input SecurityAdminConfiguration) (result SecurityAdminConfigurationsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSecurityAdminConfigurationsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSecurityAdminConfigurationsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "SecurityAdminConfigurationsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSecurityAdminConfigurationsCreateOrUpdate prepares the SecurityAdminConfigurationsCreateOrUpdate request.
+func (c NetworkManagersClient) preparerForSecurityAdminConfigurationsCreateOrUpdate(ctx context.Context, id resourceid.Formatter, input SecurityAdminConfiguration) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSecurityAdminConfigurationsCreateOrUpdate handles the response to the SecurityAdminConfigurationsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForSecurityAdminConfigurationsCreateOrUpdate(resp *http.Response) (result SecurityAdminConfigurationsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
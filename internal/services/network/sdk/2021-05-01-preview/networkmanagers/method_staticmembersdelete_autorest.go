@@ -0,0 +1,74 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/resourceid"
+)
+
+type StaticMembersDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// StaticMembersDelete ...
+func (c NetworkManagersClient) StaticMembersDelete(ctx context.Context, id resourceid.Formatter) (result StaticMembersDeleteResponse, err error) {
+	req, err := c.preparerForStaticMembersDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForStaticMembersDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "StaticMembersDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// StaticMembersDeleteThenPoll performs StaticMembersDelete then polls until it's completed
+func (c NetworkManagersClient) StaticMembersDeleteThenPoll(ctx context.Context, id resourceid.Formatter) error {
+	result, err := c.StaticMembersDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing StaticMembersDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after StaticMembersDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForStaticMembersDelete prepares the StaticMembersDelete request.
+func (c NetworkManagersClient) preparerForStaticMembersDelete(ctx context.Context, id resourceid.Formatter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForStaticMembersDelete sends the StaticMembersDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c NetworkManagersClient) senderForStaticMembersDelete(ctx context.Context, req *http.Request) (future StaticMembersDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,29 @@
+package networkmanagers
+
+type AdminRule struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Kind       AdminRuleKind        `json:"kind"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *AdminRuleProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type AdminRuleProperties struct {
+	Access                SecurityConfigurationRuleAccess    `json:"access"`
+	Description           *string                            `json:"description,omitempty"`
+	DestinationPortRanges *[]string                          `json:"destinationPortRanges,omitempty"`
+	Destinations          *[]AddressPrefixItem               `json:"destinations,omitempty"`
+	Direction             SecurityConfigurationRuleDirection `json:"direction"`
+	DisplayName           *string                            `json:"displayName,omitempty"`
+	Priority              *int64                             `json:"priority,omitempty"`
+	Protocol              SecurityConfigurationRuleProtocol  `json:"protocol"`
+	ProvisioningState     *string                            `json:"provisioningState,omitempty"`
+	SourcePortRanges      *[]string                          `json:"sourcePortRanges,omitempty"`
+	Sources               *[]AddressPrefixItem               `json:"sources,omitempty"`
+}
+
+type AddressPrefixItem struct {
+	AddressPrefix     *string            `json:"addressPrefix,omitempty"`
+	AddressPrefixType *AddressPrefixType `json:"addressPrefixType,omitempty"`
+}
@@ -0,0 +1,20 @@
+package networkmanagers
+
+type AdminRuleCollection struct {
+	Etag       *string                        `json:"etag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *AdminRuleCollectionProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
+
+type AdminRuleCollectionProperties struct {
+	AppliesToGroups   *[]ManagerSecurityGroupItem `json:"appliesToGroups,omitempty"`
+	Description       *string                     `json:"description,omitempty"`
+	DisplayName       *string                     `json:"displayName,omitempty"`
+	ProvisioningState *string                     `json:"provisioningState,omitempty"`
+}
+
+type ManagerSecurityGroupItem struct {
+	NetworkGroupId string `json:"networkGroupId"`
+}
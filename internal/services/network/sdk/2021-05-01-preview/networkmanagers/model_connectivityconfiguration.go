@@ -0,0 +1,32 @@
+package networkmanagers
+
+type ConnectivityConfiguration struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *ConnectivityConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
+
+type ConnectivityConfigurationProperties struct {
+	AppliesToGroups       []ConnectivityGroupItem `json:"appliesToGroups"`
+	ConnectivityTopology  ConnectivityTopology    `json:"connectivityTopology"`
+	DeleteExistingPeering *DeleteExistingPeering  `json:"deleteExistingPeering,omitempty"`
+	Description           *string                 `json:"description,omitempty"`
+	DisplayName           *string                 `json:"displayName,omitempty"`
+	Hubs                  *[]Hub                  `json:"hubs,omitempty"`
+	IsGlobal              *IsGlobal               `json:"isGlobal,omitempty"`
+	ProvisioningState     *string                 `json:"provisioningState,omitempty"`
+}
+
+type ConnectivityGroupItem struct {
+	GroupConnectivity GroupConnectivity `json:"groupConnectivity"`
+	IsGlobal          *IsGlobal         `json:"isGlobal,omitempty"`
+	NetworkGroupId    string            `json:"networkGroupId"`
+	UseHubGateway     *UseHubGateway    `json:"useHubGateway,omitempty"`
+}
+
+type Hub struct {
+	ResourceId   *string `json:"resourceId,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
@@ -0,0 +1,16 @@
+package networkmanagers
+
+type NetworkGroup struct {
+	Etag       *string                 `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *NetworkGroupProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type NetworkGroupProperties struct {
+	Description       *string `json:"description,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	MemberType        *string `json:"memberType,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
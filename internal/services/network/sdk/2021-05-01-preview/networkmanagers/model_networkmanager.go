@@ -0,0 +1,24 @@
+package networkmanagers
+
+type NetworkManager struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NetworkManagerProperties `json:"properties,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type NetworkManagerProperties struct {
+	Description                 *string                        `json:"description,omitempty"`
+	DisplayName                 *string                        `json:"displayName,omitempty"`
+	NetworkManagerScopeAccesses []ConfigurationScopeAccess     `json:"networkManagerScopeAccesses"`
+	NetworkManagerScopes        NetworkManagerPropertiesScopes `json:"networkManagerScopes"`
+	ProvisioningState           *string                        `json:"provisioningState,omitempty"`
+}
+
+type NetworkManagerPropertiesScopes struct {
+	ManagementGroups *[]string `json:"managementGroups,omitempty"`
+	Subscriptions    *[]string `json:"subscriptions,omitempty"`
+}
@@ -0,0 +1,7 @@
+package networkmanagers
+
+type NetworkManagerCommit struct {
+	CommitType       ConfigurationType `json:"commitType"`
+	ConfigurationIds *[]string         `json:"configurationIds,omitempty"`
+	TargetLocations  []string          `json:"targetLocations"`
+}
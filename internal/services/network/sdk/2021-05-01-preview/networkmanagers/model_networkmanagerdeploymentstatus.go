@@ -0,0 +1,21 @@
+package networkmanagers
+
+type NetworkManagerDeploymentStatusParameter struct {
+	DeploymentTypes *[]ConfigurationType `json:"deploymentTypes,omitempty"`
+	Regions         *[]string            `json:"regions,omitempty"`
+	SkipToken       *string              `json:"skipToken,omitempty"`
+}
+
+type NetworkManagerDeploymentStatusListResult struct {
+	SkipToken *string                           `json:"skipToken,omitempty"`
+	Value     *[]NetworkManagerDeploymentStatus `json:"value,omitempty"`
+}
+
+type NetworkManagerDeploymentStatus struct {
+	CommitTime       *string            `json:"commitTime,omitempty"`
+	ConfigurationIds *[]string          `json:"configurationIds,omitempty"`
+	DeploymentStatus *DeploymentStatus  `json:"deploymentStatus,omitempty"`
+	DeploymentType   *ConfigurationType `json:"deploymentType,omitempty"`
+	ErrorMessage     *string            `json:"errorMessage,omitempty"`
+	Region           *string            `json:"region,omitempty"`
+}
@@ -0,0 +1,17 @@
+package networkmanagers
+
+type SecurityAdminConfiguration struct {
+	Etag       *string                               `json:"etag,omitempty"`
+	Id         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *SecurityAdminConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                               `json:"type,omitempty"`
+}
+
+type SecurityAdminConfigurationProperties struct {
+	DeleteExistingNSGs *DeleteExistingNSGs `json:"deleteExistingNSGs,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	DisplayName        *string             `json:"displayName,omitempty"`
+	ProvisioningState  *string             `json:"provisioningState,omitempty"`
+	SecurityType       *SecurityType       `json:"securityType,omitempty"`
+}
@@ -0,0 +1,16 @@
+package networkmanagers
+
+type StaticMember struct {
+	Etag       *string                 `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *StaticMemberProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type StaticMemberProperties struct {
+	Description       *string `json:"description,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	ResourceId        *string `json:"resourceId,omitempty"`
+}
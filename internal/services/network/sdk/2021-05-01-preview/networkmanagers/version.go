@@ -0,0 +1,9 @@
+package networkmanagers
+
+import "fmt"
+
+const defaultApiVersion = "2021-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networkmanagers/%s", defaultApiVersion)
+}
@@ -0,0 +1,195 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceVirtualNetworkAvailablePrefixes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkAvailablePrefixesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkID,
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"prefix_length": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 64),
+						},
+					},
+				},
+			},
+
+			"excluded_address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"prefix": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefix": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"first_usable_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_usable_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkAvailablePrefixesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	addressSpaces := make([]string, 0)
+	existingSubnets := make(map[string][]string)
+	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			addressSpaces = *props.AddressSpace.AddressPrefixes
+		}
+
+		if props.Subnets != nil {
+			for _, subnet := range *props.Subnets {
+				if subnet.Name == nil || subnet.SubnetPropertiesFormat == nil {
+					continue
+				}
+
+				prefixes := make([]string, 0)
+				if subnet.SubnetPropertiesFormat.AddressPrefix != nil {
+					prefixes = append(prefixes, *subnet.SubnetPropertiesFormat.AddressPrefix)
+				}
+				if subnet.SubnetPropertiesFormat.AddressPrefixes != nil {
+					prefixes = append(prefixes, *subnet.SubnetPropertiesFormat.AddressPrefixes...)
+				}
+				existingSubnets[*subnet.Name] = prefixes
+			}
+		}
+	}
+
+	requests := make([]utils.SubnetPrefixRequest, 0)
+	requested := make(map[string]bool)
+	for _, raw := range d.Get("subnet").([]interface{}) {
+		v := raw.(map[string]interface{})
+		request := utils.SubnetPrefixRequest{
+			SubnetName:   v["name"].(string),
+			PrefixLength: v["prefix_length"].(int),
+		}
+
+		key := fmt.Sprintf("%s/%d", request.SubnetName, request.PrefixLength)
+		if requested[key] {
+			return fmt.Errorf("a /%d prefix is requested more than once for the Subnet %q", request.PrefixLength, request.SubnetName)
+		}
+		requested[key] = true
+
+		requests = append(requests, request)
+	}
+
+	excludedPrefixes := *utils.ExpandStringSlice(d.Get("excluded_address_prefixes").([]interface{}))
+	prefixes, err := utils.AvailableSubnetPrefixesForSubnets(addressSpaces, existingSubnets, excludedPrefixes, requests)
+	if err != nil {
+		return fmt.Errorf("allocating prefixes within %s: %+v", *id, err)
+	}
+
+	prefixBlocks := make([]interface{}, 0)
+	for i, prefix := range prefixes {
+		first, last, err := utils.SubnetUsableAddressRange(prefix)
+		if err != nil {
+			return err
+		}
+
+		prefixBlocks = append(prefixBlocks, map[string]interface{}{
+			"subnet_name":             requests[i].SubnetName,
+			"address_prefix":          prefix,
+			"first_usable_ip_address": first,
+			"last_usable_ip_address":  last,
+		})
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("virtual_network_id", id.ID())
+
+	if err := d.Set("address_prefixes", prefixes); err != nil {
+		return fmt.Errorf("setting `address_prefixes`: %+v", err)
+	}
+
+	if err := d.Set("prefix", prefixBlocks); err != nil {
+		return fmt.Errorf("setting `prefix`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type VirtualNetworkAvailablePrefixesDataSource struct{}
+
+func TestAccVirtualNetworkAvailablePrefixesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_prefixes", "test")
+	r := VirtualNetworkAvailablePrefixesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("3"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.2.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.1.64/26"),
+				check.That(data.ResourceName).Key("address_prefixes.2").HasValue("10.0.1.128/29"),
+				check.That(data.ResourceName).Key("prefix.0.subnet_name").HasValue("third"),
+				check.That(data.ResourceName).Key("prefix.0.first_usable_ip_address").HasValue("10.0.2.4"),
+				check.That(data.ResourceName).Key("prefix.0.last_usable_ip_address").HasValue("10.0.2.254"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualNetworkAvailablePrefixesDataSource_excluded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_prefixes", "test")
+	r := VirtualNetworkAvailablePrefixesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.excluded(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.4.0/24"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualNetworkAvailablePrefixesDataSource_existingSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_prefixes", "test")
+	r := VirtualNetworkAvailablePrefixesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.existingSubnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.2.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.1.64/26"),
+				check.That("azurerm_subnet.third").Key("address_prefixes.0").HasValue("10.0.2.0/24"),
+			),
+		},
+		{
+			// re-reading once the Subnet exists must return the same prefixes, otherwise the plan isn't empty
+			Config: r.existingSubnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.2.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.1.64/26"),
+			),
+		},
+	})
+}
+
+func (r VirtualNetworkAvailablePrefixesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_available_prefixes" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  subnet {
+    name          = "third"
+    prefix_length = 24
+  }
+
+  subnet {
+    name          = "fourth"
+    prefix_length = 26
+  }
+
+  subnet {
+    name          = "fifth"
+    prefix_length = 29
+  }
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, r.template(data))
+}
+
+func (r VirtualNetworkAvailablePrefixesDataSource) excluded(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_available_prefixes" "test" {
+  virtual_network_id        = azurerm_virtual_network.test.id
+  excluded_address_prefixes = ["10.0.2.0/23"]
+
+  subnet {
+    name          = "third"
+    prefix_length = 24
+  }
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, r.template(data))
+}
+
+func (r VirtualNetworkAvailablePrefixesDataSource) existingSubnet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_available_prefixes" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  subnet {
+    name          = "third"
+    prefix_length = 24
+  }
+
+  subnet {
+    name          = "fourth"
+    prefix_length = 26
+  }
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+
+resource "azurerm_subnet" "third" {
+  name                 = "third"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [data.azurerm_virtual_network_available_prefixes.test.address_prefixes[0]]
+}
+`, r.template(data))
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/26"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,320 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+)
+
+func dataSourceVirtualNetworkGatewayBgpPeerStatus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayBgpPeerStatusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			// retrieving the BGP Peer Status and Learned Routes are both long running operations
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"peer": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"peer_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"asn": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"connected_duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"local_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"messages_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"messages_sent": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"neighbor": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"routes_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"learned_route": gatewayRouteSchema(),
+
+			"advertised_route": gatewayRouteSchema(),
+		},
+	}
+}
+
+func gatewayRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayBgpPeerStatusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	peer := d.Get("peer").(string)
+
+	statusFuture, err := client.GetBgpPeerStatus(ctx, id.ResourceGroup, id.Name, peer)
+	if err != nil {
+		return fmt.Errorf("retrieving BGP Peer Status for %s: %+v", *id, err)
+	}
+	if err := statusFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for BGP Peer Status for %s: %+v", *id, err)
+	}
+	status, err := statusFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of BGP Peer Status for %s: %+v", *id, err)
+	}
+
+	learnedFuture, err := client.GetLearnedRoutes(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Learned Routes for %s: %+v", *id, err)
+	}
+	if err := learnedFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Learned Routes for %s: %+v", *id, err)
+	}
+	learned, err := learnedFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving result of Learned Routes for %s: %+v", *id, err)
+	}
+
+	// the Advertised Routes can only be retrieved for a specific peer
+	advertised := network.GatewayRouteListResult{}
+	if peer != "" {
+		advertisedFuture, err := client.GetAdvertisedRoutes(ctx, id.ResourceGroup, id.Name, peer)
+		if err != nil {
+			return fmt.Errorf("retrieving Advertised Routes to %q for %s: %+v", peer, *id, err)
+		}
+		if err := advertisedFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Advertised Routes to %q for %s: %+v", peer, *id, err)
+		}
+		advertised, err = advertisedFuture.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving result of Advertised Routes to %q for %s: %+v", peer, *id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("virtual_network_gateway_id", id.ID())
+
+	if err := d.Set("peer_status", flattenVirtualNetworkGatewayBgpPeerStatus(status.Value)); err != nil {
+		return fmt.Errorf("setting `peer_status`: %+v", err)
+	}
+
+	if err := d.Set("learned_route", flattenVirtualNetworkGatewayRoutes(learned.Value)); err != nil {
+		return fmt.Errorf("setting `learned_route`: %+v", err)
+	}
+
+	if err := d.Set("advertised_route", flattenVirtualNetworkGatewayRoutes(advertised.Value)); err != nil {
+		return fmt.Errorf("setting `advertised_route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkGatewayBgpPeerStatus(input *[]network.BgpPeerStatus) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		asn := 0
+		if item.Asn != nil {
+			asn = int(*item.Asn)
+		}
+
+		connectedDuration := ""
+		if item.ConnectedDuration != nil {
+			connectedDuration = *item.ConnectedDuration
+		}
+
+		localAddress := ""
+		if item.LocalAddress != nil {
+			localAddress = *item.LocalAddress
+		}
+
+		messagesReceived := 0
+		if item.MessagesReceived != nil {
+			messagesReceived = int(*item.MessagesReceived)
+		}
+
+		messagesSent := 0
+		if item.MessagesSent != nil {
+			messagesSent = int(*item.MessagesSent)
+		}
+
+		neighbor := ""
+		if item.Neighbor != nil {
+			neighbor = *item.Neighbor
+		}
+
+		routesReceived := 0
+		if item.RoutesReceived != nil {
+			routesReceived = int(*item.RoutesReceived)
+		}
+
+		results = append(results, map[string]interface{}{
+			"asn":                asn,
+			"connected_duration": connectedDuration,
+			"local_address":      localAddress,
+			"messages_received":  messagesReceived,
+			"messages_sent":      messagesSent,
+			"neighbor":           neighbor,
+			"routes_received":    routesReceived,
+			"state":              string(item.State),
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualNetworkGatewayRoutes(input *[]network.GatewayRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		asPath := ""
+		if item.AsPath != nil {
+			asPath = *item.AsPath
+		}
+
+		localAddress := ""
+		if item.LocalAddress != nil {
+			localAddress = *item.LocalAddress
+		}
+
+		networkPrefix := ""
+		if item.NetworkProperty != nil {
+			networkPrefix = *item.NetworkProperty
+		}
+
+		nextHop := ""
+		if item.NextHop != nil {
+			nextHop = *item.NextHop
+		}
+
+		origin := ""
+		if item.Origin != nil {
+			origin = *item.Origin
+		}
+
+		sourcePeer := ""
+		if item.SourcePeer != nil {
+			sourcePeer = *item.SourcePeer
+		}
+
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"as_path":       asPath,
+			"local_address": localAddress,
+			"network":       networkPrefix,
+			"next_hop":      nextHop,
+			"origin":        origin,
+			"source_peer":   sourcePeer,
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,142 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayBgpPeerStatusDataSource struct{}
+
+func TestAccVirtualNetworkGatewayBgpPeerStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_peer_status", "test")
+	r := VirtualNetworkGatewayBgpPeerStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peer_status.#").Exists(),
+				check.That(data.ResourceName).Key("learned_route.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_route.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualNetworkGatewayBgpPeerStatusDataSource_peer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_peer_status", "test")
+	r := VirtualNetworkGatewayBgpPeerStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.peer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peer_status.#").HasValue("1"),
+				check.That(data.ResourceName).Key("peer_status.0.neighbor").HasValue("10.1.1.254"),
+				check.That(data.ResourceName).Key("advertised_route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayBgpPeerStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_peer_status" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+}
+`, VirtualNetworkGatewayBgpPeerStatusDataSource{}.template(data))
+}
+
+func (VirtualNetworkGatewayBgpPeerStatusDataSource) peer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_peer_status" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_connection.test.virtual_network_gateway_id
+  peer                       = azurerm_local_network_gateway.test.bgp_settings.0.bgp_peering_address
+}
+`, VirtualNetworkGatewayBgpPeerStatusDataSource{}.template(data))
+}
+
+func (VirtualNetworkGatewayBgpPeerStatusDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-bgppeerstatus-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Vpn"
+  vpn_type            = "RouteBased"
+  sku                 = "VpnGw1"
+  enable_bgp          = true
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.test.id
+  }
+
+  bgp_settings {
+    asn = 65515
+  }
+}
+
+resource "azurerm_local_network_gateway" "test" {
+  name                = "acctestlng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  gateway_address     = "168.62.225.23"
+  address_space       = ["10.1.1.0/24"]
+
+  bgp_settings {
+    asn                 = 65050
+    bgp_peering_address = "10.1.1.254"
+  }
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+  name                       = "acctestvngc-%[1]d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurerm_local_network_gateway.test.id
+  shared_key                 = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+  enable_bgp                 = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
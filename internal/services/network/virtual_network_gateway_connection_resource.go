@@ -10,6 +10,7 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/suppress"
@@ -119,6 +120,24 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ingress_nat_rule_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.VirtualNetworkGatewayNatRuleID,
+				},
+			},
+
+			"egress_nat_rule_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.VirtualNetworkGatewayNatRuleID,
+				},
+			},
+
 			"routing_weight": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -433,6 +452,14 @@ func resourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta
 		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
 	}
 
+	if err := d.Set("ingress_nat_rule_ids", flattenNetworkSubResourceID(conn.IngressNatRules)); err != nil {
+		return fmt.Errorf("setting `ingress_nat_rule_ids`: %+v", err)
+	}
+
+	if err := d.Set("egress_nat_rule_ids", flattenNetworkSubResourceID(conn.EgressNatRules)); err != nil {
+		return fmt.Errorf("setting `egress_nat_rule_ids`: %+v", err)
+	}
+
 	if conn.RoutingWeight != nil {
 		d.Set("routing_weight", conn.RoutingWeight)
 	}
@@ -493,6 +520,8 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		EnableBgp:                      utils.Bool(d.Get("enable_bgp").(bool)),
 		ExpressRouteGatewayBypass:      utils.Bool(d.Get("express_route_gateway_bypass").(bool)),
 		UsePolicyBasedTrafficSelectors: utils.Bool(d.Get("use_policy_based_traffic_selectors").(bool)),
+		IngressNatRules:                expandNetworkSubResourceID(d.Get("ingress_nat_rule_ids").(*pluginsdk.Set).List()),
+		EgressNatRules:                 expandNetworkSubResourceID(d.Get("egress_nat_rule_ids").(*pluginsdk.Set).List()),
 	}
 
 	if v, ok := d.GetOk("virtual_network_gateway_id"); ok {
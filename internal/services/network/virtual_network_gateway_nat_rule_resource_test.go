@@ -0,0 +1,308 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type VirtualNetworkGatewayNatRuleResource struct{}
+
+func TestAccVirtualNetworkGatewayNatRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_nat_rule", "test")
+	r := VirtualNetworkGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkGatewayNatRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_nat_rule", "test")
+	r := VirtualNetworkGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualNetworkGatewayNatRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_nat_rule", "test")
+	r := VirtualNetworkGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkGatewayNatRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_nat_rule", "test")
+	r := VirtualNetworkGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkGatewayNatRule_connection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_nat_rule", "test")
+	r := VirtualNetworkGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_virtual_network_gateway_connection.test").Key("egress_nat_rule_ids.#").HasValue("1"),
+				check.That("azurerm_virtual_network_gateway_connection.test").Key("ingress_nat_rule_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualNetworkGatewayNatRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VnetGatewayNatRuleClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkGatewayName, id.NatRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VirtualNetworkGatewayNatRuleProperties != nil), nil
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "test" {
+  name                       = "acctest-vnetgwnatrule-%d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "import" {
+  name                       = azurerm_virtual_network_gateway_nat_rule.test.name
+  virtual_network_gateway_id = azurerm_virtual_network_gateway_nat_rule.test.virtual_network_gateway_id
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "test" {
+  name                       = "acctest-vnetgwnatrule-%d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  mode                       = "IngressSnat"
+  type                       = "Dynamic"
+  ip_configuration_id        = "${azurerm_virtual_network_gateway.test.id}/ipConfigurations/vnetGatewayConfig"
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "test" {
+  name                       = "acctest-vnetgwnatrule-%d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  ip_configuration_id        = "${azurerm_virtual_network_gateway.test.id}/ipConfigurations/vnetGatewayConfig"
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  external_mapping {
+    address_space = "10.3.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.5.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkGatewayNatRuleResource) connection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "test" {
+  name                       = "acctest-vnetgwnatrule-%[2]d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+
+resource "azurerm_virtual_network_gateway_nat_rule" "ingress" {
+  name                       = "acctest-vnetgwnatrule-in-%[2]d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  mode                       = "IngressSnat"
+
+  external_mapping {
+    address_space = "10.6.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.7.0.0/26"
+  }
+}
+
+resource "azurerm_local_network_gateway" "test" {
+  name                = "acctestlng-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  gateway_address     = "168.62.225.23"
+  address_space       = ["10.1.1.0/24"]
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+  name                       = "acctestvngc-%[2]d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurerm_local_network_gateway.test.id
+  shared_key                 = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+  egress_nat_rule_ids        = [azurerm_virtual_network_gateway_nat_rule.test.id]
+  ingress_nat_rule_ids       = [azurerm_virtual_network_gateway_nat_rule.ingress.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (VirtualNetworkGatewayNatRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vnetgwnatrule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Vpn"
+  vpn_type            = "RouteBased"
+  sku                 = "VpnGw2"
+  generation          = "Generation2"
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
@@ -20,6 +21,8 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
 
+var virtualNetworkGatewayResourceName = "azurerm_virtual_network_gateway"
+
 func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualNetworkGatewayCreateUpdate,
@@ -85,6 +88,12 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"bgp_route_translation_for_nat_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"active_active": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -398,6 +407,9 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	id := parse.NewVirtualNetworkGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
+	locks.ByName(id.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkGatewayResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -423,6 +435,19 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// NAT Rules are managed using the `azurerm_virtual_network_gateway_nat_rule` resource, so we need to
+		// send the existing ones otherwise they'd be removed
+		if existing.VirtualNetworkGatewayPropertiesFormat != nil {
+			properties.NatRules = existing.VirtualNetworkGatewayPropertiesFormat.NatRules
+		}
+	}
+
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &id.Name,
 		Location:                              &location,
@@ -473,6 +498,7 @@ func resourceVirtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("type", string(gw.GatewayType))
 		d.Set("enable_bgp", gw.EnableBgp)
 		d.Set("private_ip_address_enabled", gw.EnablePrivateIPAddress)
+		d.Set("bgp_route_translation_for_nat_enabled", gw.EnableBgpRouteTranslationForNat)
 		d.Set("active_active", gw.ActiveActive)
 		d.Set("generation", string(gw.VpnGatewayGeneration))
 
@@ -522,6 +548,9 @@ func resourceVirtualNetworkGatewayDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	locks.ByName(id.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -539,6 +568,7 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 	vpnType := network.VpnType(d.Get("vpn_type").(string))
 	enableBgp := d.Get("enable_bgp").(bool)
 	enablePrivateIpAddress := d.Get("private_ip_address_enabled").(bool)
+	enableBgpRouteTranslationForNat := d.Get("bgp_route_translation_for_nat_enabled").(bool)
 	activeActive := d.Get("active_active").(bool)
 	generation := network.VpnGatewayGeneration(d.Get("generation").(string))
 	customRoute := d.Get("custom_route").([]interface{})
@@ -553,6 +583,8 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 		Sku:                    expandVirtualNetworkGatewaySku(d),
 		IPConfigurations:       expandVirtualNetworkGatewayIPConfigurations(d),
 		CustomRoutes:           expandVirtualNetworkGatewayAddressSpace(customRoute),
+
+		EnableBgpRouteTranslationForNat: &enableBgpRouteTranslationForNat,
 	}
 
 	if gatewayDefaultSiteID := d.Get("default_local_network_gateway_id").(string); gatewayDefaultSiteID != "" {
@@ -268,6 +268,24 @@ func resourceVPNGatewayConnection() *pluginsdk.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"ingress_nat_rule_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.VpnGatewayNatRuleID,
+							},
+						},
+
+						"egress_nat_rule_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.VpnGatewayNatRuleID,
+							},
+						},
 					},
 				},
 			},
@@ -444,6 +462,8 @@ func expandVpnGatewayConnectionVpnSiteLinkConnections(input []interface{}) *[]ne
 				EnableRateLimiting:             utils.Bool(e["ratelimit_enabled"].(bool)),
 				UseLocalAzureIPAddress:         utils.Bool(e["local_azure_ip_address_enabled"].(bool)),
 				UsePolicyBasedTrafficSelectors: utils.Bool(e["policy_based_traffic_selector_enabled"].(bool)),
+				IngressNatRules:                expandNetworkSubResourceID(e["ingress_nat_rule_ids"].(*pluginsdk.Set).List()),
+				EgressNatRules:                 expandNetworkSubResourceID(e["egress_nat_rule_ids"].(*pluginsdk.Set).List()),
 			},
 		}
 
@@ -522,6 +542,8 @@ func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteL
 			"ratelimit_enabled":                     rateLimitEnabled,
 			"local_azure_ip_address_enabled":        useLocalAzureIpAddress,
 			"policy_based_traffic_selector_enabled": usePolicyBased,
+			"ingress_nat_rule_ids":                  flattenNetworkSubResourceID(e.IngressNatRules),
+			"egress_nat_rule_ids":                   flattenNetworkSubResourceID(e.EgressNatRules),
 		}
 
 		output = append(output, v)
@@ -0,0 +1,281 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type VPNGatewayNatRuleResource struct{}
+
+func TestAccVpnGatewayNatRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVpnGatewayNatRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVpnGatewayNatRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVpnGatewayNatRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVpnGatewayNatRule_connection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_vpn_gateway_connection.test").Key("vpn_link.0.egress_nat_rule_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VPNGatewayNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VpnGatewayNatRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VpnGatewayNatRuleClient.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VpnGatewayNatRuleProperties != nil), nil
+}
+
+func (r VPNGatewayNatRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name           = "acctest-vpngwnatrule-%d"
+  vpn_gateway_id = azurerm_vpn_gateway.test.id
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VPNGatewayNatRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "import" {
+  name           = azurerm_vpn_gateway_nat_rule.test.name
+  vpn_gateway_id = azurerm_vpn_gateway_nat_rule.test.vpn_gateway_id
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VPNGatewayNatRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name                = "acctest-vpngwnatrule-%d"
+  vpn_gateway_id      = azurerm_vpn_gateway.test.id
+  mode                = "IngressSnat"
+  type                = "Dynamic"
+  ip_configuration_id = "Instance0"
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VPNGatewayNatRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name                = "acctest-vpngwnatrule-%d"
+  vpn_gateway_id      = azurerm_vpn_gateway.test.id
+  ip_configuration_id = "Instance1"
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  external_mapping {
+    address_space = "192.168.22.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.5.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VPNGatewayNatRuleResource) connection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name           = "acctest-vpngwnatrule-%[2]d"
+  vpn_gateway_id = azurerm_vpn_gateway.test.id
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctest-vpnsite-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+
+  link {
+    name       = "link1"
+    ip_address = "10.0.0.1"
+  }
+}
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctest-vpngwconn-%[2]d"
+  vpn_gateway_id     = azurerm_vpn_gateway.test.id
+  remote_vpn_site_id = azurerm_vpn_site.test.id
+
+  vpn_link {
+    name                = "link1"
+    vpn_site_link_id    = azurerm_vpn_site.test.link[0].id
+    egress_nat_rule_ids = [azurerm_vpn_gateway_nat_rule.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (VPNGatewayNatRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vpngwnatrule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctest-vwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-vhub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.0.0/24"
+}
+
+resource "azurerm_vpn_gateway" "test" {
+  name                = "acctest-vpngw-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  virtual_hub_id      = azurerm_virtual_hub.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
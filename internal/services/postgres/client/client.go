@@ -4,6 +4,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2021-06-01/postgresqlflexibleservers"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/administrators"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/configurations"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/servers"
 )
 
 type Client struct {
@@ -12,8 +15,11 @@ type Client struct {
 	FirewallRulesClient                 *postgresql.FirewallRulesClient
 	FlexibleServersClient               *postgresqlflexibleservers.ServersClient
 	FlexibleServersConfigurationsClient *postgresqlflexibleservers.ConfigurationsClient
+	FlexibleServerAdministratorsClient  *administrators.AdministratorsClient
 	FlexibleServerFirewallRuleClient    *postgresqlflexibleservers.FirewallRulesClient
 	FlexibleServerDatabaseClient        *postgresqlflexibleservers.DatabasesClient
+	FlexibleServerParametersClient      *configurations.ConfigurationsClient
+	FlexibleServerPropertiesClient      *servers.ServersClient
 	ServersClient                       *postgresql.ServersClient
 	ServerKeysClient                    *postgresql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient   *postgresql.ServerSecurityAlertPoliciesClient
@@ -62,6 +68,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := postgresqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerAdministratorsClient := administrators.NewAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerParametersClient := configurations.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerParametersClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerPropertiesClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerPropertiesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationsClient:                &configurationsClient,
 		DatabasesClient:                     &databasesClient,
@@ -70,6 +85,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		FlexibleServersClient:               &flexibleServersClient,
 		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
+		FlexibleServerAdministratorsClient:  &flexibleServerAdministratorsClient,
+		FlexibleServerParametersClient:      &flexibleServerParametersClient,
+		FlexibleServerPropertiesClient:      &flexibleServerPropertiesClient,
 		ServersClient:                       &serversClient,
 		ServerKeysClient:                    &serverKeysClient,
 		ServerSecurityAlertPoliciesClient:   &serverSecurityAlertPoliciesClient,
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/administrators"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func resourcePostgresqlFlexibleServerActiveDirectoryAdministrator() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePostgresqlFlexibleServerActiveDirectoryAdministratorCreate,
+		Read:   resourcePostgresqlFlexibleServerActiveDirectoryAdministratorRead,
+		Delete: resourcePostgresqlFlexibleServerActiveDirectoryAdministratorDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := administrators.ParseAdministratorID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerID,
+			},
+
+			"object_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"principal_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(administrators.PrincipalTypeGroup),
+					string(administrators.PrincipalTypeServicePrincipal),
+					string(administrators.PrincipalTypeUser),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourcePostgresqlFlexibleServerActiveDirectoryAdministratorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.FlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := administrators.NewAdministratorID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, d.Get("object_id").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_postgresql_flexible_server_active_directory_administrator", id.ID())
+	}
+
+	principalType := administrators.PrincipalType(d.Get("principal_type").(string))
+	parameters := administrators.ActiveDirectoryAdministratorAdd{
+		Properties: &administrators.AdministratorPropertiesForAdd{
+			PrincipalName: utils.String(d.Get("principal_name").(string)),
+			PrincipalType: &principalType,
+			TenantId:      utils.String(d.Get("tenant_id").(string)),
+		},
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePostgresqlFlexibleServerActiveDirectoryAdministratorRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerActiveDirectoryAdministratorRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := administrators.ParseAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("server_id", parse.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName).ID())
+	d.Set("object_id", id.ObjectId)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("principal_name", props.PrincipalName)
+			d.Set("tenant_id", props.TenantId)
+
+			principalType := ""
+			if props.PrincipalType != nil {
+				principalType = string(*props.PrincipalType)
+			}
+			d.Set("principal_type", principalType)
+		}
+	}
+
+	return nil
+}
+
+func resourcePostgresqlFlexibleServerActiveDirectoryAdministratorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := administrators.ParseAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2021-06-01/postgresqlflexibleservers"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/configurations"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+const flexibleServerConfigurationSourceUserOverride = "user-override"
+
+func resourcePostgresqlFlexibleServerConfigurations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFlexibleServerConfigurationsCreateUpdate,
+		Read:   resourceFlexibleServerConfigurationsRead,
+		Update: resourceFlexibleServerConfigurationsCreateUpdate,
+		Delete: resourceFlexibleServerConfigurationsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(1 * time.Hour),
+		},
+
+		// the configurations are managed as a whole for a server, so the server ID identifies this resource
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FlexibleServerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerID,
+			},
+
+			"configurations": {
+				Type:     pluginsdk.TypeMap,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceFlexibleServerConfigurationsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerParametersClient
+	serversClient := meta.(*clients.Client).Postgres.FlexibleServersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	values := d.Get("configurations").(map[string]interface{})
+
+	// configurations removed from the map are reset to their defaults, as the singular resource does on deletion
+	removed := make([]string, 0)
+	if d.HasChange("configurations") {
+		old, _ := d.GetChange("configurations")
+		for name := range old.(map[string]interface{}) {
+			if _, ok := values[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	restartRequired := false
+	for _, name := range sortedFlexibleServerConfigurationNames(values) {
+		value := values[name].(string)
+		static, err := setFlexibleServerConfiguration(ctx, client, *id, name, &value)
+		if err != nil {
+			return err
+		}
+		restartRequired = restartRequired || static
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		static, err := setFlexibleServerConfiguration(ctx, client, *id, name, nil)
+		if err != nil {
+			return err
+		}
+		restartRequired = restartRequired || static
+	}
+
+	if restartRequired {
+		if err := restartFlexibleServerForConfigurations(ctx, serversClient, *id); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFlexibleServerConfigurationsRead(d, meta)
+}
+
+func resourceFlexibleServerConfigurationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerParametersClient
+	serversClient := meta.(*clients.Client).Postgres.FlexibleServersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	server, err := serversClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(server.Response) {
+			log.Printf("[INFO] %s does not exist - removing Configurations from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	resp, err := client.ListByServerComplete(ctx, configurations.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("listing Configurations for %s: %+v", *id, err)
+	}
+
+	current := make(map[string]string)
+	overridden := make(map[string]string)
+	for _, item := range resp.Items {
+		if item.Name == nil || item.Properties == nil || item.Properties.Value == nil {
+			continue
+		}
+		current[*item.Name] = *item.Properties.Value
+		if source := item.Properties.Source; source != nil && *source == flexibleServerConfigurationSourceUserOverride {
+			overridden[*item.Name] = *item.Properties.Value
+		}
+	}
+
+	// only the configurations being managed are tracked, unless this is an import in which case every
+	// configuration which has been overridden is
+	values := make(map[string]interface{})
+	if existing := d.Get("configurations").(map[string]interface{}); len(existing) > 0 {
+		for name := range existing {
+			if value, ok := current[name]; ok {
+				values[name] = value
+			}
+		}
+	} else {
+		for name, value := range overridden {
+			values[name] = value
+		}
+	}
+
+	d.Set("server_id", id.ID())
+	if err := d.Set("configurations", values); err != nil {
+		return fmt.Errorf("setting `configurations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFlexibleServerConfigurationsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerParametersClient
+	serversClient := meta.(*clients.Client).Postgres.FlexibleServersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	restartRequired := false
+	for _, name := range sortedFlexibleServerConfigurationNames(d.Get("configurations").(map[string]interface{})) {
+		static, err := setFlexibleServerConfiguration(ctx, client, *id, name, nil)
+		if err != nil {
+			return err
+		}
+		restartRequired = restartRequired || static
+	}
+
+	if restartRequired {
+		if err := restartFlexibleServerForConfigurations(ctx, serversClient, *id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFlexibleServerConfiguration sets the named configuration to the specified value, or resets it to its default
+// when value is nil. It returns whether a restart of the server is required for the change to take effect, which is
+// the case when the value of a static configuration is changed
+func setFlexibleServerConfiguration(ctx context.Context, client *configurations.ConfigurationsClient, serverId parse.FlexibleServerId, name string, value *string) (bool, error) {
+	id := configurations.NewConfigurationID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, name)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) && value == nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return false, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := *existing.Model.Properties
+
+	if value == nil {
+		value = props.DefaultValue
+	}
+	if value == nil || (props.Value != nil && *props.Value == *value) {
+		return false, nil
+	}
+
+	parameters := configurations.Configuration{
+		Properties: &configurations.ConfigurationProperties{
+			Value:  value,
+			Source: utils.String(flexibleServerConfigurationSourceUserOverride),
+		},
+	}
+	if err := client.PutThenPoll(ctx, id, parameters); err != nil {
+		return false, fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return props.IsDynamicConfig != nil && !*props.IsDynamicConfig, nil
+}
+
+func restartFlexibleServerForConfigurations(ctx context.Context, client *postgresqlflexibleservers.ServersClient, id parse.FlexibleServerId) error {
+	log.Printf("[INFO] restarting %s to apply static configurations", id)
+
+	future, err := client.Restart(ctx, id.ResourceGroup, id.Name, nil)
+	if err != nil {
+		return fmt.Errorf("restarting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for restart of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func sortedFlexibleServerConfigurationNames(input map[string]interface{}) []string {
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,136 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type PostgresqlFlexibleServerConfigurationsResource struct {
+}
+
+func TestAccFlexibleServerConfigurations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_configurations", "test")
+	r := PostgresqlFlexibleServerConfigurationsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configurations.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFlexibleServerConfigurations_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_configurations", "test")
+	r := PostgresqlFlexibleServerConfigurationsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// `max_prepared_transactions` is a static configuration, so applying this restarts the server
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configurations.%").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClientForResource(PostgresqlFlexibleServerConfigurationResource{}.checkReset("max_prepared_transactions"), "azurerm_postgresql_flexible_server.test"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.template(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(PostgresqlFlexibleServerConfigurationResource{}.checkReset("backslash_quote"), "azurerm_postgresql_flexible_server.test"),
+				data.CheckWithClientForResource(PostgresqlFlexibleServerConfigurationResource{}.checkReset("log_min_duration_statement"), "azurerm_postgresql_flexible_server.test"),
+			),
+		},
+	})
+}
+
+func (r PostgresqlFlexibleServerConfigurationsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FlexibleServerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, expected := range state.Attributes {
+		if !strings.HasPrefix(key, "configurations.") || key == "configurations.%" {
+			continue
+		}
+		name := strings.TrimPrefix(key, "configurations.")
+
+		resp, err := clients.Postgres.FlexibleServersConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Configuration %q for %s: %+v", name, *id, err)
+		}
+
+		if resp.ConfigurationProperties == nil || resp.Value == nil || *resp.Value != expected {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (PostgresqlFlexibleServerConfigurationsResource) template(data acceptance.TestData) string {
+	return PostgresqlFlexibleServerResource{}.basic(data)
+}
+
+func (r PostgresqlFlexibleServerConfigurationsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server_configurations" "test" {
+  server_id = azurerm_postgresql_flexible_server.test.id
+
+  configurations = {
+    backslash_quote            = "on"
+    log_min_duration_statement = "1000"
+  }
+}
+`, r.template(data))
+}
+
+func (r PostgresqlFlexibleServerConfigurationsResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server_configurations" "test" {
+  server_id = azurerm_postgresql_flexible_server.test.id
+
+  configurations = {
+    backslash_quote            = "off"
+    log_min_duration_statement = "500"
+    max_prepared_transactions  = "100"
+  }
+}
+`, r.template(data))
+}
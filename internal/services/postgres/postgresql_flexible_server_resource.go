@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
 	networkValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/sdk/2022-12-01/servers"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/postgres/validate"
 	privateDnsValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/privatedns/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
@@ -88,6 +90,12 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{32768, 65536, 131072, 262144, 524288, 1048576, 2097152, 4194304, 8388608, 16777216, 33554432}),
 			},
 
+			"auto_grow_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -118,9 +126,49 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					string(postgresqlflexibleservers.CreateModeDefault),
 					string(postgresqlflexibleservers.CreateModePointInTimeRestore),
+					string(servers.CreateModeReplica),
 				}, false),
 			},
 
+			"replication_role": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				// a replica can only be promoted to a standalone server, which is done by setting this to `None`
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationRoleNone),
+				}, false),
+			},
+
+			"authentication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"active_directory_auth_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"password_auth_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"tenant_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
 			"delegated_subnet_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -241,7 +289,9 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 
 func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	tenantId := meta.(*clients.Client).Account.TenantId
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	propertiesClient := meta.(*clients.Client).Postgres.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -271,6 +321,15 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if servers.CreateMode(createMode) == servers.CreateModeReplica {
+		if _, ok := d.GetOk("source_server_id"); !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `Replica`")
+		}
+		if _, ok := d.GetOk("point_in_time_restore_time_in_utc"); ok {
+			return fmt.Errorf("`point_in_time_restore_time_in_utc` cannot be set when `create_mode` is `Replica`")
+		}
+	}
+
 	if createMode == "" || postgresqlflexibleservers.CreateMode(createMode) == postgresqlflexibleservers.CreateModeDefault {
 		if _, ok := d.GetOk("administrator_login"); !ok {
 			return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default`")
@@ -289,6 +348,53 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if servers.CreateMode(createMode) == servers.CreateModeReplica {
+		// replicas inherit their sku, version, storage and administrator from the source server and can only be
+		// created using a newer API version than the rest of this resource
+		if err := createPostgresqlFlexibleServerReplica(ctx, propertiesClient, id, d); err != nil {
+			return err
+		}
+	} else if err := createPostgresqlFlexibleServer(ctx, client, id, d); err != nil {
+		return err
+	}
+
+	// `authentication` and `auto_grow_enabled` are only available in a newer API version, so are set once the server exists
+	if authentication, autoGrow := d.Get("authentication").([]interface{}), d.Get("auto_grow_enabled").(bool); len(authentication) > 0 || autoGrow {
+		serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		properties := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				AuthConfig: expandFlexibleServerAuthConfig(authentication, tenantId),
+				Storage:    expandFlexibleServerStorageAutoGrow(autoGrow),
+			},
+		}
+		if err := propertiesClient.UpdateThenPoll(ctx, serverId, properties); err != nil {
+			return fmt.Errorf("updating `authentication` and `auto_grow_enabled` for %s: %+v", id, err)
+		}
+	}
+
+	// `maintenance_window` could only be updated with, could not be created with
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		mwParams := postgresqlflexibleservers.ServerForUpdate{
+			ServerPropertiesForUpdate: &postgresqlflexibleservers.ServerPropertiesForUpdate{
+				MaintenanceWindow: expandArmServerMaintenanceWindow(v.([]interface{})),
+			},
+		}
+		mwFuture, err := client.Update(ctx, id.ResourceGroup, id.Name, mwParams)
+		if err != nil {
+			return fmt.Errorf("updating Postgresql Flexible Server %q maintenance window (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := mwFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the update of the Postgresql Flexible Server %q maintenance window (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePostgresqlFlexibleServerRead(d, meta)
+}
+
+func createPostgresqlFlexibleServer(ctx context.Context, client *postgresqlflexibleservers.ServersClient, id parse.FlexibleServerId, d *pluginsdk.ResourceData) error {
 	sku, err := expandFlexibleServerSku(d.Get("sku_name").(string))
 	if err != nil {
 		return fmt.Errorf("expanding `sku_name` for PostgreSQL Flexible Server %s (Resource Group %q): %v", id.Name, id.ResourceGroup, err)
@@ -342,30 +448,43 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for creation of the Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
-	// `maintenance_window` could only be updated with, could not be created with
-	if v, ok := d.GetOk("maintenance_window"); ok {
-		mwParams := postgresqlflexibleservers.ServerForUpdate{
-			ServerPropertiesForUpdate: &postgresqlflexibleservers.ServerPropertiesForUpdate{
-				MaintenanceWindow: expandArmServerMaintenanceWindow(v.([]interface{})),
-			},
-		}
-		mwFuture, err := client.Update(ctx, id.ResourceGroup, id.Name, mwParams)
-		if err != nil {
-			return fmt.Errorf("updating Postgresql Flexible Server %q maintenance window (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-		}
+	return nil
+}
 
-		if err := mwFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for the update of the Postgresql Flexible Server %q maintenance window (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-		}
+func createPostgresqlFlexibleServerReplica(ctx context.Context, client *servers.ServersClient, id parse.FlexibleServerId, d *pluginsdk.ResourceData) error {
+	createMode := servers.CreateModeReplica
+	parameters := servers.Server{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &servers.ServerProperties{
+			CreateMode:             &createMode,
+			SourceServerResourceId: utils.String(d.Get("source_server_id").(string)),
+			Network:                &servers.Network{},
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
 	}
 
-	d.SetId(id.ID())
+	if v, ok := d.GetOk("delegated_subnet_id"); ok {
+		parameters.Properties.Network.DelegatedSubnetResourceId = utils.String(v.(string))
+	}
 
-	return resourcePostgresqlFlexibleServerRead(d, meta)
+	if v, ok := d.GetOk("private_dns_zone_id"); ok {
+		parameters.Properties.Network.PrivateDnsZoneArmResourceId = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("zone"); ok && v.(string) != "" {
+		parameters.Properties.AvailabilityZone = utils.String(v.(string))
+	}
+
+	if err := client.CreateThenPoll(ctx, servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name), parameters); err != nil {
+		return fmt.Errorf("creating Postgresql Flexible Server Replica %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
 }
 
 func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	propertiesClient := meta.(*clients.Client).Postgres.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -428,11 +547,36 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 
 	d.Set("sku_name", sku)
 
+	properties, err := propertiesClient.Get(ctx, servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving `authentication` and replication properties for Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	replicationRole := ""
+	autoGrow := false
+	authentication := make([]interface{}, 0)
+	if model := properties.Model; model != nil && model.Properties != nil {
+		if v := model.Properties.ReplicationRole; v != nil {
+			replicationRole = string(*v)
+		}
+		if storage := model.Properties.Storage; storage != nil && storage.AutoGrow != nil {
+			autoGrow = *storage.AutoGrow == servers.StorageAutoGrowEnabled
+		}
+		authentication = flattenFlexibleServerAuthConfig(model.Properties.AuthConfig)
+	}
+	d.Set("replication_role", replicationRole)
+	d.Set("auto_grow_enabled", autoGrow)
+	if err := d.Set("authentication", authentication); err != nil {
+		return fmt.Errorf("setting `authentication`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.Client).Account.TenantId
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	propertiesClient := meta.(*clients.Client).Postgres.FlexibleServerPropertiesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -441,6 +585,28 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	// promoting a replica has to happen on its own, before any other changes are made to the now standalone server
+	if d.HasChange("replication_role") {
+		oldRole, newRole := d.GetChange("replication_role")
+		if newRole.(string) != string(servers.ReplicationRoleNone) {
+			return fmt.Errorf("`replication_role` can only be changed to `None` to promote a replica, got %q", newRole.(string))
+		}
+
+		if oldRole.(string) != "" && oldRole.(string) != string(servers.ReplicationRoleNone) && oldRole.(string) != string(servers.ReplicationRolePrimary) {
+			role := servers.ReplicationRoleNone
+			promote := servers.ServerForUpdate{
+				Properties: &servers.ServerPropertiesForUpdate{
+					ReplicationRole: &role,
+				},
+			}
+			if err := propertiesClient.UpdateThenPoll(ctx, serverId, promote); err != nil {
+				return fmt.Errorf("promoting %s: %+v", *id, err)
+			}
+		}
+	}
+
 	parameters := postgresqlflexibleservers.ServerForUpdate{
 		Location:                  utils.String(location.Normalize(d.Get("location").(string))),
 		ServerPropertiesForUpdate: &postgresqlflexibleservers.ServerPropertiesForUpdate{},
@@ -516,6 +682,18 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for the update of the Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	if d.HasChanges("authentication", "auto_grow_enabled") {
+		properties := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				AuthConfig: expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{}), tenantId),
+				Storage:    expandFlexibleServerStorageAutoGrow(d.Get("auto_grow_enabled").(bool)),
+			},
+		}
+		if err := propertiesClient.UpdateThenPoll(ctx, serverId, properties); err != nil {
+			return fmt.Errorf("updating `authentication` and `auto_grow_enabled` for %s: %+v", *id, err)
+		}
+	}
+
 	if requireFailover {
 		restartParameters := &postgresqlflexibleservers.RestartParameter{
 			RestartWithFailover: utils.Bool(true),
@@ -719,3 +897,77 @@ func flattenFlexibleServerHighAvailability(ha *postgresqlflexibleservers.HighAva
 		},
 	}
 }
+
+func expandFlexibleServerAuthConfig(input []interface{}, defaultTenantId string) *servers.AuthConfig {
+	activeDirectoryAuth := servers.ActiveDirectoryAuthEnumDisabled
+	passwordAuth := servers.PasswordAuthEnumEnabled
+	if len(input) == 0 || input[0] == nil {
+		return &servers.AuthConfig{
+			ActiveDirectoryAuth: &activeDirectoryAuth,
+			PasswordAuth:        &passwordAuth,
+		}
+	}
+	v := input[0].(map[string]interface{})
+
+	if v["active_directory_auth_enabled"].(bool) {
+		activeDirectoryAuth = servers.ActiveDirectoryAuthEnumEnabled
+	}
+	if !v["password_auth_enabled"].(bool) {
+		passwordAuth = servers.PasswordAuthEnumDisabled
+	}
+
+	authConfig := servers.AuthConfig{
+		ActiveDirectoryAuth: &activeDirectoryAuth,
+		PasswordAuth:        &passwordAuth,
+	}
+
+	tenantId := v["tenant_id"].(string)
+	if tenantId == "" && activeDirectoryAuth == servers.ActiveDirectoryAuthEnumEnabled {
+		tenantId = defaultTenantId
+	}
+	if tenantId != "" {
+		authConfig.TenantId = utils.String(tenantId)
+	}
+
+	return &authConfig
+}
+
+func flattenFlexibleServerAuthConfig(input *servers.AuthConfig) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	activeDirectoryAuthEnabled := false
+	if input.ActiveDirectoryAuth != nil {
+		activeDirectoryAuthEnabled = *input.ActiveDirectoryAuth == servers.ActiveDirectoryAuthEnumEnabled
+	}
+
+	passwordAuthEnabled := true
+	if input.PasswordAuth != nil {
+		passwordAuthEnabled = *input.PasswordAuth == servers.PasswordAuthEnumEnabled
+	}
+
+	tenantId := ""
+	if input.TenantId != nil {
+		tenantId = *input.TenantId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"active_directory_auth_enabled": activeDirectoryAuthEnabled,
+			"password_auth_enabled":         passwordAuthEnabled,
+			"tenant_id":                     tenantId,
+		},
+	}
+}
+
+func expandFlexibleServerStorageAutoGrow(enabled bool) *servers.Storage {
+	autoGrow := servers.StorageAutoGrowDisabled
+	if enabled {
+		autoGrow = servers.StorageAutoGrowEnabled
+	}
+
+	return &servers.Storage{
+		AutoGrow: &autoGrow,
+	}
+}
@@ -231,6 +231,95 @@ func TestAccPostgresqlflexibleServer_failover(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlflexibleServer_replica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+	replicaName := "azurerm_postgresql_flexible_server.replica"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.replica(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(replicaName).ExistsInAzure(r),
+				check.That(replicaName).Key("replication_role").HasValue("AsyncReplica"),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStepFor(replicaName, "create_mode", "source_server_id"),
+		{
+			Config: r.replica(data, "None"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(replicaName).ExistsInAzure(r),
+				check.That(replicaName).Key("replication_role").HasValue("None"),
+			),
+		},
+		data.ImportStepFor(replicaName, "create_mode", "source_server_id"),
+	})
+}
+
+func TestAccPostgresqlflexibleServer_authentication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication.0.active_directory_auth_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("authentication.0.password_auth_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.authentication(data, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication.0.tenant_id").Exists(),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.authentication(data, true, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.authentication(data, false, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
+func TestAccPostgresqlflexibleServer_autoGrow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoGrow(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_grow_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.autoGrow(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_grow_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
 func (PostgresqlFlexibleServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FlexibleServerID(state.ID)
 	if err != nil {
@@ -542,3 +631,66 @@ resource "azurerm_postgresql_flexible_server" "test" {
 }
 `, r.template(data), data.RandomInteger, parimaryZone, standbyZone)
 }
+
+func (r PostgresqlFlexibleServerResource) replica(data acceptance.TestData, replicationRole string) string {
+	role := ""
+	if replicationRole != "" {
+		role = fmt.Sprintf("replication_role = %q", replicationRole)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "replica" {
+  name                = "acctest-fs-replica-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  create_mode         = "Replica"
+  source_server_id    = azurerm_postgresql_flexible_server.test.id
+  %s
+}
+`, r.basic(data), data.RandomInteger, role)
+}
+
+func (r PostgresqlFlexibleServerResource) authentication(data acceptance.TestData, activeDirectoryAuthEnabled, passwordAuthEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  storage_mb             = 32768
+  version                = "12"
+  sku_name               = "GP_Standard_D2s_v3"
+
+  authentication {
+    active_directory_auth_enabled = %t
+    password_auth_enabled         = %t
+    tenant_id                     = data.azurerm_client_config.current.tenant_id
+  }
+}
+`, r.template(data), data.RandomInteger, activeDirectoryAuthEnabled, passwordAuthEnabled)
+}
+
+func (r PostgresqlFlexibleServerResource) autoGrow(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  storage_mb             = 32768
+  auto_grow_enabled      = %t
+  version                = "12"
+  sku_name               = "GP_Standard_D2s_v3"
+}
+`, r.template(data), data.RandomInteger, enabled)
+}
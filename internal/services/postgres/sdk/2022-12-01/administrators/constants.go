@@ -0,0 +1,19 @@
+package administrators
+
+type PrincipalType string
+
+const (
+	PrincipalTypeGroup            PrincipalType = "Group"
+	PrincipalTypeServicePrincipal PrincipalType = "ServicePrincipal"
+	PrincipalTypeUnknown          PrincipalType = "Unknown"
+	PrincipalTypeUser             PrincipalType = "User"
+)
+
+func PossibleValuesForPrincipalType() []string {
+	return []string{
+		string(PrincipalTypeGroup),
+		string(PrincipalTypeServicePrincipal),
+		string(PrincipalTypeUnknown),
+		string(PrincipalTypeUser),
+	}
+}
@@ -0,0 +1,8 @@
+package administrators
+
+type ActiveDirectoryAdministrator struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *AdministratorProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,19 @@
+package configurations
+
+type ConfigurationDataType string
+
+const (
+	ConfigurationDataTypeBoolean     ConfigurationDataType = "Boolean"
+	ConfigurationDataTypeEnumeration ConfigurationDataType = "Enumeration"
+	ConfigurationDataTypeInteger     ConfigurationDataType = "Integer"
+	ConfigurationDataTypeNumeric     ConfigurationDataType = "Numeric"
+)
+
+func PossibleValuesForConfigurationDataType() []string {
+	return []string{
+		string(ConfigurationDataTypeBoolean),
+		string(ConfigurationDataTypeEnumeration),
+		string(ConfigurationDataTypeInteger),
+		string(ConfigurationDataTypeNumeric),
+	}
+}
@@ -0,0 +1,126 @@
+package configurations
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ConfigurationId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FlexibleServerName string
+	ConfigurationName  string
+}
+
+func NewConfigurationID(subscriptionId, resourceGroup, flexibleServerName, configurationName string) ConfigurationId {
+	return ConfigurationId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FlexibleServerName: flexibleServerName,
+		ConfigurationName:  configurationName,
+	}
+}
+
+func (id ConfigurationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Configuration Name %q", id.ConfigurationName),
+		fmt.Sprintf("Flexible Server Name %q", id.FlexibleServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Configuration", segmentsStr)
+}
+
+func (id ConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/flexibleServers/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.ConfigurationName)
+}
+
+// ParseConfigurationID parses a Configuration ID into an ConfigurationId struct
+func ParseConfigurationID(input string) (*ConfigurationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+		return nil, err
+	}
+	if resourceId.ConfigurationName, err = id.PopSegment("configurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseConfigurationIDInsensitively parses an Configuration ID into an ConfigurationId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseConfigurationID method should be used instead for validation etc.
+func ParseConfigurationIDInsensitively(input string) (*ConfigurationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'flexibleServers' segment
+	flexibleServersKey := "flexibleServers"
+	for key := range id.Path {
+		if strings.EqualFold(key, flexibleServersKey) {
+			flexibleServersKey = key
+			break
+		}
+	}
+	if resourceId.FlexibleServerName, err = id.PopSegment(flexibleServersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'configurations' segment
+	configurationsKey := "configurations"
+	for key := range id.Path {
+		if strings.EqualFold(key, configurationsKey) {
+			configurationsKey = key
+			break
+		}
+	}
+	if resourceId.ConfigurationName, err = id.PopSegment(configurationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,108 @@
+package configurations
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FlexibleServerId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FlexibleServerName string
+}
+
+func NewFlexibleServerID(subscriptionId, resourceGroup, flexibleServerName string) FlexibleServerId {
+	return FlexibleServerId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FlexibleServerName: flexibleServerName,
+	}
+}
+
+func (id FlexibleServerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Flexible Server Name %q", id.FlexibleServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Flexible Server", segmentsStr)
+}
+
+func (id FlexibleServerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/flexibleServers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+}
+
+// ParseFlexibleServerID parses a FlexibleServer ID into an FlexibleServerId struct
+func ParseFlexibleServerID(input string) (*FlexibleServerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FlexibleServerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseFlexibleServerIDInsensitively parses an FlexibleServer ID into an FlexibleServerId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseFlexibleServerID method should be used instead for validation etc.
+func ParseFlexibleServerIDInsensitively(input string) (*FlexibleServerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FlexibleServerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'flexibleServers' segment
+	flexibleServersKey := "flexibleServers"
+	for key := range id.Path {
+		if strings.EqualFold(key, flexibleServersKey) {
+			flexibleServersKey = key
+			break
+		}
+	}
+	if resourceId.FlexibleServerName, err = id.PopSegment(flexibleServersKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
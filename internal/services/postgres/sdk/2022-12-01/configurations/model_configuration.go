@@ -0,0 +1,8 @@
+package configurations
+
+type Configuration struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *ConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,14 @@
+package configurations
+
+type ConfigurationProperties struct {
+	AllowedValues          *string                `json:"allowedValues,omitempty"`
+	DataType               *ConfigurationDataType `json:"dataType,omitempty"`
+	DefaultValue           *string                `json:"defaultValue,omitempty"`
+	Description            *string                `json:"description,omitempty"`
+	IsConfigPendingRestart *bool                  `json:"isConfigPendingRestart,omitempty"`
+	IsDynamicConfig        *bool                  `json:"isDynamicConfig,omitempty"`
+	IsReadOnly             *bool                  `json:"isReadOnly,omitempty"`
+	Source                 *string                `json:"source,omitempty"`
+	Unit                   *string                `json:"unit,omitempty"`
+	Value                  *string                `json:"value,omitempty"`
+}
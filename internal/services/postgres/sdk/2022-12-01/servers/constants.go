@@ -0,0 +1,137 @@
+package servers
+
+type ActiveDirectoryAuthEnum string
+
+const (
+	ActiveDirectoryAuthEnumDisabled ActiveDirectoryAuthEnum = "Disabled"
+	ActiveDirectoryAuthEnumEnabled  ActiveDirectoryAuthEnum = "Enabled"
+)
+
+func PossibleValuesForActiveDirectoryAuthEnum() []string {
+	return []string{
+		string(ActiveDirectoryAuthEnumDisabled),
+		string(ActiveDirectoryAuthEnumEnabled),
+	}
+}
+
+type CreateMode string
+
+const (
+	CreateModeCreate             CreateMode = "Create"
+	CreateModeDefault            CreateMode = "Default"
+	CreateModeGeoRestore         CreateMode = "GeoRestore"
+	CreateModePointInTimeRestore CreateMode = "PointInTimeRestore"
+	CreateModeReplica            CreateMode = "Replica"
+	CreateModeUpdate             CreateMode = "Update"
+)
+
+func PossibleValuesForCreateMode() []string {
+	return []string{
+		string(CreateModeCreate),
+		string(CreateModeDefault),
+		string(CreateModeGeoRestore),
+		string(CreateModePointInTimeRestore),
+		string(CreateModeReplica),
+		string(CreateModeUpdate),
+	}
+}
+
+type PasswordAuthEnum string
+
+const (
+	PasswordAuthEnumDisabled PasswordAuthEnum = "Disabled"
+	PasswordAuthEnumEnabled  PasswordAuthEnum = "Enabled"
+)
+
+func PossibleValuesForPasswordAuthEnum() []string {
+	return []string{
+		string(PasswordAuthEnumDisabled),
+		string(PasswordAuthEnumEnabled),
+	}
+}
+
+type ReplicationRole string
+
+const (
+	ReplicationRoleAsyncReplica    ReplicationRole = "AsyncReplica"
+	ReplicationRoleGeoAsyncReplica ReplicationRole = "GeoAsyncReplica"
+	ReplicationRoleNone            ReplicationRole = "None"
+	ReplicationRolePrimary         ReplicationRole = "Primary"
+)
+
+func PossibleValuesForReplicationRole() []string {
+	return []string{
+		string(ReplicationRoleAsyncReplica),
+		string(ReplicationRoleGeoAsyncReplica),
+		string(ReplicationRoleNone),
+		string(ReplicationRolePrimary),
+	}
+}
+
+type ServerPublicNetworkAccessState string
+
+const (
+	ServerPublicNetworkAccessStateDisabled ServerPublicNetworkAccessState = "Disabled"
+	ServerPublicNetworkAccessStateEnabled  ServerPublicNetworkAccessState = "Enabled"
+)
+
+func PossibleValuesForServerPublicNetworkAccessState() []string {
+	return []string{
+		string(ServerPublicNetworkAccessStateDisabled),
+		string(ServerPublicNetworkAccessStateEnabled),
+	}
+}
+
+type ServerState string
+
+const (
+	ServerStateDisabled ServerState = "Disabled"
+	ServerStateDropping ServerState = "Dropping"
+	ServerStateReady    ServerState = "Ready"
+	ServerStateStarting ServerState = "Starting"
+	ServerStateStopped  ServerState = "Stopped"
+	ServerStateStopping ServerState = "Stopping"
+	ServerStateUpdating ServerState = "Updating"
+)
+
+func PossibleValuesForServerState() []string {
+	return []string{
+		string(ServerStateDisabled),
+		string(ServerStateDropping),
+		string(ServerStateReady),
+		string(ServerStateStarting),
+		string(ServerStateStopped),
+		string(ServerStateStopping),
+		string(ServerStateUpdating),
+	}
+}
+
+type SkuTier string
+
+const (
+	SkuTierBurstable       SkuTier = "Burstable"
+	SkuTierGeneralPurpose  SkuTier = "GeneralPurpose"
+	SkuTierMemoryOptimized SkuTier = "MemoryOptimized"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBurstable),
+		string(SkuTierGeneralPurpose),
+		string(SkuTierMemoryOptimized),
+	}
+}
+
+type StorageAutoGrow string
+
+const (
+	StorageAutoGrowDisabled StorageAutoGrow = "Disabled"
+	StorageAutoGrowEnabled  StorageAutoGrow = "Enabled"
+)
+
+func PossibleValuesForStorageAutoGrow() []string {
+	return []string{
+		string(StorageAutoGrowDisabled),
+		string(StorageAutoGrowEnabled),
+	}
+}
@@ -0,0 +1,7 @@
+package servers
+
+type AuthConfig struct {
+	ActiveDirectoryAuth *ActiveDirectoryAuthEnum `json:"activeDirectoryAuth,omitempty"`
+	PasswordAuth        *PasswordAuthEnum        `json:"passwordAuth,omitempty"`
+	TenantId            *string                  `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,11 @@
+package servers
+
+type Server struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ServerProperties  `json:"properties,omitempty"`
+	Sku        *Sku               `json:"sku,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
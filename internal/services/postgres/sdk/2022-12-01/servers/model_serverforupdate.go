@@ -0,0 +1,7 @@
+package servers
+
+type ServerForUpdate struct {
+	Properties *ServerPropertiesForUpdate `json:"properties,omitempty"`
+	Sku        *Sku                       `json:"sku,omitempty"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+}
@@ -0,0 +1,17 @@
+package servers
+
+type ServerProperties struct {
+	AdministratorLogin         *string          `json:"administratorLogin,omitempty"`
+	AdministratorLoginPassword *string          `json:"administratorLoginPassword,omitempty"`
+	AuthConfig                 *AuthConfig      `json:"authConfig,omitempty"`
+	AvailabilityZone           *string          `json:"availabilityZone,omitempty"`
+	CreateMode                 *CreateMode      `json:"createMode,omitempty"`
+	FullyQualifiedDomainName   *string          `json:"fullyQualifiedDomainName,omitempty"`
+	Network                    *Network         `json:"network,omitempty"`
+	ReplicaCapacity            *int64           `json:"replicaCapacity,omitempty"`
+	ReplicationRole            *ReplicationRole `json:"replicationRole,omitempty"`
+	SourceServerResourceId     *string          `json:"sourceServerResourceId,omitempty"`
+	State                      *ServerState     `json:"state,omitempty"`
+	Storage                    *Storage         `json:"storage,omitempty"`
+	Version                    *string          `json:"version,omitempty"`
+}
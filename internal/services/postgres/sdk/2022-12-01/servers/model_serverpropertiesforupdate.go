@@ -0,0 +1,7 @@
+package servers
+
+type ServerPropertiesForUpdate struct {
+	AuthConfig      *AuthConfig      `json:"authConfig,omitempty"`
+	ReplicationRole *ReplicationRole `json:"replicationRole,omitempty"`
+	Storage         *Storage         `json:"storage,omitempty"`
+}
@@ -0,0 +1,6 @@
+package servers
+
+type Sku struct {
+	Name string  `json:"name"`
+	Tier SkuTier `json:"tier"`
+}
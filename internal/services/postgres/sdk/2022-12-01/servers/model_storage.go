@@ -0,0 +1,6 @@
+package servers
+
+type Storage struct {
+	AutoGrow      *StorageAutoGrow `json:"autoGrow,omitempty"`
+	StorageSizeGB *int64           `json:"storageSizeGB,omitempty"`
+}
@@ -3,19 +3,34 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicies"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicyassignments"
+	redisSdk "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/redis"
 )
 
 type Client struct {
-	Client               *redis.Client
-	FirewallRulesClient  *redis.FirewallRulesClient
-	PatchSchedulesClient *redis.PatchSchedulesClient
-	LinkedServerClient   *redis.LinkedServerClient
+	Client                        *redis.Client
+	AccessPoliciesClient          *accesspolicies.AccessPoliciesClient
+	AccessPolicyAssignmentsClient *accesspolicyassignments.AccessPolicyAssignmentsClient
+	CachePropertiesClient         *redisSdk.RedisClient
+	FirewallRulesClient           *redis.FirewallRulesClient
+	PatchSchedulesClient          *redis.PatchSchedulesClient
+	LinkedServerClient            *redis.LinkedServerClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	client := redis.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
+	AccessPoliciesClient := accesspolicies.NewAccessPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AccessPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	AccessPolicyAssignmentsClient := accesspolicyassignments.NewAccessPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AccessPolicyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	CachePropertiesClient := redisSdk.NewRedisClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&CachePropertiesClient.Client, o.ResourceManagerAuthorizer)
+
 	FirewallRulesClient := redis.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -26,9 +41,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&LinkedServerClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		Client:               &client,
-		FirewallRulesClient:  &FirewallRulesClient,
-		PatchSchedulesClient: &PatchSchedulesClient,
-		LinkedServerClient:   &LinkedServerClient,
+		Client:                        &client,
+		AccessPoliciesClient:          &AccessPoliciesClient,
+		AccessPolicyAssignmentsClient: &AccessPolicyAssignmentsClient,
+		CachePropertiesClient:         &CachePropertiesClient,
+		FirewallRulesClient:           &FirewallRulesClient,
+		PatchSchedulesClient:          &PatchSchedulesClient,
+		LinkedServerClient:            &LinkedServerClient,
 	}
 }
@@ -0,0 +1,172 @@
+package redis
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicyassignments"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+)
+
+func resourceRedisCacheAccessPolicyAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRedisCacheAccessPolicyAssignmentCreate,
+		Read:   resourceRedisCacheAccessPolicyAssignmentRead,
+		Delete: resourceRedisCacheAccessPolicyAssignmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := accesspolicyassignments.ParseAccessPolicyAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"redis_cache_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CacheID,
+			},
+
+			"access_policy_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"object_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"object_id_alias": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceRedisCacheAccessPolicyAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPolicyAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	cacheId, err := parse.CacheID(d.Get("redis_cache_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := accesspolicyassignments.NewAccessPolicyAssignmentID(cacheId.SubscriptionId, cacheId.ResourceGroup, cacheId.RediName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_redis_cache_access_policy_assignment", id.ID())
+	}
+
+	parameters := accesspolicyassignments.RedisCacheAccessPolicyAssignment{
+		Properties: &accesspolicyassignments.RedisCacheAccessPolicyAssignmentProperties{
+			AccessPolicyName: d.Get("access_policy_name").(string),
+			ObjectId:         d.Get("object_id").(string),
+			ObjectIdAlias:    d.Get("object_id_alias").(string),
+		},
+	}
+
+	// the cache can only process a single access policy change at a time
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	if err := client.CreateUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRedisCacheAccessPolicyAssignmentRead(d, meta)
+}
+
+func resourceRedisCacheAccessPolicyAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPolicyAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := accesspolicyassignments.ParseAccessPolicyAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AccessPolicyAssignmentName)
+	d.Set("redis_cache_id", parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.RedisName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("access_policy_name", props.AccessPolicyName)
+			d.Set("object_id", props.ObjectId)
+			d.Set("object_id_alias", props.ObjectIdAlias)
+		}
+	}
+
+	return nil
+}
+
+func resourceRedisCacheAccessPolicyAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPolicyAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := accesspolicyassignments.ParseAccessPolicyAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cacheId := parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.RedisName)
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
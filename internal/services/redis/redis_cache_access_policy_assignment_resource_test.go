@@ -0,0 +1,126 @@
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicyassignments"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type RedisCacheAccessPolicyAssignmentResource struct {
+}
+
+func TestAccRedisCacheAccessPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy_assignment", "test")
+	r := RedisCacheAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRedisCacheAccessPolicyAssignment_customPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy_assignment", "test")
+	r := RedisCacheAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRedisCacheAccessPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy_assignment", "test")
+	r := RedisCacheAccessPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (RedisCacheAccessPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := accesspolicyassignments.ParseAccessPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Redis.AccessPolicyAssignmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (RedisCacheAccessPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_redis_cache_access_policy_assignment" "test" {
+  name               = "acctestRedisAccessPolicyAssignment-%d"
+  redis_cache_id     = azurerm_redis_cache.test.id
+  access_policy_name = "Data Contributor"
+  object_id          = data.azurerm_client_config.current.object_id
+  object_id_alias    = "ServicePrincipal"
+}
+`, RedisCacheAccessPolicyResource{}.template(data), data.RandomInteger)
+}
+
+func (RedisCacheAccessPolicyAssignmentResource) customPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_redis_cache_access_policy_assignment" "test" {
+  name               = "acctestRedisAccessPolicyAssignment-%d"
+  redis_cache_id     = azurerm_redis_cache.test.id
+  access_policy_name = azurerm_redis_cache_access_policy.test.name
+  object_id          = data.azurerm_client_config.current.object_id
+  object_id_alias    = "ServicePrincipal"
+}
+`, RedisCacheAccessPolicyResource{}.basic(data, "+@read +@connection +cluster|info"), data.RandomInteger)
+}
+
+func (r RedisCacheAccessPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_cache_access_policy_assignment" "import" {
+  name               = azurerm_redis_cache_access_policy_assignment.test.name
+  redis_cache_id     = azurerm_redis_cache_access_policy_assignment.test.redis_cache_id
+  access_policy_name = azurerm_redis_cache_access_policy_assignment.test.access_policy_name
+  object_id          = azurerm_redis_cache_access_policy_assignment.test.object_id
+  object_id_alias    = azurerm_redis_cache_access_policy_assignment.test.object_id_alias
+}
+`, r.basic(data))
+}
@@ -0,0 +1,181 @@
+package redis
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicies"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+)
+
+func resourceRedisCacheAccessPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRedisCacheAccessPolicyCreate,
+		Read:   resourceRedisCacheAccessPolicyRead,
+		Update: resourceRedisCacheAccessPolicyUpdate,
+		Delete: resourceRedisCacheAccessPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := accesspolicies.ParseAccessPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"redis_cache_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CacheID,
+			},
+
+			"permissions": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceRedisCacheAccessPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	cacheId, err := parse.CacheID(d.Get("redis_cache_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := accesspolicies.NewAccessPolicyID(cacheId.SubscriptionId, cacheId.ResourceGroup, cacheId.RediName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_redis_cache_access_policy", id.ID())
+	}
+
+	// the cache can only process a single access policy change at a time
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	if err := client.CreateUpdateThenPoll(ctx, id, expandRedisCacheAccessPolicy(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRedisCacheAccessPolicyRead(d, meta)
+}
+
+func resourceRedisCacheAccessPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := accesspolicies.ParseAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AccessPolicyName)
+	d.Set("redis_cache_id", parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.RedisName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("permissions", props.Permissions)
+		}
+	}
+
+	return nil
+}
+
+func resourceRedisCacheAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := accesspolicies.ParseAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cacheId := parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.RedisName)
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	if err := client.CreateUpdateThenPoll(ctx, *id, expandRedisCacheAccessPolicy(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceRedisCacheAccessPolicyRead(d, meta)
+}
+
+func resourceRedisCacheAccessPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.AccessPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := accesspolicies.ParseAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cacheId := parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.RedisName)
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandRedisCacheAccessPolicy(d *pluginsdk.ResourceData) accesspolicies.RedisCacheAccessPolicy {
+	// only custom access policies can be managed, the built-in ones are always present on a cache
+	policyType := accesspolicies.AccessPolicyTypeCustom
+	return accesspolicies.RedisCacheAccessPolicy{
+		Properties: &accesspolicies.RedisCacheAccessPolicyProperties{
+			Permissions: d.Get("permissions").(string),
+			Type:        &policyType,
+		},
+	}
+}
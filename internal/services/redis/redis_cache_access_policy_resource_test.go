@@ -0,0 +1,140 @@
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/accesspolicies"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type RedisCacheAccessPolicyResource struct {
+}
+
+func TestAccRedisCacheAccessPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy", "test")
+	r := RedisCacheAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+@read +@connection +cluster|info"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRedisCacheAccessPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy", "test")
+	r := RedisCacheAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+@read +@connection +cluster|info"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRedisCacheAccessPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy", "test")
+	r := RedisCacheAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+@read +@connection +cluster|info"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "+@read +@write +@connection +cluster|info"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions").HasValue("+@read +@write +@connection +cluster|info"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (RedisCacheAccessPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := accesspolicies.ParseAccessPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Redis.AccessPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (RedisCacheAccessPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-redis-%d"
+  location = "%s"
+}
+
+resource "azurerm_redis_cache" "test" {
+  name                = "acctestRedis-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  capacity            = 1
+  family              = "C"
+  sku_name            = "Standard"
+  enable_non_ssl_port = false
+  minimum_tls_version = "1.2"
+
+  redis_configuration {
+    active_directory_authentication_enabled = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r RedisCacheAccessPolicyResource) basic(data acceptance.TestData, permissions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_cache_access_policy" "test" {
+  name           = "acctestRedisAccessPolicy-%d"
+  redis_cache_id = azurerm_redis_cache.test.id
+  permissions    = "%s"
+}
+`, r.template(data), data.RandomInteger, permissions)
+}
+
+func (r RedisCacheAccessPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_cache_access_policy" "import" {
+  name           = azurerm_redis_cache_access_policy.test.name
+  redis_cache_id = azurerm_redis_cache_access_policy.test.redis_cache_id
+  permissions    = azurerm_redis_cache_access_policy.test.permissions
+}
+`, r.basic(data, "+@read +@connection +cluster|info"))
+}
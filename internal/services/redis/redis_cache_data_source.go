@@ -151,6 +151,11 @@ func dataSourceRedisCache() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
 						},
+
+						"active_directory_authentication_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -276,7 +281,12 @@ func dataSourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("subnet_id", subnetId)
 	}
 
-	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration)
+	activeDirectoryAuthenticationEnabled, err := retrieveRedisCacheActiveDirectoryAuthentication(ctx, meta.(*clients.Client).Redis.CachePropertiesClient, id)
+	if err != nil {
+		return err
+	}
+
+	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration, activeDirectoryAuthenticationEnabled)
 	if err != nil {
 		return fmt.Errorf("flattening `redis_configuration`: %+v", err)
 	}
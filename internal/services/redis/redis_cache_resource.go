@@ -20,6 +20,7 @@ import (
 	networkParse "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/parse"
 	networkValidate "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/network/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/parse"
+	redisSdk "github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/sdk/2023-08-01/redis"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tags"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
@@ -209,6 +210,12 @@ func resourceRedisCache() *pluginsdk.Resource {
 							Optional: true,
 							Default:  true,
 						},
+
+						"active_directory_authentication_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -454,6 +461,12 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.SetId(id.ID())
 
+	if d.Get("redis_configuration.0.active_directory_authentication_enabled").(bool) {
+		if err := updateRedisCacheActiveDirectoryAuthentication(ctx, meta.(*clients.Client).Redis.CachePropertiesClient, id, true); err != nil {
+			return err
+		}
+	}
+
 	if patchSchedule != nil {
 		if _, err = patchClient.CreateOrUpdate(ctx, id.ResourceGroup, id.RediName, *patchSchedule); err != nil {
 			return fmt.Errorf("setting Redis Patch Schedule: %+v", err)
@@ -561,6 +574,13 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("waiting for Redis Cache %q (Resource Group %q) to become available: %+v", id.RediName, id.ResourceGroup, err)
 	}
 
+	if d.HasChange("redis_configuration.0.active_directory_authentication_enabled") {
+		enabled := d.Get("redis_configuration.0.active_directory_authentication_enabled").(bool)
+		if err := updateRedisCacheActiveDirectoryAuthentication(ctx, meta.(*clients.Client).Redis.CachePropertiesClient, *id, enabled); err != nil {
+			return err
+		}
+	}
+
 	patchSchedule := expandRedisPatchSchedule(d)
 
 	if patchSchedule == nil || len(*patchSchedule.ScheduleEntries.ScheduleEntries) == 0 {
@@ -656,7 +676,12 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("tenant_settings", flattenTenantSettings(props.TenantSettings))
 	}
 
-	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration)
+	activeDirectoryAuthenticationEnabled, err := retrieveRedisCacheActiveDirectoryAuthentication(ctx, meta.(*clients.Client).Redis.CachePropertiesClient, *id)
+	if err != nil {
+		return err
+	}
+
+	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration, activeDirectoryAuthenticationEnabled)
 	if err != nil {
 		return fmt.Errorf("flattening `redis_configuration`: %+v", err)
 	}
@@ -731,6 +756,41 @@ func redisStateRefreshFunc(ctx context.Context, client *redis.Client, resourceGr
 	}
 }
 
+// the `aad-enabled` configuration is only available in a newer API version, so it's managed separately from the rest
+// of the `redis_configuration` block once the cache exists
+func updateRedisCacheActiveDirectoryAuthentication(ctx context.Context, client *redisSdk.RedisClient, id parse.CacheId, enabled bool) error {
+	cacheId := redisSdk.NewRedisID(id.SubscriptionId, id.ResourceGroup, id.RediName)
+	parameters := redisSdk.RedisUpdateParameters{
+		Properties: &redisSdk.RedisUpdateProperties{
+			RedisConfiguration: &redisSdk.RedisCommonPropertiesRedisConfiguration{
+				AadEnabled: utils.String(strconv.FormatBool(enabled)),
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, cacheId, parameters); err != nil {
+		return fmt.Errorf("updating Active Directory Authentication for Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func retrieveRedisCacheActiveDirectoryAuthentication(ctx context.Context, client *redisSdk.RedisClient, id parse.CacheId) (bool, error) {
+	cacheId := redisSdk.NewRedisID(id.SubscriptionId, id.ResourceGroup, id.RediName)
+	resp, err := client.Get(ctx, cacheId)
+	if err != nil {
+		return false, fmt.Errorf("retrieving Active Directory Authentication for Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.RedisConfiguration != nil {
+		if v := model.Properties.RedisConfiguration.AadEnabled; v != nil {
+			return strings.EqualFold(*v, "true"), nil
+		}
+	}
+
+	return false, nil
+}
+
 func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, error) {
 	output := make(map[string]*string)
 
@@ -862,7 +922,7 @@ func flattenTenantSettings(input map[string]*string) map[string]*string {
 	}
 	return output
 }
-func flattenRedisConfiguration(input map[string]*string) ([]interface{}, error) {
+func flattenRedisConfiguration(input map[string]*string, activeDirectoryAuthenticationEnabled bool) ([]interface{}, error) {
 	outputs := make(map[string]interface{}, len(input))
 
 	if v := input["maxclients"]; v != nil {
@@ -947,6 +1007,8 @@ func flattenRedisConfiguration(input map[string]*string) ([]interface{}, error)
 		outputs["enable_authentication"] = isAuthRequiredAsBool(*v)
 	}
 
+	outputs["active_directory_authentication_enabled"] = activeDirectoryAuthenticationEnabled
+
 	return []interface{}{outputs}, nil
 }
 
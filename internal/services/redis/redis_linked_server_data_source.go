@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/location"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redis/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+func dataSourceRedisLinkedServer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceRedisLinkedServerRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_redis_cache_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"linked_redis_cache_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"linked_redis_cache_location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"server_role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRedisLinkedServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Redis.LinkedServerClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewLinkedServerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("target_redis_cache_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RediName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Linked Server %q (Redis Cache %q / Resource Group %q) was not found", id.Name, id.RediName, id.ResourceGroup)
+		}
+		return fmt.Errorf("retrieving Linked Server %q (Redis Cache %q / Resource Group %q): %+v", id.Name, id.RediName, id.ResourceGroup, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.Name)
+	d.Set("target_redis_cache_name", id.RediName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.LinkedServerProperties; props != nil {
+		linkedRedisCacheId := ""
+		if props.LinkedRedisCacheID != nil {
+			cacheId, err := parse.CacheID(*props.LinkedRedisCacheID)
+			if err != nil {
+				return err
+			}
+
+			linkedRedisCacheId = cacheId.ID()
+		}
+		d.Set("linked_redis_cache_id", linkedRedisCacheId)
+
+		d.Set("linked_redis_cache_location", location.NormalizeNilable(props.LinkedRedisCacheLocation))
+		d.Set("server_role", string(props.ServerRole))
+		d.Set("provisioning_state", props.ProvisioningState)
+	}
+
+	return nil
+}
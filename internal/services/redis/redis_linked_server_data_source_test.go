@@ -0,0 +1,41 @@
+package redis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+)
+
+type RedisLinkedServerDataSource struct {
+}
+
+func TestAccRedisLinkedServerDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_redis_linked_server", "test")
+	r := RedisLinkedServerDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("linked_redis_cache_id").Exists(),
+				check.That(data.ResourceName).Key("linked_redis_cache_location").Exists(),
+				check.That(data.ResourceName).Key("server_role").HasValue("Secondary"),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func (r RedisLinkedServerDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_redis_linked_server" "test" {
+  name                    = azurerm_redis_linked_server.test.name
+  target_redis_cache_name = azurerm_redis_linked_server.test.target_redis_cache_name
+  resource_group_name     = azurerm_redis_linked_server.test.resource_group_name
+}
+`, RedisLinkedServerResource{}.basic(data))
+}
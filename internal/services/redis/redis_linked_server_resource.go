@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
@@ -23,6 +24,7 @@ func resourceRedisLinkedServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisLinkedServerCreate,
 		Read:   resourceRedisLinkedServerRead,
+		Update: resourceRedisLinkedServerUpdate,
 		Delete: resourceRedisLinkedServerDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.LinkedServerID(id)
@@ -67,6 +69,12 @@ func resourceRedisLinkedServer() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"force_failover_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -182,6 +190,11 @@ func resourceRedisLinkedServerRead(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceRedisLinkedServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// `force_failover_enabled` only changes how the link is removed, so there's nothing to update in Azure
+	return resourceRedisLinkedServerRead(d, meta)
+}
+
 func resourceRedisLinkedServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Redis.LinkedServerClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -192,6 +205,19 @@ func resourceRedisLinkedServerDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	// when forcing a failover the link is removed from the geo-secondary rather than the geo-primary, which promotes
+	// the geo-secondary to a standalone cache and works even when the geo-primary is unavailable
+	if d.Get("force_failover_enabled").(bool) && strings.EqualFold(d.Get("server_role").(string), string(redis.ReplicationRoleSecondary)) {
+		linkedCacheId, err := parse.CacheID(d.Get("linked_redis_cache_id").(string))
+		if err != nil {
+			return err
+		}
+
+		secondaryId := parse.NewLinkedServerID(linkedCacheId.SubscriptionId, linkedCacheId.ResourceGroup, linkedCacheId.RediName, id.RediName)
+		log.Printf("[INFO] forcing a failover of Linked Server %q (Redis Cache %q / Resource Group %q) by unlinking it from the geo-secondary", id.Name, id.RediName, id.ResourceGroup)
+		id = &secondaryId
+	}
+
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.RediName, id.Name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -45,6 +45,33 @@ func TestAccRedisLinkedServer_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccRedisLinkedServer_forceFailover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_linked_server", "test")
+	r := RedisLinkedServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.forceFailover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("force_failover_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("force_failover_enabled"),
+		{
+			// removing the linked server unlinks it from the geo-secondary, leaving both caches in place
+			Config: r.template(data),
+		},
+	})
+}
+
 func (t RedisLinkedServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServerID(state.ID)
 	if err != nil {
@@ -59,7 +86,36 @@ func (t RedisLinkedServerResource) Exists(ctx context.Context, clients *clients.
 	return utils.Bool(resp.LinkedServerProperties != nil), nil
 }
 
-func (RedisLinkedServerResource) basic(data acceptance.TestData) string {
+func (r RedisLinkedServerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_linked_server" "test" {
+  target_redis_cache_name     = azurerm_redis_cache.pri.name
+  resource_group_name         = azurerm_redis_cache.pri.resource_group_name
+  linked_redis_cache_id       = azurerm_redis_cache.sec.id
+  linked_redis_cache_location = azurerm_redis_cache.sec.location
+  server_role                 = "Secondary"
+}
+`, r.template(data))
+}
+
+func (r RedisLinkedServerResource) forceFailover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_linked_server" "test" {
+  target_redis_cache_name     = azurerm_redis_cache.pri.name
+  resource_group_name         = azurerm_redis_cache.pri.resource_group_name
+  linked_redis_cache_id       = azurerm_redis_cache.sec.id
+  linked_redis_cache_location = azurerm_redis_cache.sec.location
+  server_role                 = "Secondary"
+  force_failover_enabled      = true
+}
+`, r.template(data))
+}
+
+func (RedisLinkedServerResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -106,14 +162,6 @@ resource "azurerm_redis_cache" "sec" {
     maxmemory_policy   = "allkeys-lru"
   }
 }
-
-resource "azurerm_redis_linked_server" "test" {
-  target_redis_cache_name     = azurerm_redis_cache.pri.name
-  resource_group_name         = azurerm_redis_cache.pri.resource_group_name
-  linked_redis_cache_id       = azurerm_redis_cache.sec.id
-  linked_redis_cache_location = azurerm_redis_cache.sec.location
-  server_role                 = "Secondary"
-}
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger,
 		data.RandomInteger, data.Locations.Secondary, data.RandomInteger)
 }
@@ -0,0 +1,15 @@
+package accesspolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AccessPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAccessPoliciesClientWithBaseURI(endpoint string) AccessPoliciesClient {
+	return AccessPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
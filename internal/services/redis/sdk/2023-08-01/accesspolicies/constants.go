@@ -0,0 +1,37 @@
+package accesspolicies
+
+type AccessPolicyProvisioningState string
+
+const (
+	AccessPolicyProvisioningStateCanceled  AccessPolicyProvisioningState = "Canceled"
+	AccessPolicyProvisioningStateDeleted   AccessPolicyProvisioningState = "Deleted"
+	AccessPolicyProvisioningStateDeleting  AccessPolicyProvisioningState = "Deleting"
+	AccessPolicyProvisioningStateFailed    AccessPolicyProvisioningState = "Failed"
+	AccessPolicyProvisioningStateSucceeded AccessPolicyProvisioningState = "Succeeded"
+	AccessPolicyProvisioningStateUpdating  AccessPolicyProvisioningState = "Updating"
+)
+
+func PossibleValuesForAccessPolicyProvisioningState() []string {
+	return []string{
+		string(AccessPolicyProvisioningStateCanceled),
+		string(AccessPolicyProvisioningStateDeleted),
+		string(AccessPolicyProvisioningStateDeleting),
+		string(AccessPolicyProvisioningStateFailed),
+		string(AccessPolicyProvisioningStateSucceeded),
+		string(AccessPolicyProvisioningStateUpdating),
+	}
+}
+
+type AccessPolicyType string
+
+const (
+	AccessPolicyTypeBuiltIn AccessPolicyType = "BuiltIn"
+	AccessPolicyTypeCustom  AccessPolicyType = "Custom"
+)
+
+func PossibleValuesForAccessPolicyType() []string {
+	return []string{
+		string(AccessPolicyTypeBuiltIn),
+		string(AccessPolicyTypeCustom),
+	}
+}
@@ -0,0 +1,126 @@
+package accesspolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AccessPolicyId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	RedisName        string
+	AccessPolicyName string
+}
+
+func NewAccessPolicyID(subscriptionId, resourceGroup, redisName, accessPolicyName string) AccessPolicyId {
+	return AccessPolicyId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		RedisName:        redisName,
+		AccessPolicyName: accessPolicyName,
+	}
+}
+
+func (id AccessPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Access Policy Name %q", id.AccessPolicyName),
+		fmt.Sprintf("Redis Name %q", id.RedisName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Access Policy", segmentsStr)
+}
+
+func (id AccessPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/accessPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisName, id.AccessPolicyName)
+}
+
+// ParseAccessPolicyID parses a AccessPolicy ID into an AccessPolicyId struct
+func ParseAccessPolicyID(input string) (*AccessPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AccessPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RedisName, err = id.PopSegment("Redis"); err != nil {
+		return nil, err
+	}
+	if resourceId.AccessPolicyName, err = id.PopSegment("accessPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseAccessPolicyIDInsensitively parses an AccessPolicy ID into an AccessPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseAccessPolicyID method should be used instead for validation etc.
+func ParseAccessPolicyIDInsensitively(input string) (*AccessPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AccessPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'Redis' segment
+	RedisKey := "Redis"
+	for key := range id.Path {
+		if strings.EqualFold(key, RedisKey) {
+			RedisKey = key
+			break
+		}
+	}
+	if resourceId.RedisName, err = id.PopSegment(RedisKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'accessPolicies' segment
+	accessPoliciesKey := "accessPolicies"
+	for key := range id.Path {
+		if strings.EqualFold(key, accessPoliciesKey) {
+			accessPoliciesKey = key
+			break
+		}
+	}
+	if resourceId.AccessPolicyName, err = id.PopSegment(accessPoliciesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
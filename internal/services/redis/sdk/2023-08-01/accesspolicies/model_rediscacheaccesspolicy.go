@@ -0,0 +1,8 @@
+package accesspolicies
+
+type RedisCacheAccessPolicy struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *RedisCacheAccessPolicyProperties `json:"properties,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package accesspolicies
+
+type RedisCacheAccessPolicyProperties struct {
+	Permissions       string                         `json:"permissions"`
+	ProvisioningState *AccessPolicyProvisioningState `json:"provisioningState,omitempty"`
+	Type              *AccessPolicyType              `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package accesspolicyassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AccessPolicyAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAccessPolicyAssignmentsClientWithBaseURI(endpoint string) AccessPolicyAssignmentsClient {
+	return AccessPolicyAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
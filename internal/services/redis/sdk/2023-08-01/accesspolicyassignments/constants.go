@@ -0,0 +1,23 @@
+package accesspolicyassignments
+
+type AccessPolicyAssignmentProvisioningState string
+
+const (
+	AccessPolicyAssignmentProvisioningStateCanceled  AccessPolicyAssignmentProvisioningState = "Canceled"
+	AccessPolicyAssignmentProvisioningStateDeleted   AccessPolicyAssignmentProvisioningState = "Deleted"
+	AccessPolicyAssignmentProvisioningStateDeleting  AccessPolicyAssignmentProvisioningState = "Deleting"
+	AccessPolicyAssignmentProvisioningStateFailed    AccessPolicyAssignmentProvisioningState = "Failed"
+	AccessPolicyAssignmentProvisioningStateSucceeded AccessPolicyAssignmentProvisioningState = "Succeeded"
+	AccessPolicyAssignmentProvisioningStateUpdating  AccessPolicyAssignmentProvisioningState = "Updating"
+)
+
+func PossibleValuesForAccessPolicyAssignmentProvisioningState() []string {
+	return []string{
+		string(AccessPolicyAssignmentProvisioningStateCanceled),
+		string(AccessPolicyAssignmentProvisioningStateDeleted),
+		string(AccessPolicyAssignmentProvisioningStateDeleting),
+		string(AccessPolicyAssignmentProvisioningStateFailed),
+		string(AccessPolicyAssignmentProvisioningStateSucceeded),
+		string(AccessPolicyAssignmentProvisioningStateUpdating),
+	}
+}
@@ -0,0 +1,126 @@
+package accesspolicyassignments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AccessPolicyAssignmentId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	RedisName                  string
+	AccessPolicyAssignmentName string
+}
+
+func NewAccessPolicyAssignmentID(subscriptionId, resourceGroup, redisName, accessPolicyAssignmentName string) AccessPolicyAssignmentId {
+	return AccessPolicyAssignmentId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		RedisName:                  redisName,
+		AccessPolicyAssignmentName: accessPolicyAssignmentName,
+	}
+}
+
+func (id AccessPolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Access Policy Assignment Name %q", id.AccessPolicyAssignmentName),
+		fmt.Sprintf("Redis Name %q", id.RedisName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Access Policy Assignment", segmentsStr)
+}
+
+func (id AccessPolicyAssignmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/accessPolicyAssignments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisName, id.AccessPolicyAssignmentName)
+}
+
+// ParseAccessPolicyAssignmentID parses a AccessPolicyAssignment ID into an AccessPolicyAssignmentId struct
+func ParseAccessPolicyAssignmentID(input string) (*AccessPolicyAssignmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AccessPolicyAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RedisName, err = id.PopSegment("Redis"); err != nil {
+		return nil, err
+	}
+	if resourceId.AccessPolicyAssignmentName, err = id.PopSegment("accessPolicyAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseAccessPolicyAssignmentIDInsensitively parses an AccessPolicyAssignment ID into an AccessPolicyAssignmentId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseAccessPolicyAssignmentID method should be used instead for validation etc.
+func ParseAccessPolicyAssignmentIDInsensitively(input string) (*AccessPolicyAssignmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AccessPolicyAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'Redis' segment
+	RedisKey := "Redis"
+	for key := range id.Path {
+		if strings.EqualFold(key, RedisKey) {
+			RedisKey = key
+			break
+		}
+	}
+	if resourceId.RedisName, err = id.PopSegment(RedisKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'accessPolicyAssignments' segment
+	accessPolicyAssignmentsKey := "accessPolicyAssignments"
+	for key := range id.Path {
+		if strings.EqualFold(key, accessPolicyAssignmentsKey) {
+			accessPolicyAssignmentsKey = key
+			break
+		}
+	}
+	if resourceId.AccessPolicyAssignmentName, err = id.PopSegment(accessPolicyAssignmentsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,114 @@
+package accesspolicyassignments
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = AccessPolicyAssignmentId{}
+
+func TestAccessPolicyAssignmentIDFormatter(t *testing.T) {
+	actual := NewAccessPolicyAssignmentID("{subscriptionId}", "{resourceGroupName}", "{cacheName}", "{accessPolicyAssignmentName}").ID()
+	expected := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/Redis/{cacheName}/accessPolicyAssignments/{accessPolicyAssignmentName}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseAccessPolicyAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *AccessPolicyAssignmentId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing RedisName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/",
+			Error: true,
+		},
+
+		{
+			// missing value for RedisName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/Redis/",
+			Error: true,
+		},
+
+		{
+			// missing AccessPolicyAssignmentName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/Redis/{cacheName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for AccessPolicyAssignmentName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/Redis/{cacheName}/accessPolicyAssignments/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cache/Redis/{cacheName}/accessPolicyAssignments/{accessPolicyAssignmentName}",
+			Expected: &AccessPolicyAssignmentId{
+				SubscriptionId:             "{subscriptionId}",
+				ResourceGroup:              "{resourceGroupName}",
+				RedisName:                  "{cacheName}",
+				AccessPolicyAssignmentName: "{accessPolicyAssignmentName}",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/{SUBSCRIPTIONID}/RESOURCEGROUPS/{RESOURCEGROUPNAME}/PROVIDERS/MICROSOFT.CACHE/REDIS/{CACHENAME}/ACCESSPOLICYASSIGNMENTS/{ACCESSPOLICYASSIGNMENTNAME}",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseAccessPolicyAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.RedisName != v.Expected.RedisName {
+			t.Fatalf("Expected %q but got %q for RedisName", v.Expected.RedisName, actual.RedisName)
+		}
+		if actual.AccessPolicyAssignmentName != v.Expected.AccessPolicyAssignmentName {
+			t.Fatalf("Expected %q but got %q for AccessPolicyAssignmentName", v.Expected.AccessPolicyAssignmentName, actual.AccessPolicyAssignmentName)
+		}
+	}
+}
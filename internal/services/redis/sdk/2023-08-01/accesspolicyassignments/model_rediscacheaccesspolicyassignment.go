@@ -0,0 +1,8 @@
+package accesspolicyassignments
+
+type RedisCacheAccessPolicyAssignment struct {
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *RedisCacheAccessPolicyAssignmentProperties `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package accesspolicyassignments
+
+type RedisCacheAccessPolicyAssignmentProperties struct {
+	AccessPolicyName  string                                   `json:"accessPolicyName"`
+	ObjectId          string                                   `json:"objectId"`
+	ObjectIdAlias     string                                   `json:"objectIdAlias"`
+	ProvisioningState *AccessPolicyAssignmentProvisioningState `json:"provisioningState,omitempty"`
+}
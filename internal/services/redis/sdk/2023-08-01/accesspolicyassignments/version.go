@@ -0,0 +1,9 @@
+package accesspolicyassignments
+
+import "fmt"
+
+const defaultApiVersion = "2023-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/accesspolicyassignments/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package redis
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RedisClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRedisClientWithBaseURI(endpoint string) RedisClient {
+	return RedisClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
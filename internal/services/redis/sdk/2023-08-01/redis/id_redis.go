@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RedisId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RedisName      string
+}
+
+func NewRedisID(subscriptionId, resourceGroup, redisName string) RedisId {
+	return RedisId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RedisName:      redisName,
+	}
+}
+
+func (id RedisId) String() string {
+	segments := []string{
+		fmt.Sprintf("Redis Name %q", id.RedisName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Redis", segmentsStr)
+}
+
+func (id RedisId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisName)
+}
+
+// ParseRedisID parses a Redis ID into an RedisId struct
+func ParseRedisID(input string) (*RedisId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RedisId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RedisName, err = id.PopSegment("Redis"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseRedisIDInsensitively parses an Redis ID into an RedisId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseRedisID method should be used instead for validation etc.
+func ParseRedisIDInsensitively(input string) (*RedisId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RedisId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'Redis' segment
+	RedisKey := "Redis"
+	for key := range id.Path {
+		if strings.EqualFold(key, RedisKey) {
+			RedisKey = key
+			break
+		}
+	}
+	if resourceId.RedisName, err = id.PopSegment(RedisKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
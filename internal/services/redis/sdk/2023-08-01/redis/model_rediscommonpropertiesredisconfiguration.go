@@ -0,0 +1,5 @@
+package redis
+
+type RedisCommonPropertiesRedisConfiguration struct {
+	AadEnabled *string `json:"aad-enabled,omitempty"`
+}
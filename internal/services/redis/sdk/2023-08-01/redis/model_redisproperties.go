@@ -0,0 +1,5 @@
+package redis
+
+type RedisProperties struct {
+	RedisConfiguration *RedisCommonPropertiesRedisConfiguration `json:"redisConfiguration,omitempty"`
+}
@@ -0,0 +1,6 @@
+package redis
+
+type RedisUpdateParameters struct {
+	Properties *RedisUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package redis
+
+type RedisUpdateProperties struct {
+	RedisConfiguration *RedisCommonPropertiesRedisConfiguration `json:"redisConfiguration,omitempty"`
+}
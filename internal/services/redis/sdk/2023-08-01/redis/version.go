@@ -0,0 +1,9 @@
+package redis
+
+import "fmt"
+
+const defaultApiVersion = "2023-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/redis/%s", defaultApiVersion)
+}
@@ -3,11 +3,12 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/redisenterprise/mgmt/2021-03-01/redisenterprise"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/common"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
 )
 
 type Client struct {
 	Client           *redisenterprise.Client
-	DatabaseClient   *redisenterprise.DatabasesClient
+	DatabaseClient   *databases.DatabasesClient
 	OperationsClient *redisenterprise.OperationsClient
 }
 
@@ -15,7 +16,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	client := redisenterprise.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
-	databaseClient := redisenterprise.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	databaseClient := databases.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&databaseClient.Client, o.ResourceManagerAuthorizer)
 
 	operationsClient := redisenterprise.NewOperationsClient(o.ResourceManagerEndpoint)
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
@@ -34,6 +36,19 @@ func dataSourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				ValidateFunc: validate.RedisEnterpriseClusterID,
 			},
 
+			"linked_database_id": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"linked_database_group_nickname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -60,8 +75,17 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 	}
 
 	id := parse.NewRedisEnterpriseDatabaseID(subscriptionId, d.Get("resource_group_name").(string), clusterId.RedisEnterpriseName, d.Get("name").(string))
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 
-	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.Get(ctx, databaseId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) was not found", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName)
+		}
+		return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	}
+
+	keysResp, err := client.ListKeys(ctx, databaseId)
 	if err != nil {
 		return fmt.Errorf("listing keys for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
 	}
@@ -70,8 +94,25 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 	d.Set("name", id.DatabaseName)
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("cluster_id", clusterId.ID())
-	d.Set("primary_access_key", keysResp.PrimaryKey)
-	d.Set("secondary_access_key", keysResp.SecondaryKey)
+
+	groupNickname := ""
+	linkedDatabaseIds := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		geo := model.Properties.GeoReplication
+		if geo.GroupNickname != nil {
+			groupNickname = *geo.GroupNickname
+		}
+		linkedDatabaseIds = flattenArmDatabaseLinkedDatabaseIds(geo.LinkedDatabases)
+	}
+	d.Set("linked_database_group_nickname", groupNickname)
+	if err := d.Set("linked_database_id", linkedDatabaseIds); err != nil {
+		return fmt.Errorf("setting `linked_database_id`: %+v", err)
+	}
+
+	if model := keysResp.Model; model != nil {
+		d.Set("primary_access_key", model.PrimaryKey)
+		d.Set("secondary_access_key", model.SecondaryKey)
+	}
 
 	return nil
 }
@@ -31,6 +31,23 @@ func TestAccRedisEnterpriseDatabaseDataSource_standard(t *testing.T) {
 	})
 }
 
+func TestAccRedisEnterpriseDatabaseDataSource_geoReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_redis_enterprise_database", "test")
+	r := RedisEnterpriseDatabaseDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.geoReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("primary_access_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("2"),
+				check.That(data.ResourceName).Key("linked_database_group_nickname").HasValue(fmt.Sprintf("acctest-group-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
 func (r RedisEnterpriseDatabaseDataSource) dataSource(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -44,3 +61,15 @@ data "azurerm_redis_enterprise_database" "test" {
 }
 `, RedisenterpriseDatabaseResource{}.basic(data))
 }
+
+func (r RedisEnterpriseDatabaseDataSource) geoReplication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_redis_enterprise_database" "test" {
+  name                = azurerm_redis_enterprise_database.test1.name
+  resource_group_name = azurerm_redis_enterprise_database.test1.resource_group_name
+  cluster_id          = azurerm_redis_enterprise_database.test1.cluster_id
+}
+`, RedisenterpriseDatabaseResource{}.geoReplication(data))
+}
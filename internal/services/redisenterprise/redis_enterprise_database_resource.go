@@ -1,16 +1,18 @@
 package redisenterprise
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/redisenterprise/mgmt/2021-03-01/redisenterprise"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/azure"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/helpers/tf"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/validate"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
@@ -22,12 +24,13 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisEnterpriseDatabaseCreate,
 		Read:   resourceRedisEnterpriseDatabaseRead,
-		// Update currently is not implemented, will be for GA
+		Update: resourceRedisEnterpriseDatabaseUpdate,
 		Delete: resourceRedisEnterpriseDatabaseDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -36,8 +39,15 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			return err
 		}),
 
-		// Since update is not currently supported all attribute have to be marked as FORCE NEW
-		// until support for Update comes online in the near future
+		// the `args` of the existing modules can be updated in-place, however adding, removing or
+		// reordering modules requires the database to be recreated
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("module", func(ctx context.Context, old, new, _ interface{}) bool {
+				return !redisEnterpriseDatabaseModuleNamesMatch(old.([]interface{}), new.([]interface{}))
+			}),
+		),
+
+		// Since update is only supported for module arguments and the members of the replication group all other attributes have to be marked as FORCE NEW
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -60,10 +70,10 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.Encrypted),
+				Default:  string(databases.ProtocolEncrypted),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.Encrypted),
-					string(redisenterprise.Plaintext),
+					string(databases.ProtocolEncrypted),
+					string(databases.ProtocolPlaintext),
 				}, false),
 			},
 
@@ -71,10 +81,10 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.OSSCluster),
+				Default:  string(databases.ClusteringPolicyOSSCluster),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.EnterpriseCluster),
-					string(redisenterprise.OSSCluster),
+					string(databases.ClusteringPolicyEnterpriseCluster),
+					string(databases.ClusteringPolicyOSSCluster),
 				}, false),
 			},
 
@@ -82,30 +92,50 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.VolatileLRU),
+				Default:  string(databases.EvictionPolicyVolatileLRU),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.AllKeysLFU),
-					string(redisenterprise.AllKeysLRU),
-					string(redisenterprise.AllKeysRandom),
-					string(redisenterprise.VolatileLRU),
-					string(redisenterprise.VolatileLFU),
-					string(redisenterprise.VolatileTTL),
-					string(redisenterprise.VolatileRandom),
-					string(redisenterprise.NoEviction),
+					string(databases.EvictionPolicyAllKeysLFU),
+					string(databases.EvictionPolicyAllKeysLRU),
+					string(databases.EvictionPolicyAllKeysRandom),
+					string(databases.EvictionPolicyVolatileLRU),
+					string(databases.EvictionPolicyVolatileLFU),
+					string(databases.EvictionPolicyVolatileTTL),
+					string(databases.EvictionPolicyVolatileRandom),
+					string(databases.EvictionPolicyNoEviction),
 				}, false),
 			},
 
+			// this is Computed since the membership of the replication group can also be managed using the
+			// `azurerm_redis_enterprise_linked_database` resource, so this only causes a diff when it's configured
+			"linked_database_id": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.RedisEnterpriseDatabaseID,
+				},
+				RequiredWith: []string{"linked_database_group_nickname"},
+			},
+
+			"linked_database_group_nickname": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"linked_database_id"},
+			},
+
 			"module": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 3,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"RedisBloom",
 								"RedisTimeSeries",
@@ -116,7 +146,6 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 						"args": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Default:  "",
 						},
 
@@ -144,8 +173,8 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			// 				Type:     pluginsdk.TypeString,
 			// 				Optional: true,
 			// 				ValidateFunc: validation.StringInSlice([]string{
-			// 					string(redisenterprise.Ones),
-			// 					string(redisenterprise.Always),
+			// 					string(databases.AofFrequencyOnes),
+			// 					string(databases.AofFrequencyAlways),
 			// 				}, false),
 			// 			},
 
@@ -158,9 +187,9 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			// 				Type:     pluginsdk.TypeString,
 			// 				Optional: true,
 			// 				ValidateFunc: validation.StringInSlice([]string{
-			// 					string(redisenterprise.Oneh),
-			// 					string(redisenterprise.Sixh),
-			// 					string(redisenterprise.OneTwoh),
+			// 					string(databases.RdbFrequencyOneh),
+			// 					string(databases.RdbFrequencySixh),
+			// 					string(databases.RdbFrequencyOneTwoh),
 			// 				}, false),
 			// 			},
 			// 		},
@@ -200,31 +229,41 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 
 	clusterID, _ := parse.RedisEnterpriseClusterID(d.Get("cluster_id").(string))
 	id := parse.NewRedisEnterpriseDatabaseID(subscriptionId, resourceGroup, clusterID.RedisEnterpriseName, name)
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 
-	existing, err := client.Get(ctx, resourceGroup, id.RedisEnterpriseName, name)
+	existing, err := client.Get(ctx, databaseId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for present of existing Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
 		}
 	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_redis_enterprise_database", id.ID())
 	}
 
-	parameters := redisenterprise.Database{
-		DatabaseProperties: &redisenterprise.DatabaseProperties{
-			ClientProtocol:   redisenterprise.Protocol(d.Get("client_protocol").(string)),
-			ClusteringPolicy: redisenterprise.ClusteringPolicy(d.Get("clustering_policy").(string)),
-			EvictionPolicy:   redisenterprise.EvictionPolicy(d.Get("eviction_policy").(string)),
+	clientProtocol := databases.Protocol(d.Get("client_protocol").(string))
+	clusteringPolicy := databases.ClusteringPolicy(d.Get("clustering_policy").(string))
+	evictionPolicy := databases.EvictionPolicy(d.Get("eviction_policy").(string))
+	parameters := databases.Database{
+		Properties: &databases.DatabaseProperties{
+			ClientProtocol:   &clientProtocol,
+			ClusteringPolicy: &clusteringPolicy,
+			EvictionPolicy:   &evictionPolicy,
 			Modules:          expandArmDatabaseModuleArray(d.Get("module").([]interface{})),
 			//Persistence:      expandArmDatabasePersistence(d.Get("persistence").([]interface{})),
-			Port: utils.Int32(int32(d.Get("port").(int))),
+			Port: utils.Int64(int64(d.Get("port").(int))),
 		},
 	}
 
-	future, err := client.Create(ctx, resourceGroup, id.RedisEnterpriseName, name, parameters)
-	if err != nil {
+	if v, ok := d.GetOk("linked_database_id"); ok {
+		parameters.Properties.GeoReplication = &databases.DatabasePropertiesGeoReplication{
+			GroupNickname:   utils.String(d.Get("linked_database_group_nickname").(string)),
+			LinkedDatabases: expandArmDatabaseLinkedDatabases(v.(*pluginsdk.Set).List()),
+		}
+	}
+
+	if err := client.CreateThenPoll(ctx, databaseId, parameters); err != nil {
 		// Need to check if this was due to the cluster having the wrong sku
 		if strings.Contains(err.Error(), "The value of the parameter 'properties.modules' is invalid") {
 			clusterClient := meta.(*clients.Client).RedisEnterprise.Client
@@ -241,10 +280,6 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("creating Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creating future for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
-	}
-
 	d.SetId(id.ID())
 
 	return resourceRedisEnterpriseDatabaseRead(d, meta)
@@ -259,10 +294,11 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.Get(ctx, databaseId)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Redis Enterprise Database %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -270,7 +306,7 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
 	}
 
-	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	keysResp, err := client.ListKeys(ctx, databaseId)
 	if err != nil {
 		return fmt.Errorf("listing keys for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
 	}
@@ -279,85 +315,187 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("cluster_id", parse.NewRedisEnterpriseClusterID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName).ID())
 
-	if props := resp.DatabaseProperties; props != nil {
-		d.Set("client_protocol", props.ClientProtocol)
-		d.Set("clustering_policy", props.ClusteringPolicy)
-		d.Set("eviction_policy", props.EvictionPolicy)
-		if err := d.Set("module", flattenArmDatabaseModuleArray(props.Modules)); err != nil {
-			return fmt.Errorf("setting `module`: %+v", err)
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			clientProtocol := ""
+			if props.ClientProtocol != nil {
+				clientProtocol = string(*props.ClientProtocol)
+			}
+			d.Set("client_protocol", clientProtocol)
+
+			clusteringPolicy := ""
+			if props.ClusteringPolicy != nil {
+				clusteringPolicy = string(*props.ClusteringPolicy)
+			}
+			d.Set("clustering_policy", clusteringPolicy)
+
+			evictionPolicy := ""
+			if props.EvictionPolicy != nil {
+				evictionPolicy = string(*props.EvictionPolicy)
+			}
+			d.Set("eviction_policy", evictionPolicy)
+
+			if err := d.Set("module", flattenArmDatabaseModuleArray(props.Modules)); err != nil {
+				return fmt.Errorf("setting `module`: %+v", err)
+			}
+			// if err := d.Set("persistence", flattenArmDatabasePersistence(props.Persistence)); err != nil {
+			// 	return fmt.Errorf("setting `persistence`: %+v", err)
+			// }
+			d.Set("port", props.Port)
+
+			groupNickname := ""
+			linkedDatabaseIds := make([]interface{}, 0)
+			if geo := props.GeoReplication; geo != nil {
+				if geo.GroupNickname != nil {
+					groupNickname = *geo.GroupNickname
+				}
+				linkedDatabaseIds = flattenArmDatabaseLinkedDatabaseIds(geo.LinkedDatabases)
+			}
+			d.Set("linked_database_group_nickname", groupNickname)
+			if err := d.Set("linked_database_id", linkedDatabaseIds); err != nil {
+				return fmt.Errorf("setting `linked_database_id`: %+v", err)
+			}
 		}
-		// if err := d.Set("persistence", flattenArmDatabasePersistence(props.Persistence)); err != nil {
-		// 	return fmt.Errorf("setting `persistence`: %+v", err)
-		// }
-		d.Set("port", props.Port)
 	}
 
-	d.Set("primary_access_key", keysResp.PrimaryKey)
-	d.Set("secondary_access_key", keysResp.SecondaryKey)
+	if model := keysResp.Model; model != nil {
+		d.Set("primary_access_key", model.PrimaryKey)
+		d.Set("secondary_access_key", model.SecondaryKey)
+	}
 
 	return nil
 }
 
-func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
 	if err != nil {
 		return err
 	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+
+	if d.HasChange("module") {
+		parameters := databases.DatabaseUpdate{
+			Properties: &databases.DatabaseProperties{
+				Modules: expandArmDatabaseModuleArray(d.Get("module").([]interface{})),
+			},
+		}
+
+		if err := client.UpdateThenPoll(ctx, databaseId, parameters); err != nil {
+			return fmt.Errorf("updating the modules of Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+		}
+	}
+
+	if d.HasChange("linked_database_id") {
+		old, new := d.GetChange("linked_database_id")
+
+		// an existing database can only be linked to other databases by force linking it, which discards all of the
+		// data within it - as such this is only done by the `azurerm_redis_enterprise_linked_database` resource
+		if added := new.(*pluginsdk.Set).Difference(old.(*pluginsdk.Set)).List(); len(added) > 0 {
+			return fmt.Errorf("Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) can't be linked to the databases added to `linked_database_id` once it has been created - the `azurerm_redis_enterprise_linked_database` resource can be used to force link it instead, in which case `linked_database_id` should be added to `ignore_changes` within a `lifecycle` block", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName)
+		}
+
+		if removed := old.(*pluginsdk.Set).Difference(new.(*pluginsdk.Set)).List(); len(removed) > 0 {
+			parameters := databases.ForceUnlinkParameters{
+				Ids: *utils.ExpandStringSlice(removed),
+			}
+
+			log.Printf("[INFO] force unlinking %d database(s) from Redis Enterprise Database %q (Resource Group %q / Cluster Name %q)", len(removed), id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName)
+			if err := client.ForceUnlinkThenPoll(ctx, databaseId, parameters); err != nil {
+				return fmt.Errorf("force unlinking databases from Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+			}
+		}
+	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	return resourceRedisEnterpriseDatabaseRead(d, meta)
+}
+
+func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
 	if err != nil {
-		return fmt.Errorf("deleting Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+		return err
 	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deleting future for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	if err := client.DeleteThenPoll(ctx, databaseId); err != nil {
+		return fmt.Errorf("deleting Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
 	}
 	return nil
 }
 
-func expandArmDatabaseModuleArray(input []interface{}) *[]redisenterprise.Module {
-	results := make([]redisenterprise.Module, 0)
+func expandArmDatabaseModuleArray(input []interface{}) *[]databases.Module {
+	results := make([]databases.Module, 0)
 
 	for _, item := range input {
 		v := item.(map[string]interface{})
-		results = append(results, redisenterprise.Module{
-			Name: utils.String(v["name"].(string)),
+		results = append(results, databases.Module{
+			Name: v["name"].(string),
 			Args: utils.String(v["args"].(string)),
 		})
 	}
 	return &results
 }
 
+func expandArmDatabaseLinkedDatabases(input []interface{}) *[]databases.LinkedDatabase {
+	results := make([]databases.LinkedDatabase, 0)
+
+	for _, item := range input {
+		results = append(results, databases.LinkedDatabase{
+			Id: utils.String(item.(string)),
+		})
+	}
+	return &results
+}
+
+func redisEnterpriseDatabaseModuleNamesMatch(old, new []interface{}) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	for i := range old {
+		oldName, newName := "", ""
+		if v, ok := old[i].(map[string]interface{}); ok {
+			oldName = v["name"].(string)
+		}
+		if v, ok := new[i].(map[string]interface{}); ok {
+			newName = v["name"].(string)
+		}
+		if oldName != newName {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
-// func expandArmDatabasePersistence(input []interface{}) *redisenterprise.Persistence {
+// func expandArmDatabasePersistence(input []interface{}) *databases.Persistence {
 // 	if len(input) == 0 {
 // 		return nil
 // 	}
 // 	v := input[0].(map[string]interface{})
-// 	return &redisenterprise.Persistence{
+// 	return &databases.Persistence{
 // 		AofEnabled:   utils.Bool(v["aof_enabled"].(bool)),
-// 		AofFrequency: redisenterprise.AofFrequency(v["aof_frequency"].(string)),
+// 		AofFrequency: databases.AofFrequency(v["aof_frequency"].(string)),
 // 		RdbEnabled:   utils.Bool(v["rdb_enabled"].(bool)),
-// 		RdbFrequency: redisenterprise.RdbFrequency(v["rdb_frequency"].(string)),
+// 		RdbFrequency: databases.RdbFrequency(v["rdb_frequency"].(string)),
 // 	}
 // }
 
-func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{} {
+func flattenArmDatabaseModuleArray(input *[]databases.Module) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
-		var name string
-		if item.Name != nil {
-			name = *item.Name
-		}
-
 		args := ""
 		if item.Args != nil {
 			args = *item.Args
@@ -377,7 +515,7 @@ func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{
 		}
 
 		results = append(results, map[string]interface{}{
-			"name":    name,
+			"name":    item.Name,
 			"args":    args,
 			"version": version,
 		})
@@ -386,8 +524,29 @@ func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{
 	return results
 }
 
+func flattenArmDatabaseLinkedDatabaseIds(input *[]databases.LinkedDatabase) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id == nil {
+			continue
+		}
+
+		id := *item.Id
+		if parsed, err := parse.RedisEnterpriseDatabaseID(id); err == nil {
+			id = parsed.ID()
+		}
+		results = append(results, id)
+	}
+
+	return results
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
-// func flattenArmDatabasePersistence(input *redisenterprise.Persistence) []interface{} {
+// func flattenArmDatabasePersistence(input *databases.Persistence) []interface{} {
 // 	if input == nil {
 // 		return make([]interface{}, 0)
 // 	}
@@ -397,7 +556,7 @@ func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{
 // 		aofEnabled = *input.AofEnabled
 // 	}
 
-// 	var aofFrequency redisenterprise.AofFrequency
+// 	var aofFrequency databases.AofFrequency
 // 	if input.AofFrequency != "" {
 // 		aofFrequency = input.AofFrequency
 // 	}
@@ -407,7 +566,7 @@ func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{
 // 		rdbEnabled = *input.RdbEnabled
 // 	}
 
-// 	var rdbFrequency redisenterprise.RdbFrequency
+// 	var rdbFrequency databases.RdbFrequency
 // 	if input.RdbFrequency != "" {
 // 		rdbFrequency = input.RdbFrequency
 // 	}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
 	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
 )
@@ -57,15 +59,76 @@ func TestRedisEnterpriseDatabase_complete(t *testing.T) {
 	})
 }
 
+func TestRedisEnterpriseDatabase_updateModuleArgs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.moduleArgsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("module.2.args").HasValue("RETENTION_POLICY 30"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestRedisEnterpriseDatabase_geoReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("2"),
+				check.That(data.ResourceName).Key("linked_database_group_nickname").HasValue(fmt.Sprintf("acctest-group-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestRedisEnterpriseDatabase_geoReplicationUnlink(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing a database from `linked_database_id` force unlinks it in-place
+			Config: r.geoReplicationUnlinked(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r RedisenterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RedisEnterpriseDatabaseID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving Redis Entrprise Database %q (Resource Group %q / clusterName %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
@@ -154,3 +217,145 @@ resource "azurerm_redis_enterprise_database" "test" {
 }
 `, template)
 }
+
+func (r RedisenterpriseDatabaseResource) moduleArgsUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_database" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  module {
+    name = "RediSearch"
+    args = ""
+  }
+
+  module {
+    name = "RedisBloom"
+    args = "ERROR_RATE 0.01 INITIAL_SIZE 400"
+  }
+
+  module {
+    name = "RedisTimeSeries"
+    args = "RETENTION_POLICY 30"
+  }
+
+  port = 10000
+}
+`, template)
+}
+
+func (r RedisenterpriseDatabaseResource) geoReplicationTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_cluster" "test1" {
+  name                = "acctest-rec1-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%s"
+
+  sku_name = "Enterprise_E20-4"
+}
+`, r.template(data), data.RandomInteger, "westus2")
+}
+
+func (r RedisenterpriseDatabaseResource) geoReplication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  database_name = "default"
+}
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+    "${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+}
+
+resource "azurerm_redis_enterprise_database" "test1" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test1.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+    "${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  depends_on = [azurerm_redis_enterprise_database.test]
+}
+`, r.geoReplicationTemplate(data), data.RandomInteger)
+}
+
+func (r RedisenterpriseDatabaseResource) geoReplicationUnlinked(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  database_name = "default"
+}
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+}
+
+resource "azurerm_redis_enterprise_database" "test1" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test1.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+    "${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  lifecycle {
+    ignore_changes = [linked_database_id]
+  }
+
+  depends_on = [azurerm_redis_enterprise_database.test]
+}
+`, r.geoReplicationTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,331 @@
+package redisenterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/locks"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/validate"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/validation"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/timeouts"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+const redisEnterpriseLinkedDatabaseResourceName = "azurerm_redis_enterprise_linked_database"
+
+func resourceRedisEnterpriseLinkedDatabase() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRedisEnterpriseLinkedDatabaseCreate,
+		Read:   resourceRedisEnterpriseLinkedDatabaseRead,
+		Update: resourceRedisEnterpriseLinkedDatabaseUpdate,
+		Delete: resourceRedisEnterpriseLinkedDatabaseDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		// the replication group membership of a database is managed as a whole, so the database ID identifies this resource
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RedisEnterpriseDatabaseID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RedisEnterpriseDatabaseID,
+			},
+
+			"linked_database_group_nickname": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"linked_database_id": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.RedisEnterpriseDatabaseID,
+				},
+			},
+
+			"force_link_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"link_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRedisEnterpriseLinkedDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RedisEnterpriseDatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+
+	groupNickname := d.Get("linked_database_group_nickname").(string)
+	locks.ByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+	defer locks.UnlockByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+
+	existing, err := client.Get(ctx, databaseId)
+	if err != nil {
+		return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	}
+
+	// a database normally joins the replication group when it's created, since force linking an existing database to
+	// the group discards all of the data within it - as such this is only done when `force_link_enabled` is set
+	linkedDatabaseIds := d.Get("linked_database_id").(*pluginsdk.Set).List()
+	if !redisEnterpriseDatabaseIsLinkedTo(existing.Model, groupNickname, linkedDatabaseIds) {
+		if !d.Get("force_link_enabled").(bool) {
+			return fmt.Errorf("Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) is not linked to each of the databases in `linked_database_id` within replication group %q - either the database must join the replication group when it's created (using the `linked_database_id` and `linked_database_group_nickname` of the `azurerm_redis_enterprise_database` resource) or `force_link_enabled` must be set to `true`, which discards all of the data within the database", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname)
+		}
+
+		if err := forceLinkRedisEnterpriseDatabase(ctx, client, *id, groupNickname, linkedDatabaseIds); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRedisEnterpriseLinkedDatabaseRead(d, meta)
+}
+
+func resourceRedisEnterpriseLinkedDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+
+	resp, err := client.Get(ctx, databaseId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Redis Enterprise Database %q does not exist - removing Linked Database from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	}
+
+	groupNickname := ""
+	linkState := ""
+	linkedDatabaseIds := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		geo := model.Properties.GeoReplication
+		if geo.GroupNickname != nil {
+			groupNickname = *geo.GroupNickname
+		}
+		if geo.LinkedDatabases != nil {
+			for _, item := range *geo.LinkedDatabases {
+				if item.Id == nil {
+					continue
+				}
+
+				linkedId := *item.Id
+				if parsed, err := parse.RedisEnterpriseDatabaseID(linkedId); err == nil {
+					linkedId = parsed.ID()
+				}
+
+				if strings.EqualFold(linkedId, id.ID()) {
+					if item.State != nil {
+						linkState = string(*item.State)
+					}
+					continue
+				}
+				linkedDatabaseIds = append(linkedDatabaseIds, linkedId)
+			}
+		}
+	}
+
+	if len(linkedDatabaseIds) == 0 {
+		log.Printf("[INFO] Redis Enterprise Database %q is not linked to any other databases - removing Linked Database from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("database_id", id.ID())
+	d.Set("linked_database_group_nickname", groupNickname)
+	d.Set("link_state", linkState)
+	if err := d.Set("linked_database_id", linkedDatabaseIds); err != nil {
+		return fmt.Errorf("setting `linked_database_id`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceRedisEnterpriseLinkedDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+
+	groupNickname := d.Get("linked_database_group_nickname").(string)
+	locks.ByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+	defer locks.UnlockByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+
+	if d.HasChange("linked_database_id") {
+		old, new := d.GetChange("linked_database_id")
+
+		// databases which have been added must either have joined the replication group when they were created,
+		// or this database is force linked to them - which discards all of the data within it
+		if added := new.(*pluginsdk.Set).Difference(old.(*pluginsdk.Set)).List(); len(added) > 0 {
+			existing, err := client.Get(ctx, databaseId)
+			if err != nil {
+				return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+			}
+
+			if !redisEnterpriseDatabaseIsLinkedTo(existing.Model, groupNickname, added) {
+				if !d.Get("force_link_enabled").(bool) {
+					return fmt.Errorf("Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) is not linked to each of the databases added to `linked_database_id` within replication group %q - either the databases must join the replication group when they're created (using the `linked_database_id` and `linked_database_group_nickname` of the `azurerm_redis_enterprise_database` resource) or `force_link_enabled` must be set to `true`, which discards all of the data within this database", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname)
+				}
+
+				if err := forceLinkRedisEnterpriseDatabase(ctx, client, *id, groupNickname, new.(*pluginsdk.Set).List()); err != nil {
+					return err
+				}
+			}
+		}
+
+		if removed := old.(*pluginsdk.Set).Difference(new.(*pluginsdk.Set)).List(); len(removed) > 0 {
+			parameters := databases.ForceUnlinkParameters{
+				Ids: *utils.ExpandStringSlice(removed),
+			}
+
+			log.Printf("[INFO] force unlinking %d database(s) from Redis Enterprise Database %q (Resource Group %q / Cluster Name %q)", len(removed), id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName)
+			if err := client.ForceUnlinkThenPoll(ctx, databaseId, parameters); err != nil {
+				return fmt.Errorf("force unlinking databases from Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+			}
+		}
+	}
+
+	return resourceRedisEnterpriseLinkedDatabaseRead(d, meta)
+}
+
+func resourceRedisEnterpriseLinkedDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupNickname := d.Get("linked_database_group_nickname").(string)
+	locks.ByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+	defer locks.UnlockByName(groupNickname, redisEnterpriseLinkedDatabaseResourceName)
+
+	// the database is unlinked by one of the remaining members of the replication group, so that this works even when
+	// the database being unlinked (or the cluster it's within) is unavailable
+	parameters := databases.ForceUnlinkParameters{
+		Ids: []string{id.ID()},
+	}
+	for _, v := range d.Get("linked_database_id").(*pluginsdk.Set).List() {
+		remaining, err := parse.RedisEnterpriseDatabaseID(v.(string))
+		if err != nil {
+			return err
+		}
+		remainingId := databases.NewDatabaseID(remaining.SubscriptionId, remaining.ResourceGroup, remaining.RedisEnterpriseName, remaining.DatabaseName)
+
+		existing, err := client.Get(ctx, remainingId)
+		if err != nil {
+			if response.WasNotFound(existing.HttpResponse) {
+				continue
+			}
+			return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", remaining.DatabaseName, remaining.ResourceGroup, remaining.RedisEnterpriseName, err)
+		}
+
+		log.Printf("[INFO] force unlinking Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) from replication group %q", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname)
+		if err := client.ForceUnlinkThenPoll(ctx, remainingId, parameters); err != nil {
+			return fmt.Errorf("force unlinking Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) from replication group %q: %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname, err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] none of the linked databases for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) exist - nothing to unlink", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName)
+	return nil
+}
+
+// forceLinkRedisEnterpriseDatabase links the database to each of the other databases within the replication group,
+// recreating it and discarding all of the data within it
+func forceLinkRedisEnterpriseDatabase(ctx context.Context, client *databases.DatabasesClient, id parse.RedisEnterpriseDatabaseId, groupNickname string, linkedDatabaseIds []interface{}) error {
+	databaseId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	parameters := databases.ForceLinkParameters{
+		GeoReplication: databases.ForceLinkParametersGeoReplication{
+			GroupNickname:   utils.String(groupNickname),
+			LinkedDatabases: expandArmDatabaseLinkedDatabases(append(linkedDatabaseIds, id.ID())),
+		},
+	}
+
+	log.Printf("[INFO] force linking Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) to replication group %q", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname)
+	if err := client.ForceLinkToReplicationGroupThenPoll(ctx, databaseId, parameters); err != nil {
+		return fmt.Errorf("force linking Redis Enterprise Database %q (Resource Group %q / Cluster Name %q) to replication group %q: %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, groupNickname, err)
+	}
+
+	return nil
+}
+
+// redisEnterpriseDatabaseIsLinkedTo returns whether the database is a member of the specified replication group and
+// is linked (or is being linked) to each of the other databases
+func redisEnterpriseDatabaseIsLinkedTo(model *databases.Database, groupNickname string, linkedDatabaseIds []interface{}) bool {
+	if model == nil || model.Properties == nil || model.Properties.GeoReplication == nil {
+		return false
+	}
+	geo := model.Properties.GeoReplication
+	if geo.GroupNickname == nil || *geo.GroupNickname != groupNickname || geo.LinkedDatabases == nil {
+		return false
+	}
+
+	linked := make(map[string]bool)
+	for _, item := range *geo.LinkedDatabases {
+		if item.Id != nil && item.State != nil && (*item.State == databases.LinkStateLinked || *item.State == databases.LinkStateLinking) {
+			linked[strings.ToLower(*item.Id)] = true
+		}
+	}
+
+	for _, v := range linkedDatabaseIds {
+		if !linked[strings.ToLower(v.(string))] {
+			return false
+		}
+	}
+
+	return true
+}
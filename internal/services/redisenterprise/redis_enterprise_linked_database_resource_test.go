@@ -0,0 +1,209 @@
+package redisenterprise_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/acceptance/check"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/clients"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/parse"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/services/redisenterprise/sdk/2024-10-01/databases"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/internal/tf/pluginsdk"
+	"github.com/kevinklinger/terraform-provider-azurerm/v2/utils"
+)
+
+type RedisEnterpriseLinkedDatabaseResource struct{}
+
+func TestRedisEnterpriseLinkedDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_linked_database", "test")
+	r := RedisEnterpriseLinkedDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link_state").HasValue("Linked"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestRedisEnterpriseLinkedDatabase_unlink(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_linked_database", "test")
+	r := RedisEnterpriseLinkedDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the resource force unlinks the database from the replication group
+			Config: r.template(data),
+		},
+	})
+}
+
+func TestRedisEnterpriseLinkedDatabase_forceLink(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_linked_database", "test")
+	r := RedisEnterpriseLinkedDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forceLink(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link_state").HasValue("Linked"),
+			),
+		},
+		data.ImportStep("force_link_enabled"),
+	})
+}
+
+func (r RedisEnterpriseLinkedDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RedisEnterpriseDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil && model.Properties.GeoReplication.LinkedDatabases != nil {
+		for _, item := range *model.Properties.GeoReplication.LinkedDatabases {
+			if item.Id != nil && !strings.EqualFold(*item.Id, id.ID()) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r RedisEnterpriseLinkedDatabaseResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  database_name = "default"
+}
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+    "${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  lifecycle {
+    ignore_changes = [linked_database_id]
+  }
+}
+
+resource "azurerm_redis_enterprise_database" "test1" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test1.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id = [
+    "${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}",
+    "${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}",
+  ]
+
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  lifecycle {
+    ignore_changes = [linked_database_id]
+  }
+
+  depends_on = [azurerm_redis_enterprise_database.test]
+}
+`, RedisenterpriseDatabaseResource{}.geoReplicationTemplate(data), data.RandomInteger)
+}
+
+func (r RedisEnterpriseLinkedDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_redis_enterprise_linked_database" "test" {
+  database_id                    = azurerm_redis_enterprise_database.test1.id
+  linked_database_group_nickname = azurerm_redis_enterprise_database.test1.linked_database_group_nickname
+  linked_database_id             = [azurerm_redis_enterprise_database.test.id]
+}
+`, r.template(data))
+}
+
+func (r RedisEnterpriseLinkedDatabaseResource) forceLink(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  database_name = "default"
+}
+
+resource "azurerm_redis_enterprise_database" "test" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id             = ["${azurerm_redis_enterprise_cluster.test.id}/databases/${local.database_name}"]
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  lifecycle {
+    ignore_changes = [linked_database_id]
+  }
+}
+
+resource "azurerm_redis_enterprise_database" "test1" {
+  name                = local.database_name
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test1.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  linked_database_id             = ["${azurerm_redis_enterprise_cluster.test1.id}/databases/${local.database_name}"]
+  linked_database_group_nickname = "acctest-group-%[2]d"
+
+  lifecycle {
+    ignore_changes = [linked_database_id]
+  }
+}
+
+resource "azurerm_redis_enterprise_linked_database" "test" {
+  database_id                    = azurerm_redis_enterprise_database.test1.id
+  linked_database_group_nickname = azurerm_redis_enterprise_database.test1.linked_database_group_nickname
+  linked_database_id             = [azurerm_redis_enterprise_database.test.id]
+  force_link_enabled             = true
+}
+`, RedisenterpriseDatabaseResource{}.geoReplicationTemplate(data), data.RandomInteger)
+}
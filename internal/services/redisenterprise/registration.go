@@ -28,7 +28,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_redis_enterprise_cluster":  resourceRedisEnterpriseCluster(),
-		"azurerm_redis_enterprise_database": resourceRedisEnterpriseDatabase(),
+		"azurerm_redis_enterprise_cluster":         resourceRedisEnterpriseCluster(),
+		"azurerm_redis_enterprise_database":        resourceRedisEnterpriseDatabase(),
+		"azurerm_redis_enterprise_linked_database": resourceRedisEnterpriseLinkedDatabase(),
 	}
 }
@@ -0,0 +1,75 @@
+package databases
+
+type ClusteringPolicy string
+
+const (
+	ClusteringPolicyEnterpriseCluster ClusteringPolicy = "EnterpriseCluster"
+	ClusteringPolicyOSSCluster        ClusteringPolicy = "OSSCluster"
+)
+
+func PossibleValuesForClusteringPolicy() []string {
+	return []string{
+		string(ClusteringPolicyEnterpriseCluster),
+		string(ClusteringPolicyOSSCluster),
+	}
+}
+
+type EvictionPolicy string
+
+const (
+	EvictionPolicyAllKeysLFU     EvictionPolicy = "AllKeysLFU"
+	EvictionPolicyAllKeysLRU     EvictionPolicy = "AllKeysLRU"
+	EvictionPolicyAllKeysRandom  EvictionPolicy = "AllKeysRandom"
+	EvictionPolicyNoEviction     EvictionPolicy = "NoEviction"
+	EvictionPolicyVolatileLFU    EvictionPolicy = "VolatileLFU"
+	EvictionPolicyVolatileLRU    EvictionPolicy = "VolatileLRU"
+	EvictionPolicyVolatileRandom EvictionPolicy = "VolatileRandom"
+	EvictionPolicyVolatileTTL    EvictionPolicy = "VolatileTTL"
+)
+
+func PossibleValuesForEvictionPolicy() []string {
+	return []string{
+		string(EvictionPolicyAllKeysLFU),
+		string(EvictionPolicyAllKeysLRU),
+		string(EvictionPolicyAllKeysRandom),
+		string(EvictionPolicyNoEviction),
+		string(EvictionPolicyVolatileLFU),
+		string(EvictionPolicyVolatileLRU),
+		string(EvictionPolicyVolatileRandom),
+		string(EvictionPolicyVolatileTTL),
+	}
+}
+
+type LinkState string
+
+const (
+	LinkStateLinkFailed   LinkState = "LinkFailed"
+	LinkStateLinked       LinkState = "Linked"
+	LinkStateLinking      LinkState = "Linking"
+	LinkStateUnlinkFailed LinkState = "UnlinkFailed"
+	LinkStateUnlinking    LinkState = "Unlinking"
+)
+
+func PossibleValuesForLinkState() []string {
+	return []string{
+		string(LinkStateLinkFailed),
+		string(LinkStateLinked),
+		string(LinkStateLinking),
+		string(LinkStateUnlinkFailed),
+		string(LinkStateUnlinking),
+	}
+}
+
+type Protocol string
+
+const (
+	ProtocolEncrypted Protocol = "Encrypted"
+	ProtocolPlaintext Protocol = "Plaintext"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolEncrypted),
+		string(ProtocolPlaintext),
+	}
+}
@@ -0,0 +1,126 @@
+package databases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DatabaseId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	RedisEnterpriseName string
+	DatabaseName        string
+}
+
+func NewDatabaseID(subscriptionId, resourceGroup, redisEnterpriseName, databaseName string) DatabaseId {
+	return DatabaseId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		RedisEnterpriseName: redisEnterpriseName,
+		DatabaseName:        databaseName,
+	}
+}
+
+func (id DatabaseId) String() string {
+	segments := []string{
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Redis Enterprise Name %q", id.RedisEnterpriseName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Database", segmentsStr)
+}
+
+func (id DatabaseId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/redisEnterprise/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+}
+
+// ParseDatabaseID parses a Database ID into an DatabaseId struct
+func ParseDatabaseID(input string) (*DatabaseId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RedisEnterpriseName, err = id.PopSegment("redisEnterprise"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDatabaseIDInsensitively parses an Database ID into an DatabaseId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDatabaseID method should be used instead for validation etc.
+func ParseDatabaseIDInsensitively(input string) (*DatabaseId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'redisEnterprise' segment
+	redisEnterpriseKey := "redisEnterprise"
+	for key := range id.Path {
+		if strings.EqualFold(key, redisEnterpriseKey) {
+			redisEnterpriseKey = key
+			break
+		}
+	}
+	if resourceId.RedisEnterpriseName, err = id.PopSegment(redisEnterpriseKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'databases' segment
+	databasesKey := "databases"
+	for key := range id.Path {
+		if strings.EqualFold(key, databasesKey) {
+			databasesKey = key
+			break
+		}
+	}
+	if resourceId.DatabaseName, err = id.PopSegment(databasesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
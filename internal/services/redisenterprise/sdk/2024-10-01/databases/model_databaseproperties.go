@@ -0,0 +1,13 @@
+package databases
+
+type DatabaseProperties struct {
+	ClientProtocol    *Protocol                         `json:"clientProtocol,omitempty"`
+	ClusteringPolicy  *ClusteringPolicy                 `json:"clusteringPolicy,omitempty"`
+	EvictionPolicy    *EvictionPolicy                   `json:"evictionPolicy,omitempty"`
+	GeoReplication    *DatabasePropertiesGeoReplication `json:"geoReplication,omitempty"`
+	Modules           *[]Module                         `json:"modules,omitempty"`
+	Port              *int64                            `json:"port,omitempty"`
+	ProvisioningState *string                           `json:"provisioningState,omitempty"`
+	RedisVersion      *string                           `json:"redisVersion,omitempty"`
+	ResourceState     *string                           `json:"resourceState,omitempty"`
+}
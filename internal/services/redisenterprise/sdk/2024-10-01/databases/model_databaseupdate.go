@@ -0,0 +1,5 @@
+package databases
+
+type DatabaseUpdate struct {
+	Properties *DatabaseProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package databases
+
+import "fmt"
+
+const defaultApiVersion = "2024-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/databases/%s", defaultApiVersion)
+}
@@ -1,6 +1,11 @@
 package utils
 
-import "net"
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+)
 
 func NormalizeIPv6Address(ipv6 interface{}) string {
 	if ipv6 == nil || ipv6.(string) == "" {
@@ -12,3 +17,278 @@ func NormalizeIPv6Address(ipv6 interface{}) string {
 	}
 	return r.String()
 }
+
+// Azure reserves the first four addresses and the last address within each Subnet, which limits how small a
+// Subnet can be - IPv6 Subnets must always be a /64
+const (
+	subnetReservedAddressesAtStart = 4
+	subnetReservedAddressesAtEnd   = 1
+	maxIPv4SubnetPrefixLength      = 29
+	ipv6SubnetPrefixLength         = 64
+)
+
+type addressRange struct {
+	bits  int
+	start *big.Int
+	end   *big.Int
+}
+
+func (r addressRange) overlaps(other addressRange) bool {
+	return r.bits == other.bits && r.start.Cmp(other.end) <= 0 && other.start.Cmp(r.end) <= 0
+}
+
+// AvailableSubnetPrefixes returns, for each of the requested prefix lengths and in the same order, the lowest
+// prefix within the address spaces which is aligned to its size and which doesn't overlap either the used
+// prefixes or any prefix allocated to an earlier request.
+//
+// Requests are allocated in the order specified, so appending a request never changes the prefixes allocated
+// to the earlier ones. IPv4 prefixes must be a /29 or larger and IPv6 prefixes must be a /64, since Azure
+// reserves five addresses within each Subnet.
+func AvailableSubnetPrefixes(addressSpaces []string, usedPrefixes []string, prefixLengths []int) ([]string, error) {
+	spaces := make([]*net.IPNet, 0)
+	for _, v := range addressSpaces {
+		_, space, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing address space %q: %+v", v, err)
+		}
+		spaces = append(spaces, space)
+	}
+
+	used := make([]addressRange, 0)
+	for _, v := range usedPrefixes {
+		_, prefix, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing used prefix %q: %+v", v, err)
+		}
+		used = append(used, networkAddressRange(prefix))
+	}
+
+	results := make([]string, 0)
+	for _, length := range prefixLengths {
+		allocated, err := allocateSubnetPrefix(spaces, used, length)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, allocated.String())
+		used = append(used, networkAddressRange(allocated))
+	}
+
+	return results, nil
+}
+
+// SubnetPrefixRequest is a request for an address prefix of the specified length for the named Subnet
+type SubnetPrefixRequest struct {
+	SubnetName   string
+	PrefixLength int
+}
+
+// AvailableSubnetPrefixesForSubnets returns an address prefix for each of the requested Subnets, in the same
+// order as the requests. The address prefixes of the existing Subnets are keyed by the Subnet name.
+//
+// When a requested Subnet already exists with an address prefix of the requested length, that prefix is
+// returned for it - so once a Subnet has been created from the allocated prefix, subsequent allocations keep
+// returning the same prefix rather than treating it as in use. The remaining requests are allocated as per
+// AvailableSubnetPrefixes, treating the prefixes of all existing Subnets and the excluded prefixes as in use.
+func AvailableSubnetPrefixesForSubnets(addressSpaces []string, existingSubnets map[string][]string, excludedPrefixes []string, requests []SubnetPrefixRequest) ([]string, error) {
+	usedPrefixes := make([]string, 0)
+	usedPrefixes = append(usedPrefixes, excludedPrefixes...)
+	for _, prefixes := range existingSubnets {
+		usedPrefixes = append(usedPrefixes, prefixes...)
+	}
+
+	results := make([]string, len(requests))
+	pending := make([]int, 0)
+	pendingLengths := make([]int, 0)
+	for i, request := range requests {
+		for _, v := range existingSubnets[request.SubnetName] {
+			_, prefix, err := net.ParseCIDR(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing prefix %q of Subnet %q: %+v", v, request.SubnetName, err)
+			}
+
+			if ones, _ := prefix.Mask.Size(); ones == request.PrefixLength {
+				results[i] = prefix.String()
+				break
+			}
+		}
+
+		if results[i] == "" {
+			pending = append(pending, i)
+			pendingLengths = append(pendingLengths, request.PrefixLength)
+		}
+	}
+
+	allocated, err := AvailableSubnetPrefixes(addressSpaces, usedPrefixes, pendingLengths)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range allocated {
+		results[pending[i]] = v
+	}
+
+	return results, nil
+}
+
+func allocateSubnetPrefix(spaces []*net.IPNet, used []addressRange, length int) (*net.IPNet, error) {
+	if length < 1 {
+		return nil, fmt.Errorf("prefix length must be at least 1 but got %d", length)
+	}
+	if length > maxIPv4SubnetPrefixLength && length != ipv6SubnetPrefixLength {
+		return nil, fmt.Errorf("prefix length /%d is too small for a Subnet - IPv4 Subnets must be a /%d or larger and IPv6 Subnets must be a /%d", length, maxIPv4SubnetPrefixLength, ipv6SubnetPrefixLength)
+	}
+
+	// sorting the used ranges means we can skip straight past each overlapping range
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].start.Cmp(used[j].start) < 0
+	})
+
+	for _, space := range spaces {
+		ones, bits := space.Mask.Size()
+		if ones > length {
+			continue
+		}
+		if bits == 32 && length > maxIPv4SubnetPrefixLength {
+			continue
+		}
+		if bits == 128 && length != ipv6SubnetPrefixLength {
+			continue
+		}
+
+		spaceRange := networkAddressRange(space)
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-length))
+
+		candidate := new(big.Int).Set(spaceRange.start)
+		for {
+			end := new(big.Int).Sub(new(big.Int).Add(candidate, size), big.NewInt(1))
+			if end.Cmp(spaceRange.end) > 0 {
+				break
+			}
+
+			current := addressRange{bits: bits, start: candidate, end: end}
+			var overlapping *addressRange
+			for i := range used {
+				if used[i].overlaps(current) {
+					overlapping = &used[i]
+					break
+				}
+			}
+
+			if overlapping == nil {
+				return &net.IPNet{
+					IP:   bigIntToIP(candidate, bits),
+					Mask: net.CIDRMask(length, bits),
+				}, nil
+			}
+
+			candidate = alignUp(new(big.Int).Add(overlapping.end, big.NewInt(1)), size)
+		}
+	}
+
+	return nil, fmt.Errorf("no /%d prefix is available within the address spaces", length)
+}
+
+// SubnetUsableAddressRange returns the first and last addresses within the prefix which can be assigned to
+// resources, excluding the addresses Azure reserves within each Subnet
+func SubnetUsableAddressRange(prefix string) (string, string, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing prefix %q: %+v", prefix, err)
+	}
+
+	ones, bits := network.Mask.Size()
+	if bits == 32 && ones > maxIPv4SubnetPrefixLength {
+		return "", "", fmt.Errorf("prefix %q is too small for a Subnet", prefix)
+	}
+
+	r := networkAddressRange(network)
+	first := new(big.Int).Add(r.start, big.NewInt(subnetReservedAddressesAtStart))
+	last := new(big.Int).Sub(r.end, big.NewInt(subnetReservedAddressesAtEnd))
+
+	return bigIntToIP(first, bits).String(), bigIntToIP(last, bits).String(), nil
+}
+
+// PrefixCoveredBy returns whether every address within the prefix is also within one of the more specific
+// prefixes - that is, whether longest-prefix matching means the prefix itself can never be matched. Prefixes
+// which are the same size or larger than the prefix are ignored.
+func PrefixCoveredBy(prefix string, moreSpecificPrefixes []string) (bool, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false, fmt.Errorf("parsing prefix %q: %+v", prefix, err)
+	}
+	ones, _ := network.Mask.Size()
+	target := networkAddressRange(network)
+
+	candidates := make([]addressRange, 0)
+	for _, v := range moreSpecificPrefixes {
+		_, other, err := net.ParseCIDR(v)
+		if err != nil {
+			return false, fmt.Errorf("parsing prefix %q: %+v", v, err)
+		}
+
+		otherOnes, _ := other.Mask.Size()
+		otherRange := networkAddressRange(other)
+		if otherOnes <= ones || !otherRange.overlaps(target) {
+			continue
+		}
+
+		candidates = append(candidates, otherRange)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].start.Cmp(candidates[j].start) < 0
+	})
+
+	// since prefixes are aligned, any more specific prefix which overlaps the target is contained within it - so
+	// the target is covered when the more specific prefixes are contiguous from its start through to its end
+	next := new(big.Int).Set(target.start)
+	for _, candidate := range candidates {
+		if candidate.start.Cmp(next) > 0 {
+			return false, nil
+		}
+		if end := new(big.Int).Add(candidate.end, big.NewInt(1)); end.Cmp(next) > 0 {
+			next = end
+		}
+	}
+
+	return next.Cmp(target.end) > 0, nil
+}
+
+func networkAddressRange(network *net.IPNet) addressRange {
+	ones, bits := network.Mask.Size()
+
+	start := ipToBigInt(network.IP, bits)
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+
+	return addressRange{
+		bits:  bits,
+		start: start,
+		end:   new(big.Int).Sub(new(big.Int).Add(start, size), big.NewInt(1)),
+	}
+}
+
+func alignUp(value *big.Int, size *big.Int) *big.Int {
+	remainder := new(big.Int).Mod(value, size)
+	if remainder.Sign() == 0 {
+		return value
+	}
+
+	return new(big.Int).Add(value, new(big.Int).Sub(size, remainder))
+}
+
+func ipToBigInt(ip net.IP, bits int) *big.Int {
+	if bits == 32 {
+		ip = ip.To4()
+	} else {
+		ip = ip.To16()
+	}
+
+	return new(big.Int).SetBytes(ip)
+}
+
+func bigIntToIP(value *big.Int, bits int) net.IP {
+	b := value.Bytes()
+	ip := make(net.IP, bits/8)
+	copy(ip[len(ip)-len(b):], b)
+	return ip
+}
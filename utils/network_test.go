@@ -46,3 +46,405 @@ func TestIPv6Compression(t *testing.T) {
 		})
 	}
 }
+
+func TestAvailableSubnetPrefixes(t *testing.T) {
+	cases := []struct {
+		Name          string
+		AddressSpaces []string
+		Used          []string
+		PrefixLengths []int
+		Expected      []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "empty address space",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLengths: []int{24},
+			Expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			Name:          "skips used prefixes",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/24", "10.0.1.0/24"},
+			PrefixLengths: []int{24},
+			Expected:      []string{"10.0.2.0/24"},
+		},
+		{
+			Name:          "fills gaps between used prefixes",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/24", "10.0.2.0/24"},
+			PrefixLengths: []int{24},
+			Expected:      []string{"10.0.1.0/24"},
+		},
+		{
+			Name:          "aligned to the requested size",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/28"},
+			PrefixLengths: []int{24, 28},
+			Expected:      []string{"10.0.1.0/24", "10.0.0.16/28"},
+		},
+		{
+			Name:          "allocated in the order requested",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLengths: []int{28, 24, 26},
+			Expected:      []string{"10.0.0.0/28", "10.0.1.0/24", "10.0.0.64/26"},
+		},
+		{
+			Name:          "appending a request doesn't change earlier allocations",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLengths: []int{28, 24, 26, 23},
+			Expected:      []string{"10.0.0.0/28", "10.0.1.0/24", "10.0.0.64/26", "10.0.2.0/23"},
+		},
+		{
+			Name:          "used prefix larger than the request",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/20"},
+			PrefixLengths: []int{29},
+			Expected:      []string{"10.0.16.0/29"},
+		},
+		{
+			Name:          "falls through to the next address space",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			Used:          []string{"10.0.0.0/25"},
+			PrefixLengths: []int{25, 25},
+			Expected:      []string{"10.0.0.128/25", "10.1.0.0/25"},
+		},
+		{
+			Name:          "address space smaller than the request",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			PrefixLengths: []int{22},
+			Expected:      []string{"10.1.0.0/22"},
+		},
+		{
+			Name:          "used prefixes outside the address spaces are ignored",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"192.168.0.0/24"},
+			PrefixLengths: []int{24},
+			Expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			Name:          "full address space",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			Used:          []string{"10.0.0.0/25", "10.0.0.128/26"},
+			PrefixLengths: []int{25},
+			ExpectError:   true,
+		},
+		{
+			Name:          "exactly fits",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			Used:          []string{"10.0.0.0/25", "10.0.0.128/26"},
+			PrefixLengths: []int{26},
+			Expected:      []string{"10.0.0.192/26"},
+		},
+		{
+			Name:          "IPv4 smaller than a /29",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLengths: []int{30},
+			ExpectError:   true,
+		},
+		{
+			Name:          "IPv6",
+			AddressSpaces: []string{"10.0.0.0/16", "fd00:db8:deca::/48"},
+			Used:          []string{"10.0.0.0/24", "fd00:db8:deca::/64"},
+			PrefixLengths: []int{64, 24},
+			Expected:      []string{"fd00:db8:deca:1::/64", "10.0.1.0/24"},
+		},
+		{
+			Name:          "IPv6 must be a /64",
+			AddressSpaces: []string{"fd00:db8:deca::/48"},
+			PrefixLengths: []int{56},
+			ExpectError:   true,
+		},
+		{
+			Name:          "invalid address space",
+			AddressSpaces: []string{"10.0.0.0"},
+			PrefixLengths: []int{24},
+			ExpectError:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := AvailableSubnetPrefixes(tc.AddressSpaces, tc.Used, tc.PrefixLengths)
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error but got %v", actual)
+			}
+
+			if len(actual) != len(tc.Expected) {
+				t.Fatalf("expected %v but got %v", tc.Expected, actual)
+			}
+			for i := range actual {
+				if actual[i] != tc.Expected[i] {
+					t.Fatalf("expected %v but got %v", tc.Expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestAvailableSubnetPrefixesForSubnets(t *testing.T) {
+	cases := []struct {
+		Name            string
+		AddressSpaces   []string
+		ExistingSubnets map[string][]string
+		Excluded        []string
+		Requests        []SubnetPrefixRequest
+		Expected        []string
+		ExpectError     bool
+	}{
+		{
+			Name:          "no existing subnets",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+				{SubnetName: "management", PrefixLength: 26},
+			},
+			Expected: []string{"10.0.0.0/24", "10.0.1.0/26"},
+		},
+		{
+			Name:          "other subnets are in use",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			ExistingSubnets: map[string][]string{
+				"other": {"10.0.0.0/24"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+			},
+			Expected: []string{"10.0.1.0/24"},
+		},
+		{
+			Name:          "first requested subnet exists",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			ExistingSubnets: map[string][]string{
+				"app": {"10.0.0.0/24"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+				{SubnetName: "management", PrefixLength: 26},
+			},
+			Expected: []string{"10.0.0.0/24", "10.0.1.0/26"},
+		},
+		{
+			Name:          "second requested subnet exists",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			ExistingSubnets: map[string][]string{
+				"management": {"10.0.1.0/26"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+				{SubnetName: "management", PrefixLength: 26},
+			},
+			Expected: []string{"10.0.0.0/24", "10.0.1.0/26"},
+		},
+		{
+			Name:          "requested subnet exists with a different prefix length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			ExistingSubnets: map[string][]string{
+				"app": {"10.0.0.0/25"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+			},
+			Expected: []string{"10.0.1.0/24"},
+		},
+		{
+			Name:          "requested subnet exists with a prefix in each address family",
+			AddressSpaces: []string{"10.0.0.0/16", "fd00:db8:deca::/48"},
+			ExistingSubnets: map[string][]string{
+				"app": {"10.0.0.0/24", "fd00:db8:deca::/64"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+				{SubnetName: "app", PrefixLength: 64},
+			},
+			Expected: []string{"10.0.0.0/24", "fd00:db8:deca::/64"},
+		},
+		{
+			Name:          "excluded prefixes",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Excluded:      []string{"10.0.0.0/23"},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+			},
+			Expected: []string{"10.0.2.0/24"},
+		},
+		{
+			Name:          "invalid existing prefix",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			ExistingSubnets: map[string][]string{
+				"app": {"10.0.0.0"},
+			},
+			Requests: []SubnetPrefixRequest{
+				{SubnetName: "app", PrefixLength: 24},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := AvailableSubnetPrefixesForSubnets(tc.AddressSpaces, tc.ExistingSubnets, tc.Excluded, tc.Requests)
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error but got %v", actual)
+			}
+
+			if len(actual) != len(tc.Expected) {
+				t.Fatalf("expected %v but got %v", tc.Expected, actual)
+			}
+			for i := range actual {
+				if actual[i] != tc.Expected[i] {
+					t.Fatalf("expected %v but got %v", tc.Expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestSubnetUsableAddressRange(t *testing.T) {
+	cases := []struct {
+		Prefix      string
+		First       string
+		Last        string
+		ExpectError bool
+	}{
+		{
+			Prefix: "10.0.0.0/24",
+			First:  "10.0.0.4",
+			Last:   "10.0.0.254",
+		},
+		{
+			Prefix: "10.0.1.8/29",
+			First:  "10.0.1.12",
+			Last:   "10.0.1.14",
+		},
+		{
+			Prefix: "fd00:db8:deca:1::/64",
+			First:  "fd00:db8:deca:1::4",
+			Last:   "fd00:db8:deca:1:ffff:ffff:ffff:fffe",
+		},
+		{
+			Prefix:      "10.0.0.0/30",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Prefix, func(t *testing.T) {
+			first, last, err := SubnetUsableAddressRange(tc.Prefix)
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error but got %q - %q", first, last)
+			}
+
+			if first != tc.First || last != tc.Last {
+				t.Fatalf("expected %q - %q but got %q - %q", tc.First, tc.Last, first, last)
+			}
+		})
+	}
+}
+
+func TestPrefixCoveredBy(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Prefix      string
+		Others      []string
+		Expected    bool
+		ExpectError bool
+	}{
+		{
+			Name:     "no other prefixes",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{},
+			Expected: false,
+		},
+		{
+			Name:     "covered by two halves",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.128/25", "10.0.0.0/25"},
+			Expected: true,
+		},
+		{
+			Name:     "covered by uneven prefixes",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/26"},
+			Expected: true,
+		},
+		{
+			Name:     "gap in the middle",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.0/26", "10.0.0.128/25"},
+			Expected: false,
+		},
+		{
+			Name:     "gap at the end",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.0/25", "10.0.0.128/26"},
+			Expected: false,
+		},
+		{
+			Name:     "same size prefix is ignored",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.0/24"},
+			Expected: false,
+		},
+		{
+			Name:     "less specific prefix is ignored",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.0.0.0/16"},
+			Expected: false,
+		},
+		{
+			Name:     "unrelated prefixes are ignored",
+			Prefix:   "10.0.0.0/24",
+			Others:   []string{"10.1.0.0/25", "10.1.0.128/25", "fd00::/64"},
+			Expected: false,
+		},
+		{
+			Name:     "IPv6 covered",
+			Prefix:   "fd00:db8::/63",
+			Others:   []string{"fd00:db8::/64", "fd00:db8:0:1::/64"},
+			Expected: true,
+		},
+		{
+			Name:        "invalid prefix",
+			Prefix:      "AzureCloud",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := PrefixCoveredBy(tc.Prefix, tc.Others)
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error but got %t", actual)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}